@@ -8,7 +8,7 @@ import (
 // AccountService is the interface to interact with Accounts endpoint on the Vultr API
 // Link : https://www.vultr.com/api/#tag/account
 type AccountService interface {
-	Get(ctx context.Context) (*Account, *http.Response, error)
+	Get(ctx context.Context) (*Account, *Response, error)
 }
 
 // AccountServiceHandler handles interaction with the account methods for the Vultr API
@@ -22,17 +22,17 @@ type accountBase struct {
 
 // Account represents a Vultr account
 type Account struct {
-	Balance           float32  `json:"balance"`
-	PendingCharges    float32  `json:"pending_charges"`
-	LastPaymentDate   string   `json:"last_payment_date"`
-	LastPaymentAmount float32  `json:"last_payment_amount"`
-	Name              string   `json:"name"`
-	Email             string   `json:"email"`
-	ACL               []string `json:"acls"`
+	Balance           float32   `json:"balance"`
+	PendingCharges    float32   `json:"pending_charges"`
+	LastPaymentDate   Timestamp `json:"last_payment_date"`
+	LastPaymentAmount float32   `json:"last_payment_amount"`
+	Name              string    `json:"name"`
+	Email             string    `json:"email"`
+	ACL               []string  `json:"acls"`
 }
 
 // Get Vultr account info
-func (a *AccountServiceHandler) Get(ctx context.Context) (*Account, *http.Response, error) {
+func (a *AccountServiceHandler) Get(ctx context.Context) (*Account, *Response, error) {
 	uri := "/v2/account"
 	req, err := a.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -39,7 +39,7 @@ func TestAccountServiceHandler_Get(t *testing.T) {
 		t.Errorf("Account.Get returned error: %v", err)
 	}
 
-	expected := &Account{Balance: -5519.11, PendingCharges: 57.03, LastPaymentDate: "2014-07-18 15:31:01", LastPaymentAmount: -1.00, Name: "Test Tester", Email: "example@vultr.com", ACL: []string{"subscriptions", "billing", "support", "provisioning"}}
+	expected := &Account{Balance: -5519.11, PendingCharges: 57.03, LastPaymentDate: timestamp("2014-07-18 15:31:01"), LastPaymentAmount: -1.00, Name: "Test Tester", Email: "example@vultr.com", ACL: []string{"subscriptions", "billing", "support", "provisioning"}}
 
 	if !reflect.DeepEqual(account, expected) {
 		t.Errorf("Account.Get returned %+v, expected %+v", account, expected)
@@ -10,7 +10,7 @@ import (
 // ApplicationService is the interface to interact with the Application endpoint on the Vultr API.
 // Link : https://www.vultr.com/api/#tag/application
 type ApplicationService interface {
-	List(ctx context.Context, options *ListOptions) ([]Application, *Meta, *http.Response, error)
+	List(ctx context.Context, options *ListOptions) ([]Application, *Meta, *Response, error)
 }
 
 // ApplicationServiceHandler handles interaction with the application methods for the Vultr API.
@@ -35,7 +35,7 @@ type applicationBase struct {
 }
 
 // List retrieves a list of available applications that can be launched when creating a Vultr instance
-func (a *ApplicationServiceHandler) List(ctx context.Context, options *ListOptions) ([]Application, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (a *ApplicationServiceHandler) List(ctx context.Context, options *ListOptions) ([]Application, *Meta, *Response, error) { //nolint:dupl,lll
 	uri := "/v2/applications"
 
 	req, err := a.client.NewRequest(ctx, http.MethodGet, uri, nil)
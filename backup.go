@@ -11,8 +11,8 @@ import (
 // BackupService is the interface to interact with the backup endpoint on the Vultr API
 // Link : https://www.vultr.com/api/#tag/backup
 type BackupService interface {
-	Get(ctx context.Context, backupID string) (*Backup, *http.Response, error)
-	List(ctx context.Context, options *ListOptions) ([]Backup, *Meta, *http.Response, error)
+	Get(ctx context.Context, backupID string) (*Backup, *Response, error)
+	List(ctx context.Context, options *ListOptions) ([]Backup, *Meta, *Response, error)
 }
 
 // BackupServiceHandler handles interaction with the backup methods for the Vultr API
@@ -22,11 +22,11 @@ type BackupServiceHandler struct {
 
 // Backup represents a Vultr backup
 type Backup struct {
-	ID          string `json:"id"`
-	DateCreated string `json:"date_created"`
-	Description string `json:"description"`
-	Size        int    `json:"size"`
-	Status      string `json:"status"`
+	ID          string    `json:"id"`
+	DateCreated Timestamp `json:"date_created"`
+	Description string    `json:"description"`
+	Size        int       `json:"size"`
+	Status      string    `json:"status"`
 }
 
 type backupsBase struct {
@@ -39,7 +39,7 @@ type backupBase struct {
 }
 
 // Get retrieves a backup that matches the given backupID
-func (b *BackupServiceHandler) Get(ctx context.Context, backupID string) (*Backup, *http.Response, error) {
+func (b *BackupServiceHandler) Get(ctx context.Context, backupID string) (*Backup, *Response, error) {
 	uri := fmt.Sprintf("/v2/backups/%s", backupID)
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
 
@@ -57,7 +57,7 @@ func (b *BackupServiceHandler) Get(ctx context.Context, backupID string) (*Backu
 }
 
 // List retrieves a list of all backups on the current account
-func (b *BackupServiceHandler) List(ctx context.Context, options *ListOptions) ([]Backup, *Meta, *http.Response, error) { //nolint:dupl
+func (b *BackupServiceHandler) List(ctx context.Context, options *ListOptions) ([]Backup, *Meta, *Response, error) { //nolint:dupl
 	uri := "/v2/backups"
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
 
@@ -44,7 +44,7 @@ func TestBackupServiceHandler_List(t *testing.T) {
 	expected := []Backup{
 		{
 			ID:          "543d34149403a",
-			DateCreated: "2014-10-14 12:40:40",
+			DateCreated: timestamp("2014-10-14 12:40:40"),
 			Description: "Automatic server backup",
 			Size:        42949672960,
 			Status:      "complete",
@@ -137,7 +137,7 @@ func TestBackupServiceHandler_Get(t *testing.T) {
 
 	expected := &Backup{
 		ID:          "543d34149403a",
-		DateCreated: "2014-10-14 12:40:40",
+		DateCreated: timestamp("2014-10-14 12:40:40"),
 		Description: "Automatic server backup",
 		Size:        42949672960,
 		Status:      "complete",
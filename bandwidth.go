@@ -0,0 +1,425 @@
+package govultr
+
+import (
+	"cmp"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// bandwidthGB is the number of bytes in a GB of allowed bandwidth
+const bandwidthGB = 1000 * 1000 * 1000
+
+const bandwidthDateLayout = "2006-01-02"
+
+// BandwidthDay is the traffic of one day, in UTC
+type BandwidthDay struct {
+	Date time.Time
+	BandwidthUsage
+}
+
+// BandwidthReport is the daily traffic of an instance or bare metal server
+type BandwidthReport struct {
+	// ID and Label identify the instance or bare metal server
+	ID    string
+	Label string
+	// Kind is PlanKindInstance or PlanKindBareMetal
+	Kind PlanKind
+	// Days are sorted by date, oldest first
+	Days []BandwidthDay
+	// AllowedBytes is the outgoing traffic included each month, 0 when unknown
+	AllowedBytes int
+}
+
+// NewBandwidthReport returns the report of the bandwidth returned by GetBandwidth for the
+// instance or bare metal server id. Kind, Label and AllowedBytes are left to the caller,
+// BandwidthReporter sets them.
+func NewBandwidthReport(id string, bandwidth *Bandwidth) (*BandwidthReport, error) {
+	report := &BandwidthReport{ID: id}
+	if bandwidth == nil {
+		return report, nil
+	}
+
+	report.Days = make([]BandwidthDay, 0, len(bandwidth.Bandwidth))
+	for date, usage := range bandwidth.Bandwidth {
+		day, err := time.Parse(bandwidthDateLayout, date)
+		if err != nil {
+			return nil, fmt.Errorf("bandwidth of %s: %w", id, err)
+		}
+		report.Days = append(report.Days, BandwidthDay{Date: day, BandwidthUsage: usage})
+	}
+	slices.SortFunc(report.Days, func(a, b BandwidthDay) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	return report, nil
+}
+
+// Total returns the traffic of the days from from to to, both included
+func (r *BandwidthReport) Total(from, to time.Time) BandwidthUsage {
+	from, to = truncateDay(from), truncateDay(to)
+
+	var total BandwidthUsage
+	for _, day := range r.Days {
+		if day.Date.Before(from) || day.Date.After(to) {
+			continue
+		}
+		total.IncomingBytes += day.IncomingBytes
+		total.OutgoingBytes += day.OutgoingBytes
+	}
+	return total
+}
+
+// MonthToDate returns the traffic since the first day of the month of now, now included
+func (r *BandwidthReport) MonthToDate(now time.Time) BandwidthUsage {
+	return r.Total(monthStart(now), now)
+}
+
+// BandwidthProjection estimates the traffic of a month. Only outgoing traffic is counted against
+// the allowance, incoming traffic is free.
+type BandwidthProjection struct {
+	MonthToDate BandwidthUsage
+	// ProjectedBytes is the outgoing traffic expected by the end of the month, at the average
+	// daily rate of the month so far
+	ProjectedBytes int
+	AllowedBytes   int
+	// OverageBytes is the projected traffic beyond AllowedBytes, 0 when the projection is within
+	// the allowance or the allowance is unknown
+	OverageBytes int
+}
+
+// Overage reports whether the projected traffic is beyond the allowance
+func (p BandwidthProjection) Overage() bool {
+	return p.OverageBytes > 0
+}
+
+// Projection projects the outgoing traffic of the month of now. The daily rate is averaged from
+// the first day of the month, or from the first day of the report for resources created during
+// the month.
+func (r *BandwidthReport) Projection(now time.Time) BandwidthProjection {
+	start, end := monthStart(now), truncateDay(now)
+	projection := BandwidthProjection{MonthToDate: r.MonthToDate(now), AllowedBytes: r.AllowedBytes}
+
+	if len(r.Days) > 0 && r.Days[0].Date.After(start) {
+		start = r.Days[0].Date
+	}
+	if elapsed := int(end.Sub(start).Hours()/24) + 1; elapsed > 0 {
+		remaining := daysIn(now) - now.UTC().Day()
+		projection.ProjectedBytes = projection.MonthToDate.OutgoingBytes +
+			projection.MonthToDate.OutgoingBytes*remaining/elapsed
+	}
+
+	projection.OverageBytes = overage(projection.ProjectedBytes, projection.AllowedBytes)
+	return projection
+}
+
+// WriteCSV writes the daily traffic of the report as CSV, with a header row
+func (r *BandwidthReport) WriteCSV(w io.Writer) error {
+	return writeBandwidthCSV(w, []*BandwidthReport{r})
+}
+
+// FleetBandwidth aggregates the bandwidth reports of many instances and bare metal servers. Vultr
+// pools the allowances of an account, so the fleet is over its allowance when its total traffic
+// is, even if no single resource is.
+type FleetBandwidth struct {
+	Reports []*BandwidthReport
+	// Days is the traffic of every report summed by day, oldest first
+	Days []BandwidthDay
+	// AllowedBytes is the sum of the allowances of the reports
+	AllowedBytes int
+}
+
+// NewFleetBandwidth aggregates reports
+func NewFleetBandwidth(reports ...*BandwidthReport) *FleetBandwidth {
+	fleet := &FleetBandwidth{Reports: reports}
+
+	days := make(map[time.Time]BandwidthUsage)
+	for _, report := range reports {
+		fleet.AllowedBytes += report.AllowedBytes
+		for _, day := range report.Days {
+			usage := days[day.Date]
+			usage.IncomingBytes += day.IncomingBytes
+			usage.OutgoingBytes += day.OutgoingBytes
+			days[day.Date] = usage
+		}
+	}
+
+	fleet.Days = make([]BandwidthDay, 0, len(days))
+	for date, usage := range days {
+		fleet.Days = append(fleet.Days, BandwidthDay{Date: date, BandwidthUsage: usage})
+	}
+	slices.SortFunc(fleet.Days, func(a, b BandwidthDay) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	return fleet
+}
+
+// Projection sums the projections of the reports, and compares the total to the pooled allowance
+func (f *FleetBandwidth) Projection(now time.Time) BandwidthProjection {
+	var projection BandwidthProjection
+	for _, report := range f.Reports {
+		p := report.Projection(now)
+		projection.MonthToDate.IncomingBytes += p.MonthToDate.IncomingBytes
+		projection.MonthToDate.OutgoingBytes += p.MonthToDate.OutgoingBytes
+		projection.ProjectedBytes += p.ProjectedBytes
+		projection.AllowedBytes += p.AllowedBytes
+	}
+
+	projection.OverageBytes = overage(projection.ProjectedBytes, projection.AllowedBytes)
+	return projection
+}
+
+// Overages returns the reports projected beyond their own allowance in the month of now, largest
+// overage first
+func (f *FleetBandwidth) Overages(now time.Time) []*BandwidthReport {
+	overages := make(map[*BandwidthReport]int)
+	var reports []*BandwidthReport
+	for _, report := range f.Reports {
+		if p := report.Projection(now); p.Overage() {
+			overages[report] = p.OverageBytes
+			reports = append(reports, report)
+		}
+	}
+
+	slices.SortStableFunc(reports, func(a, b *BandwidthReport) int {
+		return cmp.Compare(overages[b], overages[a])
+	})
+	return reports
+}
+
+// WriteCSV writes the daily traffic of every report as CSV, with a header row
+func (f *FleetBandwidth) WriteCSV(w io.Writer) error {
+	return writeBandwidthCSV(w, f.Reports)
+}
+
+// WriteProjectionCSV writes the projection of every report for the month of now as CSV, with a
+// header row
+func (f *FleetBandwidth) WriteProjectionCSV(w io.Writer, now time.Time) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"id", "label", "kind", "month_to_date_incoming_bytes", "month_to_date_outgoing_bytes",
+		"projected_bytes", "allowed_bytes", "overage_bytes"})
+
+	for _, report := range f.Reports {
+		p := report.Projection(now)
+		_ = cw.Write([]string{
+			report.ID, report.Label, string(report.Kind),
+			strconv.Itoa(p.MonthToDate.IncomingBytes), strconv.Itoa(p.MonthToDate.OutgoingBytes),
+			strconv.Itoa(p.ProjectedBytes), strconv.Itoa(p.AllowedBytes), strconv.Itoa(p.OverageBytes),
+		})
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeBandwidthCSV(w io.Writer, reports []*BandwidthReport) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"id", "label", "kind", "date", "incoming_bytes", "outgoing_bytes"})
+
+	for _, report := range reports {
+		for _, day := range report.Days {
+			_ = cw.Write([]string{
+				report.ID, report.Label, string(report.Kind), day.Date.Format(bandwidthDateLayout),
+				strconv.Itoa(day.IncomingBytes), strconv.Itoa(day.OutgoingBytes),
+			})
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// BandwidthReporter fetches the bandwidth reports of instances and bare metal servers, along with
+// their allowance
+type BandwidthReporter struct {
+	client *Client
+
+	mu         sync.Mutex
+	metalPlans *metalPlansLoad
+}
+
+// metalPlansLoad is the load of the bandwidth of the bare metal plans, shared by the reports. Once
+// done is closed, bandwidth maps the plan IDs to their bandwidth in GB, unless err is set.
+type metalPlansLoad struct {
+	done      chan struct{}
+	bandwidth map[string]int
+	err       error
+}
+
+// NewBandwidthReporter returns a BandwidthReporter using the services of client
+func NewBandwidthReporter(client *Client) *BandwidthReporter {
+	return &BandwidthReporter{client: client}
+}
+
+// Instance returns the report of an instance
+func (r *BandwidthReporter) Instance(ctx context.Context, instanceID string) (*BandwidthReport, error) {
+	instance, _, err := r.client.Instance.Get(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	return r.instance(ctx, instance)
+}
+
+// BareMetal returns the report of a bare metal server. Its allowance is the bandwidth of its plan.
+func (r *BandwidthReporter) BareMetal(ctx context.Context, serverID string) (*BandwidthReport, error) {
+	server, _, err := r.client.BareMetalServer.Get(ctx, serverID)
+	if err != nil {
+		return nil, err
+	}
+	return r.bareMetal(ctx, server)
+}
+
+// Fleet returns the reports of every instance and bare metal server matching options, such as
+// all the resources with a tag. The bandwidth of the resources is fetched with Bulk, 10 at a time
+// unless opts set another concurrency, and the first failure stops the fetch.
+func (r *BandwidthReporter) Fleet(ctx context.Context, options *ListOptions, opts ...BulkOption) (*FleetBandwidth, error) {
+	instances, err := ListAll(ctx, r.client.Instance.List, options)
+	if err != nil {
+		return nil, err
+	}
+	servers, err := ListAll(ctx, r.client.BareMetalServer.List, options)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append([]BulkOption{WithFailFast()}, opts...)
+	instanceReports, err := bulkReports(ctx, instances, func(instance *Instance) string { return instance.ID }, r.instance, opts)
+	if err != nil {
+		return nil, err
+	}
+	serverReports, err := bulkReports(ctx, servers, func(server *BareMetalServer) string { return server.ID }, r.bareMetal, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFleetBandwidth(append(instanceReports, serverReports...)...), nil
+}
+
+// bulkReports fetches the report of every resource with Bulk, in the order of resources
+func bulkReports[T any](ctx context.Context, resources []T, id func(*T) string,
+	report func(context.Context, *T) (*BandwidthReport, error), opts []BulkOption) ([]*BandwidthReport, error) {
+	ids := make([]string, len(resources))
+	byID := make(map[string]*T, len(resources))
+	for i := range resources {
+		ids[i] = id(&resources[i])
+		byID[ids[i]] = &resources[i]
+	}
+
+	results := Bulk(ctx, ids, func(ctx context.Context, id string) (*BandwidthReport, *Response, error) {
+		r, err := report(ctx, byID[id])
+		return r, nil, err
+	}, opts...)
+	if err := results.Err(); err != nil {
+		return nil, err
+	}
+
+	reports := make([]*BandwidthReport, len(results))
+	for i := range results {
+		reports[i] = results[i].Value
+	}
+	return reports, nil
+}
+
+func (r *BandwidthReporter) instance(ctx context.Context, instance *Instance) (*BandwidthReport, error) {
+	bandwidth, _, err := r.client.Instance.GetBandwidth(ctx, instance.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := NewBandwidthReport(instance.ID, bandwidth)
+	if err != nil {
+		return nil, err
+	}
+	report.Label, report.Kind, report.AllowedBytes = instance.Label, PlanKindInstance, instance.AllowedBandwidth*bandwidthGB
+	return report, nil
+}
+
+func (r *BandwidthReporter) bareMetal(ctx context.Context, server *BareMetalServer) (*BandwidthReport, error) {
+	bandwidth, _, err := r.client.BareMetalServer.GetBandwidth(ctx, server.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := NewBandwidthReport(server.ID, bandwidth)
+	if err != nil {
+		return nil, err
+	}
+	report.Label, report.Kind = server.Label, PlanKindBareMetal
+
+	bandwidthByPlan, err := r.metalBandwidth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.AllowedBytes = bandwidthByPlan[server.Plan] * bandwidthGB
+
+	return report, nil
+}
+
+// metalBandwidth returns the bandwidth of the bare metal plans by ID. The plans are listed once
+// for every report, by a load that outlives the cancellation of the ctx that started it, so that
+// the callers waiting for it are not affected. A failed load is started again by the next call.
+func (r *BandwidthReporter) metalBandwidth(ctx context.Context) (map[string]int, error) {
+	r.mu.Lock()
+	load := r.metalPlans
+	if load == nil {
+		load = &metalPlansLoad{done: make(chan struct{})}
+		r.metalPlans = load
+		go r.loadMetalPlans(context.WithoutCancel(ctx), load)
+	}
+	r.mu.Unlock()
+
+	select {
+	case <-load.done:
+		return load.bandwidth, load.err
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	}
+}
+
+func (r *BandwidthReporter) loadMetalPlans(ctx context.Context, load *metalPlansLoad) {
+	defer close(load.done)
+
+	plans, err := ListAll(ctx, r.client.Plan.ListBareMetal, &ListOptions{PerPage: 500})
+	if err != nil {
+		load.err = err
+		r.mu.Lock()
+		if r.metalPlans == load {
+			r.metalPlans = nil
+		}
+		r.mu.Unlock()
+		return
+	}
+
+	load.bandwidth = make(map[string]int, len(plans))
+	for _, plan := range plans {
+		load.bandwidth[plan.ID] = plan.Bandwidth
+	}
+}
+
+func overage(projected, allowed int) int {
+	if allowed == 0 || projected <= allowed {
+		return 0
+	}
+	return projected - allowed
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// daysIn returns the number of days in the month of t
+func daysIn(t time.Time) int {
+	return monthStart(t).AddDate(0, 1, -1).Day()
+}
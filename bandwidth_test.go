@@ -0,0 +1,219 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// bandwidthJSON returns a GetBandwidth response with outgoing bytes on every day of April 2024
+// from first to last, preceded by days
+func bandwidthJSON(first, last, outgoing int, days ...string) string {
+	for day := last; day >= first; day-- {
+		days = append(days, fmt.Sprintf(`"2024-04-%02d":{"incoming_bytes":10,"outgoing_bytes":%d}`, day, outgoing))
+	}
+	return `{"bandwidth":{` + strings.Join(days, ",") + `}}`
+}
+
+func setupBandwidth() {
+	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"instances":[{"id":"i-1","label":"web","allowed_bandwidth":1}],"meta":{"total":1}}`)
+	})
+	mux.HandleFunc("/v2/instances/i-1", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"instance":{"id":"i-1","label":"web","allowed_bandwidth":1}}`)
+	})
+	mux.HandleFunc("/v2/instances/i-1/bandwidth", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, bandwidthJSON(1, 10, 100_000_000, `"2024-03-31":{"incoming_bytes":5,"outgoing_bytes":1000}`))
+	})
+	mux.HandleFunc("/v2/bare-metals", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"bare_metals":[{"id":"bm-1","label":"db","plan":"vbm-4c-32gb"}],"meta":{"total":1}}`)
+	})
+	mux.HandleFunc("/v2/bare-metals/bm-1", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"bare_metal":{"id":"bm-1","label":"db","plan":"vbm-4c-32gb"}}`)
+	})
+	mux.HandleFunc("/v2/bare-metals/bm-1/bandwidth", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, bandwidthJSON(6, 10, 50_000_000))
+	})
+	mux.HandleFunc("/v2/plans-metal", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"plans_metal":[{"id":"vbm-4c-32gb","bandwidth":5}],"meta":{"total":1}}`)
+	})
+}
+
+func TestNewBandwidthReport(t *testing.T) {
+	report, err := NewBandwidthReport("i-1", &Bandwidth{Bandwidth: map[string]BandwidthUsage{
+		"2024-04-02": {IncomingBytes: 3, OutgoingBytes: 4},
+		"2024-03-31": {IncomingBytes: 1, OutgoingBytes: 2},
+	}})
+	if err != nil {
+		t.Fatalf("NewBandwidthReport returned %+v", err)
+	}
+
+	expected := []BandwidthDay{
+		{Date: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), BandwidthUsage: BandwidthUsage{IncomingBytes: 1, OutgoingBytes: 2}},
+		{Date: time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC), BandwidthUsage: BandwidthUsage{IncomingBytes: 3, OutgoingBytes: 4}},
+	}
+	if !reflect.DeepEqual(report.Days, expected) {
+		t.Errorf("NewBandwidthReport returned %+v, expected %+v", report.Days, expected)
+	}
+
+	if _, err := NewBandwidthReport("i-1", &Bandwidth{Bandwidth: map[string]BandwidthUsage{"April 1st": {}}}); err == nil {
+		t.Errorf("NewBandwidthReport accepted an invalid date")
+	}
+}
+
+func TestBandwidthReporter(t *testing.T) {
+	setup()
+	defer teardown()
+	setupBandwidth()
+
+	now := time.Date(2024, 4, 10, 15, 0, 0, 0, time.UTC)
+	reporter := NewBandwidthReporter(client)
+
+	fleet, err := reporter.Fleet(ctx, nil)
+	if err != nil {
+		t.Fatalf("BandwidthReporter.Fleet returned %+v", err)
+	}
+	if len(fleet.Reports) != 2 || len(fleet.Days) != 11 || fleet.AllowedBytes != 6_000_000_000 {
+		t.Fatalf("BandwidthReporter.Fleet returned %+v", fleet)
+	}
+
+	tests := []struct {
+		report   *BandwidthReport
+		expected BandwidthProjection
+	}{
+		{fleet.Reports[0], BandwidthProjection{
+			MonthToDate:    BandwidthUsage{IncomingBytes: 100, OutgoingBytes: 1_000_000_000},
+			ProjectedBytes: 3_000_000_000, AllowedBytes: 1_000_000_000, OverageBytes: 2_000_000_000,
+		}},
+		// created on April 6th, the rate is averaged over 5 days
+		{fleet.Reports[1], BandwidthProjection{
+			MonthToDate:    BandwidthUsage{IncomingBytes: 50, OutgoingBytes: 250_000_000},
+			ProjectedBytes: 1_250_000_000, AllowedBytes: 5_000_000_000,
+		}},
+	}
+	for _, test := range tests {
+		if projection := test.report.Projection(now); projection != test.expected {
+			t.Errorf("BandwidthReport.Projection of %s returned %+v, expected %+v", test.report.ID, projection, test.expected)
+		}
+	}
+
+	expected := BandwidthProjection{
+		MonthToDate:    BandwidthUsage{IncomingBytes: 150, OutgoingBytes: 1_250_000_000},
+		ProjectedBytes: 4_250_000_000, AllowedBytes: 6_000_000_000,
+	}
+	if projection := fleet.Projection(now); projection != expected {
+		t.Errorf("FleetBandwidth.Projection returned %+v, expected %+v", projection, expected)
+	}
+	if overages := fleet.Overages(now); len(overages) != 1 || overages[0].ID != "i-1" {
+		t.Errorf("FleetBandwidth.Overages returned %+v, expected the instance", overages)
+	}
+	if fleet.Reports[1].Kind != PlanKindBareMetal || fleet.Reports[1].Label != "db" {
+		t.Errorf("BandwidthReporter.Fleet returned %+v for the bare metal server", fleet.Reports[1])
+	}
+
+	instance, err := reporter.Instance(ctx, "i-1")
+	if err != nil || !reflect.DeepEqual(instance, fleet.Reports[0]) {
+		t.Errorf("BandwidthReporter.Instance returned %+v, %+v, expected %+v", instance, err, fleet.Reports[0])
+	}
+	server, err := reporter.BareMetal(ctx, "bm-1")
+	if err != nil || !reflect.DeepEqual(server, fleet.Reports[1]) {
+		t.Errorf("BandwidthReporter.BareMetal returned %+v, %+v, expected %+v", server, err, fleet.Reports[1])
+	}
+}
+
+func TestBandwidthReporter_MetalPlansLoadedOnce(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/bare-metals/bm-1", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"bare_metal":{"id":"bm-1","label":"db","plan":"vbm-4c-32gb"}}`)
+	})
+	mux.HandleFunc("/v2/bare-metals/bm-1/bandwidth", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, bandwidthJSON(6, 10, 50_000_000))
+	})
+	var loads atomic.Int32
+	release := make(chan struct{})
+	mux.HandleFunc("/v2/plans-metal", func(writer http.ResponseWriter, request *http.Request) {
+		loads.Add(1)
+		<-release
+		fmt.Fprint(writer, `{"plans_metal":[{"id":"vbm-4c-32gb","bandwidth":5}],"meta":{"total":1}}`)
+	})
+
+	reporter := NewBandwidthReporter(client)
+
+	// the report whose ctx is cancelled while the plans load does not stop the load for the others
+	cancelled, cancel := context.WithCancel(ctx)
+	errs := make(chan error, 1)
+	go func() {
+		_, err := reporter.BareMetal(cancelled, "bm-1")
+		errs <- err
+	}()
+	for loads.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Errorf("BandwidthReporter.BareMetal returned %+v, expected context.Canceled", err)
+	}
+
+	var wg sync.WaitGroup
+	reports := make([]*BandwidthReport, 5)
+	for i := range reports {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reports[i], _ = reporter.BareMetal(ctx, "bm-1")
+		}()
+	}
+	close(release)
+	wg.Wait()
+
+	for _, report := range reports {
+		if report == nil || report.AllowedBytes != 5_000_000_000 {
+			t.Errorf("BandwidthReporter.BareMetal returned %+v, expected 5 GB allowed", report)
+		}
+	}
+	if loads.Load() != 1 {
+		t.Errorf("BandwidthReporter listed the bare metal plans %d times, expected once", loads.Load())
+	}
+}
+
+func TestBandwidthReport_WriteCSV(t *testing.T) {
+	report, _ := NewBandwidthReport("i-1", &Bandwidth{Bandwidth: map[string]BandwidthUsage{
+		"2024-04-02": {IncomingBytes: 3, OutgoingBytes: 4},
+		"2024-04-01": {IncomingBytes: 1, OutgoingBytes: 2},
+	}})
+	report.Label, report.Kind, report.AllowedBytes = "web, eu", PlanKindInstance, 5
+
+	var csv strings.Builder
+	if err := report.WriteCSV(&csv); err != nil {
+		t.Fatalf("BandwidthReport.WriteCSV returned %+v", err)
+	}
+
+	expected := `id,label,kind,date,incoming_bytes,outgoing_bytes
+i-1,"web, eu",instance,2024-04-01,1,2
+i-1,"web, eu",instance,2024-04-02,3,4
+`
+	if csv.String() != expected {
+		t.Errorf("BandwidthReport.WriteCSV wrote\n%s\nexpected\n%s", csv.String(), expected)
+	}
+
+	csv.Reset()
+	if err := NewFleetBandwidth(report).WriteProjectionCSV(&csv, time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("FleetBandwidth.WriteProjectionCSV returned %+v", err)
+	}
+
+	expected = `id,label,kind,month_to_date_incoming_bytes,month_to_date_outgoing_bytes,projected_bytes,allowed_bytes,overage_bytes
+i-1,"web, eu",instance,4,6,90,5,85
+`
+	if csv.String() != expected {
+		t.Errorf("FleetBandwidth.WriteProjectionCSV wrote\n%s\nexpected\n%s", csv.String(), expected)
+	}
+}
@@ -13,29 +13,29 @@ const bmPath = "/v2/bare-metals"
 // BareMetalServerService is the interface to interact with the Bare Metal endpoints on the Vultr API
 // Link : https://www.vultr.com/api/#tag/baremetal
 type BareMetalServerService interface {
-	Create(ctx context.Context, bmCreate *BareMetalCreate) (*BareMetalServer, *http.Response, error)
-	Get(ctx context.Context, serverID string) (*BareMetalServer, *http.Response, error)
-	Update(ctx context.Context, serverID string, bmReq *BareMetalUpdate) (*BareMetalServer, *http.Response, error)
-	Delete(ctx context.Context, serverID string) error
-	List(ctx context.Context, options *ListOptions) ([]BareMetalServer, *Meta, *http.Response, error)
+	Create(ctx context.Context, bmCreate *BareMetalCreate) (*BareMetalServer, *Response, error)
+	Get(ctx context.Context, serverID string) (*BareMetalServer, *Response, error)
+	Update(ctx context.Context, serverID string, bmReq *BareMetalUpdate) (*BareMetalServer, *Response, error)
+	Delete(ctx context.Context, serverID string) (*Response, error)
+	List(ctx context.Context, options *ListOptions) ([]BareMetalServer, *Meta, *Response, error)
 
-	GetBandwidth(ctx context.Context, serverID string) (*Bandwidth, *http.Response, error)
-	GetUserData(ctx context.Context, serverID string) (*UserData, *http.Response, error)
-	GetVNCUrl(ctx context.Context, serverID string) (*VNCUrl, *http.Response, error)
+	GetBandwidth(ctx context.Context, serverID string) (*Bandwidth, *Response, error)
+	GetUserData(ctx context.Context, serverID string) (*UserData, *Response, error)
+	GetVNCUrl(ctx context.Context, serverID string) (*VNCUrl, *Response, error)
 
-	ListIPv4s(ctx context.Context, serverID string, options *ListOptions) ([]IPv4, *Meta, *http.Response, error)
-	ListIPv6s(ctx context.Context, serverID string, options *ListOptions) ([]IPv6, *Meta, *http.Response, error)
+	ListIPv4s(ctx context.Context, serverID string, options *ListOptions) ([]IPv4, *Meta, *Response, error)
+	ListIPv6s(ctx context.Context, serverID string, options *ListOptions) ([]IPv6, *Meta, *Response, error)
 
-	Halt(ctx context.Context, serverID string) error
-	Reboot(ctx context.Context, serverID string) error
-	Start(ctx context.Context, serverID string) error
-	Reinstall(ctx context.Context, serverID string) (*BareMetalServer, *http.Response, error)
+	Halt(ctx context.Context, serverID string) (*Response, error)
+	Reboot(ctx context.Context, serverID string) (*Response, error)
+	Start(ctx context.Context, serverID string) (*Response, error)
+	Reinstall(ctx context.Context, serverID string) (*BareMetalServer, *Response, error)
 
-	MassStart(ctx context.Context, serverList []string) error
-	MassHalt(ctx context.Context, serverList []string) error
-	MassReboot(ctx context.Context, serverList []string) error
+	MassStart(ctx context.Context, serverList []string) (*Response, error)
+	MassHalt(ctx context.Context, serverList []string) (*Response, error)
+	MassReboot(ctx context.Context, serverList []string) (*Response, error)
 
-	GetUpgrades(ctx context.Context, serverID string) (*Upgrades, *http.Response, error)
+	GetUpgrades(ctx context.Context, serverID string) (*Upgrades, *Response, error)
 }
 
 // BareMetalServerServiceHandler handles interaction with the Bare Metal methods for the Vultr API
@@ -45,24 +45,24 @@ type BareMetalServerServiceHandler struct {
 
 // BareMetalServer represents a Bare Metal server on Vultr
 type BareMetalServer struct {
-	ID              string `json:"id"`
-	Os              string `json:"os"`
-	RAM             string `json:"ram"`
-	Disk            string `json:"disk"`
-	MainIP          string `json:"main_ip"`
-	CPUCount        int    `json:"cpu_count"`
-	Region          string `json:"region"`
-	DefaultPassword string `json:"default_password"`
-	DateCreated     string `json:"date_created"`
-	Status          string `json:"status"`
-	NetmaskV4       string `json:"netmask_v4"`
-	GatewayV4       string `json:"gateway_v4"`
-	Plan            string `json:"plan"`
-	V6Network       string `json:"v6_network"`
-	V6MainIP        string `json:"v6_main_ip"`
-	V6NetworkSize   int    `json:"v6_network_size"`
-	MacAddress      int    `json:"mac_address"`
-	Label           string `json:"label"`
+	ID              string    `json:"id"`
+	Os              string    `json:"os"`
+	RAM             string    `json:"ram"`
+	Disk            string    `json:"disk"`
+	MainIP          string    `json:"main_ip"`
+	CPUCount        int       `json:"cpu_count"`
+	Region          string    `json:"region"`
+	DefaultPassword string    `json:"default_password"`
+	DateCreated     Timestamp `json:"date_created"`
+	Status          string    `json:"status"`
+	NetmaskV4       string    `json:"netmask_v4"`
+	GatewayV4       string    `json:"gateway_v4"`
+	Plan            string    `json:"plan"`
+	V6Network       string    `json:"v6_network"`
+	V6MainIP        string    `json:"v6_main_ip"`
+	V6NetworkSize   int       `json:"v6_network_size"`
+	MacAddress      int       `json:"mac_address"`
+	Label           string    `json:"label"`
 	// Deprecated: Tag should no longer be used. Instead, use Tags.
 	Tag      string   `json:"tag"`
 	OsID     int      `json:"os_id"`
@@ -94,25 +94,45 @@ type BareMetalCreate struct {
 	Tags          []string `json:"tags"`
 }
 
+// Validate checks that the region and plan are given, that the server is created from exactly
+// one of an OS, application, image or snapshot, that the hostname is valid, and that the user
+// data is base64 encoded and within MaxUserDataSize
+func (r BareMetalCreate) Validate() error {
+	v := new(validation)
+	v.required("region", r.Region, "plan", r.Plan)
+	v.exactlyOne([]string{"os_id", "app_id", "image_id", "snapshot_id"}, r.OsID != 0, r.AppID != 0, r.ImageID != "", r.SnapshotID != "")
+	v.hostname("hostname", r.Hostname)
+	v.userData("user_data", r.UserData)
+	return v.err()
+}
+
 // BareMetalUpdate represents the optional parameters that can be set when updating a Bare Metal server
 type BareMetalUpdate struct {
-	OsID       int    `json:"os_id,omitempty"`
-	EnableIPv6 *bool  `json:"enable_ipv6,omitempty"`
-	Label      string `json:"label,omitempty"`
-	AppID      int    `json:"app_id,omitempty"`
-	ImageID    string `json:"image_id,omitempty"`
-	UserData   string `json:"user_data,omitempty"`
+	OsID       Optional[int]    `json:"os_id,omitzero"`
+	EnableIPv6 Optional[bool]   `json:"enable_ipv6,omitzero"`
+	Label      Optional[string] `json:"label,omitzero"`
+	AppID      Optional[int]    `json:"app_id,omitzero"`
+	ImageID    Optional[string] `json:"image_id,omitzero"`
+	UserData   Optional[string] `json:"user_data,omitzero"`
 	// Deprecated: Tag should no longer be used. Instead, use Tags.
-	Tag  *string  `json:"tag,omitempty"`
-	Tags []string `json:"tags"`
+	Tag  *string            `json:"tag,omitempty"`
+	Tags Optional[[]string] `json:"tags,omitzero"`
 }
 
-// BareMetalServerBandwidth represents bandwidth information for a Bare Metal server
-type BareMetalServerBandwidth struct {
-	IncomingBytes int `json:"incoming_bytes"`
-	OutgoingBytes int `json:"outgoing_bytes"`
+// validateUpdate checks that the user data, when set, is base64 encoded and within MaxUserDataSize
+func (r BareMetalUpdate) validateUpdate() error {
+	v := new(validation)
+	if userData, ok := r.UserData.Get(); ok {
+		v.userData("user_data", userData)
+	}
+	return v.err()
 }
 
+// BareMetalServerBandwidth represents bandwidth information for a Bare Metal server
+//
+// Deprecated: BareMetalServerBandwidth is BandwidthUsage, the type shared with instances.
+type BareMetalServerBandwidth = BandwidthUsage
+
 type bareMetalsBase struct {
 	BareMetals []BareMetalServer `json:"bare_metals"`
 	Meta       *Meta             `json:"meta"`
@@ -123,6 +143,8 @@ type bareMetalBase struct {
 }
 
 // BMBareMetalBase represents the base struct for a Bare Metal server
+//
+// Deprecated: BareMetalServerService.GetBandwidth returns a Bandwidth, like instances.
 type BMBareMetalBase struct {
 	BareMetalBandwidth map[string]BareMetalServerBandwidth `json:"bandwidth"`
 }
@@ -137,14 +159,14 @@ type VNCUrl struct {
 }
 
 // Create a new Bare Metal server.
-func (b *BareMetalServerServiceHandler) Create(ctx context.Context, bmCreate *BareMetalCreate) (*BareMetalServer, *http.Response, error) {
+func (b *BareMetalServerServiceHandler) Create(ctx context.Context, bmCreate *BareMetalCreate) (*BareMetalServer, *Response, error) {
 	req, err := b.client.NewRequest(ctx, http.MethodPost, bmPath, bmCreate)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	bm := new(bareMetalBase)
-	resp, err := b.client.DoWithContext(ctx, req, bm)
+	resp, err := doCreate(ctx, b.client, req, bm, &bm.BareMetal, b.createMatch(bmCreate))
 	if err != nil {
 		return nil, resp, err
 	}
@@ -152,8 +174,26 @@ func (b *BareMetalServerServiceHandler) Create(ctx context.Context, bmCreate *Ba
 	return bm.BareMetal, resp, nil
 }
 
+// createMatch recognizes the bare metal servers with the label, region and tags of bmCreate
+func (b *BareMetalServerServiceHandler) createMatch(bmCreate *BareMetalCreate) *createMatch[BareMetalServer] {
+	if bmCreate == nil || (bmCreate.Label == "" && len(bmCreate.Tags) == 0) {
+		return nil
+	}
+
+	return &createMatch[BareMetalServer]{
+		list: b.List,
+		match: func(bm *BareMetalServer) bool {
+			return (bmCreate.Label == "" || bm.Label == bmCreate.Label) &&
+				(bmCreate.Region == "" || bm.Region == bmCreate.Region) &&
+				hasTags(bm.Tags, bmCreate.Tags)
+		},
+		id:          func(bm *BareMetalServer) string { return bm.ID },
+		dateCreated: func(bm *BareMetalServer) Timestamp { return bm.DateCreated },
+	}
+}
+
 // Get information for a Bare Metal instance.
-func (b *BareMetalServerServiceHandler) Get(ctx context.Context, serverID string) (*BareMetalServer, *http.Response, error) {
+func (b *BareMetalServerServiceHandler) Get(ctx context.Context, serverID string) (*BareMetalServer, *Response, error) {
 	uri := fmt.Sprintf("%s/%s", bmPath, serverID)
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -170,7 +210,7 @@ func (b *BareMetalServerServiceHandler) Get(ctx context.Context, serverID string
 }
 
 // Update a Bare Metal server
-func (b *BareMetalServerServiceHandler) Update(ctx context.Context, serverID string, bmReq *BareMetalUpdate) (*BareMetalServer, *http.Response, error) { //nolint:lll
+func (b *BareMetalServerServiceHandler) Update(ctx context.Context, serverID string, bmReq *BareMetalUpdate) (*BareMetalServer, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s", bmPath, serverID)
 	req, err := b.client.NewRequest(ctx, http.MethodPatch, uri, bmReq)
 	if err != nil {
@@ -187,18 +227,17 @@ func (b *BareMetalServerServiceHandler) Update(ctx context.Context, serverID str
 }
 
 // Delete a Bare Metal server.
-func (b *BareMetalServerServiceHandler) Delete(ctx context.Context, serverID string) error {
+func (b *BareMetalServerServiceHandler) Delete(ctx context.Context, serverID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s", bmPath, serverID)
 	req, err := b.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return nil
+		return nil, nil
 	}
-	_, err = b.client.DoWithContext(ctx, req, nil)
-	return err
+	return b.client.DoWithContext(ctx, req, nil)
 }
 
 // List all Bare Metal instances in your account.
-func (b *BareMetalServerServiceHandler) List(ctx context.Context, options *ListOptions) ([]BareMetalServer, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (b *BareMetalServerServiceHandler) List(ctx context.Context, options *ListOptions) ([]BareMetalServer, *Meta, *Response, error) { //nolint:dupl,lll
 	req, err := b.client.NewRequest(ctx, http.MethodGet, bmPath, nil)
 	if err != nil {
 		return nil, nil, nil, err
@@ -221,7 +260,7 @@ func (b *BareMetalServerServiceHandler) List(ctx context.Context, options *ListO
 }
 
 // GetBandwidth  used by a Bare Metal server.
-func (b *BareMetalServerServiceHandler) GetBandwidth(ctx context.Context, serverID string) (*Bandwidth, *http.Response, error) {
+func (b *BareMetalServerServiceHandler) GetBandwidth(ctx context.Context, serverID string) (*Bandwidth, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/bandwidth", bmPath, serverID)
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -229,7 +268,7 @@ func (b *BareMetalServerServiceHandler) GetBandwidth(ctx context.Context, server
 	}
 
 	bms := new(Bandwidth)
-	resp, err := b.client.DoWithContext(ctx, req, &bms)
+	resp, err := b.client.DoWithContext(ctx, req, bms)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -237,8 +276,8 @@ func (b *BareMetalServerServiceHandler) GetBandwidth(ctx context.Context, server
 	return bms, resp, nil
 }
 
-// GetUserData for a Bare Metal server. The userdata returned will be in base64 encoding.
-func (b *BareMetalServerServiceHandler) GetUserData(ctx context.Context, serverID string) (*UserData, *http.Response, error) {
+// GetUserData for a Bare Metal server. The userdata returned will be in base64 encoding, use UserData.Decode to decode it.
+func (b *BareMetalServerServiceHandler) GetUserData(ctx context.Context, serverID string) (*UserData, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/user-data", bmPath, serverID)
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -248,14 +287,14 @@ func (b *BareMetalServerServiceHandler) GetUserData(ctx context.Context, serverI
 	userData := new(userDataBase)
 	resp, err := b.client.DoWithContext(ctx, req, userData)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return userData.UserData, resp, nil
 }
 
 // GetVNCUrl gets the vnc url for a given Bare Metal server.
-func (b *BareMetalServerServiceHandler) GetVNCUrl(ctx context.Context, serverID string) (*VNCUrl, *http.Response, error) {
+func (b *BareMetalServerServiceHandler) GetVNCUrl(ctx context.Context, serverID string) (*VNCUrl, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/vnc", bmPath, serverID)
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -273,7 +312,7 @@ func (b *BareMetalServerServiceHandler) GetVNCUrl(ctx context.Context, serverID
 
 // ListIPv4s information of a Bare Metal server.
 // IP information is only available for Bare Metal servers in the "active" state.
-func (b *BareMetalServerServiceHandler) ListIPv4s(ctx context.Context, serverID string, options *ListOptions) ([]IPv4, *Meta, *http.Response, error) { //nolint:lll,dupl
+func (b *BareMetalServerServiceHandler) ListIPv4s(ctx context.Context, serverID string, options *ListOptions) ([]IPv4, *Meta, *Response, error) { //nolint:lll,dupl
 	uri := fmt.Sprintf("%s/%s/ipv4", bmPath, serverID)
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -299,7 +338,7 @@ func (b *BareMetalServerServiceHandler) ListIPv4s(ctx context.Context, serverID
 // ListIPv6s information of a Bare Metal server.
 // IP information is only available for Bare Metal servers in the "active" state.
 // If the Bare Metal server does not have IPv6 enabled, then an empty array is returned.
-func (b *BareMetalServerServiceHandler) ListIPv6s(ctx context.Context, serverID string, options *ListOptions) ([]IPv6, *Meta, *http.Response, error) { //nolint:lll,dupl
+func (b *BareMetalServerServiceHandler) ListIPv6s(ctx context.Context, serverID string, options *ListOptions) ([]IPv6, *Meta, *Response, error) { //nolint:lll,dupl
 	uri := fmt.Sprintf("%s/%s/ipv6", bmPath, serverID)
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -325,45 +364,42 @@ func (b *BareMetalServerServiceHandler) ListIPv6s(ctx context.Context, serverID
 // Halt a Bare Metal server.
 // This is a hard power off, meaning that the power to the machine is severed.
 // The data on the machine will not be modified, and you will still be billed for the machine.
-func (b *BareMetalServerServiceHandler) Halt(ctx context.Context, serverID string) error {
+func (b *BareMetalServerServiceHandler) Halt(ctx context.Context, serverID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/halt", bmPath, serverID)
 	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = b.client.DoWithContext(ctx, req, nil)
-	return err
+	return b.client.DoWithContext(ctx, req, nil)
 }
 
 // Reboot a Bare Metal server. This is a hard reboot, which means that the server is powered off, then back on.
-func (b *BareMetalServerServiceHandler) Reboot(ctx context.Context, serverID string) error {
+func (b *BareMetalServerServiceHandler) Reboot(ctx context.Context, serverID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/reboot", bmPath, serverID)
 
 	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = b.client.DoWithContext(ctx, req, nil)
-	return err
+	return b.client.DoWithContext(ctx, req, nil)
 }
 
 // Start a Bare Metal server.
-func (b *BareMetalServerServiceHandler) Start(ctx context.Context, serverID string) error {
+func (b *BareMetalServerServiceHandler) Start(ctx context.Context, serverID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/start", bmPath, serverID)
 	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = b.client.DoWithContext(ctx, req, nil)
-	return err
+	return b.client.DoWithContext(ctx, req, nil)
 }
 
 // Reinstall the operating system on a Bare Metal server.
 // All data will be permanently lost, but the IP address will remain the same.
-func (b *BareMetalServerServiceHandler) Reinstall(ctx context.Context, serverID string) (*BareMetalServer, *http.Response, error) {
+func (b *BareMetalServerServiceHandler) Reinstall(ctx context.Context, serverID string) (*BareMetalServer, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/reinstall", bmPath, serverID)
 	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, nil)
 	if err != nil {
@@ -380,46 +416,43 @@ func (b *BareMetalServerServiceHandler) Reinstall(ctx context.Context, serverID
 }
 
 // MassStart will start a list of Bare Metal servers the machine is already running, it will be restarted.
-func (b *BareMetalServerServiceHandler) MassStart(ctx context.Context, serverList []string) error {
+func (b *BareMetalServerServiceHandler) MassStart(ctx context.Context, serverList []string) (*Response, error) {
 	uri := fmt.Sprintf("%s/start", bmPath)
 
 	reqBody := RequestBody{"baremetal_ids": serverList}
 	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, reqBody)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = b.client.DoWithContext(ctx, req, nil)
-	return err
+	return b.client.DoWithContext(ctx, req, nil)
 }
 
 // MassHalt a list of Bare Metal servers.
-func (b *BareMetalServerServiceHandler) MassHalt(ctx context.Context, serverList []string) error {
+func (b *BareMetalServerServiceHandler) MassHalt(ctx context.Context, serverList []string) (*Response, error) {
 	uri := fmt.Sprintf("%s/halt", bmPath)
 
 	reqBody := RequestBody{"baremetal_ids": serverList}
 	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, reqBody)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = b.client.DoWithContext(ctx, req, nil)
-	return err
+	return b.client.DoWithContext(ctx, req, nil)
 }
 
 // MassReboot a list of Bare Metal servers.
-func (b *BareMetalServerServiceHandler) MassReboot(ctx context.Context, serverList []string) error {
+func (b *BareMetalServerServiceHandler) MassReboot(ctx context.Context, serverList []string) (*Response, error) {
 	uri := fmt.Sprintf("%s/reboot", bmPath)
 
 	reqBody := RequestBody{"baremetal_ids": serverList}
 	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, reqBody)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = b.client.DoWithContext(ctx, req, nil)
-	return err
+	return b.client.DoWithContext(ctx, req, nil)
 }
 
 // GetUpgrades that are available for a Bare Metal server.
-func (b *BareMetalServerServiceHandler) GetUpgrades(ctx context.Context, serverID string) (*Upgrades, *http.Response, error) {
+func (b *BareMetalServerServiceHandler) GetUpgrades(ctx context.Context, serverID string) (*Upgrades, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/upgrades", bmPath, serverID)
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -54,7 +54,7 @@ func TestBareMetalServerServiceHandler_GetServer(t *testing.T) {
 		MainIP:        "203.0.113.10",
 		CPUCount:      1,
 		Region:        "ewr",
-		DateCreated:   "2017-04-12 18:45:41",
+		DateCreated:   timestamp("2017-04-12 18:45:41"),
 		Status:        "active",
 		NetmaskV4:     "255.255.255.0",
 		GatewayV4:     "203.0.113.1",
@@ -117,8 +117,7 @@ func TestBareMetalServerServiceHandler_Create(t *testing.T) {
 		EnableIPv6:      BoolToBoolPtr(true),
 		Label:           "go-bm-test",
 		SSHKeyIDs:       []string{"6b80207b1821f"},
-		AppID:           1,
-		UserData:        "echo Hello World",
+		UserData:        "ZWNobyBIZWxsbyBXb3JsZA==",
 		ActivationEmail: BoolToBoolPtr(true),
 		Hostname:        "test",
 		Tags:            []string{"my tag"},
@@ -140,7 +139,7 @@ func TestBareMetalServerServiceHandler_Create(t *testing.T) {
 		MainIP:          "203.0.113.10",
 		CPUCount:        1,
 		DefaultPassword: "ab81u!ryranq",
-		DateCreated:     "2017-04-12 18:45:41",
+		DateCreated:     timestamp("2017-04-12 18:45:41"),
 		Status:          "active",
 		NetmaskV4:       "255.255.255.0",
 		GatewayV4:       "203.0.113.1",
@@ -196,7 +195,7 @@ func TestBareMetalServerServiceHandler_Update(t *testing.T) {
 	})
 
 	options := &BareMetalUpdate{
-		Label: "my new label",
+		Label: NewOptional("my new label"),
 	}
 
 	bm, _, err := client.BareMetalServer.Update(ctx, "dev-preview-abc123", options)
@@ -212,7 +211,7 @@ func TestBareMetalServerServiceHandler_Update(t *testing.T) {
 		MainIP:          "203.0.113.10",
 		CPUCount:        1,
 		DefaultPassword: "ab81u!ryranq",
-		DateCreated:     "2017-04-12 18:45:41",
+		DateCreated:     timestamp("2017-04-12 18:45:41"),
 		Status:          "active",
 		NetmaskV4:       "255.255.255.0",
 		GatewayV4:       "203.0.113.1",
@@ -240,7 +239,7 @@ func TestBareMetalServerServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.BareMetalServer.Delete(ctx, "900000")
+	_, err := client.BareMetalServer.Delete(ctx, "900000")
 
 	if err != nil {
 		t.Errorf("BareMetalServer.Delete returned %+v, expected %+v", err, nil)
@@ -296,7 +295,7 @@ func TestBareMetalServerServiceHandler_List(t *testing.T) {
 			MainIP:        "203.0.113.10",
 			CPUCount:      1,
 			Region:        "ewr",
-			DateCreated:   "2017-04-12 18:45:41",
+			DateCreated:   timestamp("2017-04-12 18:45:41"),
 			Status:        "active",
 			NetmaskV4:     "255.255.255.0",
 			GatewayV4:     "203.0.113.1",
@@ -341,10 +340,7 @@ func TestBareMetalServerServiceHandler_GetBandwidth(t *testing.T) {
 	}
 
 	expected := &Bandwidth{
-		Bandwidth: map[string]struct {
-			IncomingBytes int `json:"incoming_bytes"`
-			OutgoingBytes int `json:"outgoing_bytes"`
-		}{
+		Bandwidth: map[string]BandwidthUsage{
 			"2017-04-01": {
 				IncomingBytes: 91571055,
 				OutgoingBytes: 3084731,
@@ -365,7 +361,7 @@ func TestBareMetalServerServiceHandler_Halt(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.BareMetalServer.Halt(ctx, "900000")
+	_, err := client.BareMetalServer.Halt(ctx, "900000")
 
 	if err != nil {
 		t.Errorf("BareMetalServer.Halt returned %+v, expected %+v", err, nil)
@@ -468,7 +464,7 @@ func TestBareMetalServerServiceHandler_Reboot(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.BareMetalServer.Reboot(ctx, "900000")
+	_, err := client.BareMetalServer.Reboot(ctx, "900000")
 
 	if err != nil {
 		t.Errorf("BareMetalServer.Reboot returned %+v, expected %+v", err, nil)
@@ -522,7 +518,7 @@ func TestBareMetalServerServiceHandler_Reinstall(t *testing.T) {
 		MainIP:          "203.0.113.10",
 		CPUCount:        1,
 		DefaultPassword: "ab81u!ryranq",
-		DateCreated:     "2017-04-12 18:45:41",
+		DateCreated:     timestamp("2017-04-12 18:45:41"),
 		Status:          "active",
 		NetmaskV4:       "255.255.255.0",
 		GatewayV4:       "203.0.113.1",
@@ -550,7 +546,7 @@ func TestBareMetalServerServiceHandler_Start(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.BareMetalServer.Start(ctx, "42018b7b-a4e3-4c7e-be74-663afeb142aa")
+	_, err := client.BareMetalServer.Start(ctx, "42018b7b-a4e3-4c7e-be74-663afeb142aa")
 
 	if err != nil {
 		t.Errorf("BareMetalServer.Start returned %+v, expected %+v", err, nil)
@@ -673,7 +669,7 @@ func TestBareMetalServerServiceHandler_MassStart(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.BareMetalServer.MassStart(ctx, []string{"42018b7b-a4e3-4c7e-be74-663afeb142aa"})
+	_, err := client.BareMetalServer.MassStart(ctx, []string{"42018b7b-a4e3-4c7e-be74-663afeb142aa"})
 
 	if err != nil {
 		t.Errorf("BareMetalServer.MassStart returned %+v, expected %+v", err, nil)
@@ -688,7 +684,7 @@ func TestBareMetalServerServiceHandler_MassReboot(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.BareMetalServer.MassReboot(ctx, []string{"42018b7b-a4e3-4c7e-be74-663afeb142aa"})
+	_, err := client.BareMetalServer.MassReboot(ctx, []string{"42018b7b-a4e3-4c7e-be74-663afeb142aa"})
 
 	if err != nil {
 		t.Errorf("BareMetalServer.Reboot returned %+v, expected %+v", err, nil)
@@ -703,7 +699,7 @@ func TestBareMetalServerServiceHandler_MassHalt(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.BareMetalServer.MassHalt(ctx, []string{"42018b7b-a4e3-4c7e-be74-663afeb142aa"})
+	_, err := client.BareMetalServer.MassHalt(ctx, []string{"42018b7b-a4e3-4c7e-be74-663afeb142aa"})
 
 	if err != nil {
 		t.Errorf("BareMetalServer.MassHalf returned %+v, expected %+v", err, nil)
@@ -750,12 +746,10 @@ func TestBareMetalServerServiceHandler_CreateMarketplaceImage(t *testing.T) {
 		StartupScriptID: "1",
 		Region:          "ewr",
 		Plan:            "vbm-4c-32gb",
-		SnapshotID:      "1",
 		EnableIPv6:      BoolToBoolPtr(true),
 		Label:           "go-bm-test",
 		SSHKeyIDs:       []string{"6b80207b1821f"},
-		AppID:           1,
-		UserData:        "echo Hello World",
+		UserData:        "ZWNobyBIZWxsbyBXb3JsZA==",
 		ActivationEmail: BoolToBoolPtr(true),
 		Hostname:        "test",
 		Tags:            []string{"go-test"},
@@ -778,7 +772,7 @@ func TestBareMetalServerServiceHandler_CreateMarketplaceImage(t *testing.T) {
 		MainIP:          "203.0.113.10",
 		CPUCount:        1,
 		DefaultPassword: "ab81u!ryranq",
-		DateCreated:     "2017-04-12 18:45:41",
+		DateCreated:     timestamp("2017-04-12 18:45:41"),
 		Status:          "active",
 		NetmaskV4:       "255.255.255.0",
 		GatewayV4:       "203.0.113.1",
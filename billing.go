@@ -11,10 +11,10 @@ import (
 // BillingService is the interface to interact with the billing endpoint on the Vultr API
 // Link : https://www.vultr.com/api/#tag/billing
 type BillingService interface {
-	ListHistory(ctx context.Context, options *ListOptions) ([]History, *Meta, *http.Response, error)
-	ListInvoices(ctx context.Context, options *ListOptions) ([]Invoice, *Meta, *http.Response, error)
-	GetInvoice(ctx context.Context, invoiceID string) (*Invoice, *http.Response, error)
-	ListInvoiceItems(ctx context.Context, invoiceID int, options *ListOptions) ([]InvoiceItem, *Meta, *http.Response, error)
+	ListHistory(ctx context.Context, options *ListOptions) ([]History, *Meta, *Response, error)
+	ListInvoices(ctx context.Context, options *ListOptions) ([]Invoice, *Meta, *Response, error)
+	GetInvoice(ctx context.Context, invoiceID string) (*Invoice, *Response, error)
+	ListInvoiceItems(ctx context.Context, invoiceID int, options *ListOptions) ([]InvoiceItem, *Meta, *Response, error)
 }
 
 // BillingServiceHandler handles interaction with the billing methods for the Vultr API
@@ -24,33 +24,33 @@ type BillingServiceHandler struct {
 
 // History represents a billing history item on an account
 type History struct {
-	ID          int     `json:"id"`
-	Date        string  `json:"date"`
-	Type        string  `json:"type"`
-	Description string  `json:"description"`
-	Amount      float32 `json:"amount"`
-	Balance     float32 `json:"balance"`
+	ID          int       `json:"id"`
+	Date        Timestamp `json:"date"`
+	Type        string    `json:"type"`
+	Description string    `json:"description"`
+	Amount      float32   `json:"amount"`
+	Balance     float32   `json:"balance"`
 }
 
 // Invoice represents an invoice on an account
 type Invoice struct {
-	ID          int     `json:"id"`
-	Date        string  `json:"date"`
-	Description string  `json:"description"`
-	Amount      float32 `json:"amount"`
-	Balance     float32 `json:"balance"`
+	ID          int       `json:"id"`
+	Date        Timestamp `json:"date"`
+	Description string    `json:"description"`
+	Amount      float32   `json:"amount"`
+	Balance     float32   `json:"balance"`
 }
 
 // InvoiceItem represents an item on an accounts invoice
 type InvoiceItem struct {
-	Description string  `json:"description"`
-	Product     string  `json:"product"`
-	StartDate   string  `json:"start_date"`
-	EndDate     string  `json:"end_date"`
-	Units       int     `json:"units"`
-	UnitType    string  `json:"unit_type"`
-	UnitPrice   float32 `json:"unit_price"`
-	Total       float32 `json:"total"`
+	Description string    `json:"description"`
+	Product     string    `json:"product"`
+	StartDate   Timestamp `json:"start_date"`
+	EndDate     Timestamp `json:"end_date"`
+	Units       int       `json:"units"`
+	UnitType    string    `json:"unit_type"`
+	UnitPrice   float32   `json:"unit_price"`
+	Total       float32   `json:"total"`
 }
 
 type billingHistoryBase struct {
@@ -73,7 +73,7 @@ type invoiceItemsBase struct {
 }
 
 // ListHistory retrieves a list of all billing history on the current account
-func (b *BillingServiceHandler) ListHistory(ctx context.Context, options *ListOptions) ([]History, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (b *BillingServiceHandler) ListHistory(ctx context.Context, options *ListOptions) ([]History, *Meta, *Response, error) { //nolint:dupl,lll
 	uri := "/v2/billing/history"
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -97,7 +97,7 @@ func (b *BillingServiceHandler) ListHistory(ctx context.Context, options *ListOp
 }
 
 // ListInvoices retrieves a list of all billing invoices on the current account
-func (b *BillingServiceHandler) ListInvoices(ctx context.Context, options *ListOptions) ([]Invoice, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (b *BillingServiceHandler) ListInvoices(ctx context.Context, options *ListOptions) ([]Invoice, *Meta, *Response, error) { //nolint:dupl,lll
 	uri := "/v2/billing/invoices"
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -121,7 +121,7 @@ func (b *BillingServiceHandler) ListInvoices(ctx context.Context, options *ListO
 }
 
 // GetInvoice retrieves an invoice that matches the given invoiceID
-func (b *BillingServiceHandler) GetInvoice(ctx context.Context, invoiceID string) (*Invoice, *http.Response, error) {
+func (b *BillingServiceHandler) GetInvoice(ctx context.Context, invoiceID string) (*Invoice, *Response, error) {
 	uri := fmt.Sprintf("/v2/billing/invoices/%s", invoiceID)
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
 
@@ -139,7 +139,7 @@ func (b *BillingServiceHandler) GetInvoice(ctx context.Context, invoiceID string
 }
 
 // ListInvoiceItems retrieves items in an invoice that matches the given invoiceID
-func (b *BillingServiceHandler) ListInvoiceItems(ctx context.Context, invoiceID int, options *ListOptions) ([]InvoiceItem, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (b *BillingServiceHandler) ListInvoiceItems(ctx context.Context, invoiceID int, options *ListOptions) ([]InvoiceItem, *Meta, *Response, error) { //nolint:dupl,lll
 	uri := fmt.Sprintf("/v2/billing/invoices/%d/items", invoiceID)
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
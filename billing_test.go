@@ -45,7 +45,7 @@ func TestBillingServiceHandler_ListHistory(t *testing.T) {
 	expected := []History{
 		{
 			ID:          5317720,
-			Date:        "2018-04-01T00:30:05+00:00",
+			Date:        timestamp("2018-04-01T00:30:05+00:00"),
 			Type:        "invoice",
 			Description: "Invoice #5317720",
 			Amount:      2.35,
@@ -104,7 +104,7 @@ func TestBillingServiceHandler_ListInvoices(t *testing.T) {
 	expected := []Invoice{
 		{
 			ID:          5317720,
-			Date:        "2018-04-01T00:30:05+00:00",
+			Date:        timestamp("2018-04-01T00:30:05+00:00"),
 			Description: "Invoice #5317720",
 			Amount:      2.35,
 			Balance:     -497.65,
@@ -242,7 +242,7 @@ func TestBillingServiceHandler_GetInvoice(t *testing.T) {
 
 	expected := &Invoice{
 		ID:          123456,
-		Date:        "2018-04-01T00:30:05+00:00",
+		Date:        timestamp("2018-04-01T00:30:05+00:00"),
 		Description: "Invoice #5317782",
 		Amount:      2.35,
 		Balance:     -497.65,
@@ -293,8 +293,8 @@ func TestBillingServiceHandler_ListInvoiceItems(t *testing.T) {
 		{
 			Description: "1.1.1.1 (1024 MB)",
 			Product:     "Vultr Cloud Compute",
-			StartDate:   "2018-03-18T21:57:58+00:00",
-			EndDate:     "2018-04-01T00:00:00+00:00",
+			StartDate:   timestamp("2018-03-18T21:57:58+00:00"),
+			EndDate:     timestamp("2018-04-01T00:00:00+00:00"),
 			Units:       315,
 			UnitType:    "hours",
 			UnitPrice:   0.0074,
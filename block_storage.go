@@ -11,14 +11,14 @@ import (
 // BlockStorageService is the interface to interact with Block-Storage endpoint on the Vultr API
 // Link : https://www.vultr.com/api/#tag/block
 type BlockStorageService interface {
-	Create(ctx context.Context, blockReq *BlockStorageCreate) (*BlockStorage, *http.Response, error)
-	Get(ctx context.Context, blockID string) (*BlockStorage, *http.Response, error)
-	Update(ctx context.Context, blockID string, blockReq *BlockStorageUpdate) error
-	Delete(ctx context.Context, blockID string) error
-	List(ctx context.Context, options *ListOptions) ([]BlockStorage, *Meta, *http.Response, error)
+	Create(ctx context.Context, blockReq *BlockStorageCreate) (*BlockStorage, *Response, error)
+	Get(ctx context.Context, blockID string) (*BlockStorage, *Response, error)
+	Update(ctx context.Context, blockID string, blockReq *BlockStorageUpdate) (*Response, error)
+	Delete(ctx context.Context, blockID string) (*Response, error)
+	List(ctx context.Context, options *ListOptions) ([]BlockStorage, *Meta, *Response, error)
 
-	Attach(ctx context.Context, blockID string, attach *BlockStorageAttach) error
-	Detach(ctx context.Context, blockID string, detach *BlockStorageDetach) error
+	Attach(ctx context.Context, blockID string, attach *BlockStorageAttach) (*Response, error)
+	Detach(ctx context.Context, blockID string, detach *BlockStorageDetach) (*Response, error)
 }
 
 // BlockStorageServiceHandler handles interaction with the block-storage methods for the Vultr API
@@ -28,30 +28,61 @@ type BlockStorageServiceHandler struct {
 
 // BlockStorage represents Vultr Block-Storage
 type BlockStorage struct {
-	ID                 string  `json:"id"`
-	Cost               float32 `json:"cost"`
-	Status             string  `json:"status"`
-	SizeGB             int     `json:"size_gb"`
-	Region             string  `json:"region"`
-	DateCreated        string  `json:"date_created"`
-	AttachedToInstance string  `json:"attached_to_instance"`
-	Label              string  `json:"label"`
-	MountID            string  `json:"mount_id"`
-	BlockType          string  `json:"block_type"`
+	ID                 string    `json:"id"`
+	Cost               float32   `json:"cost"`
+	Status             string    `json:"status"`
+	SizeGB             int       `json:"size_gb"`
+	Region             string    `json:"region"`
+	DateCreated        Timestamp `json:"date_created"`
+	AttachedToInstance string    `json:"attached_to_instance"`
+	Label              string    `json:"label"`
+	MountID            string    `json:"mount_id"`
+	BlockType          BlockType `json:"block_type"`
+}
+
+// BlockType is the storage class of a block storage
+type BlockType string
+
+// Block types
+const (
+	BlockTypeHighPerf   BlockType = "high_perf"
+	BlockTypeStorageOpt BlockType = "storage_opt"
+)
+
+// IsValid reports whether t is a known block type
+func (t BlockType) IsValid() bool {
+	switch t {
+	case BlockTypeHighPerf, BlockTypeStorageOpt:
+		return true
+	}
+	return false
 }
 
 // BlockStorageCreate struct is used for creating Block Storage.
 type BlockStorageCreate struct {
-	Region    string `json:"region"`
-	SizeGB    int    `json:"size_gb"`
-	Label     string `json:"label,omitempty"`
-	BlockType string `json:"block_type,omitempty"`
+	Region    string    `json:"region"`
+	SizeGB    int       `json:"size_gb"`
+	Label     string    `json:"label,omitempty"`
+	BlockType BlockType `json:"block_type,omitempty"`
+}
+
+// Validate checks that the region and size are given, and the block type when given
+func (r BlockStorageCreate) Validate() error {
+	v := new(validation)
+	v.required("region", r.Region)
+	if r.SizeGB <= 0 {
+		v.add("size_gb", "is required")
+	}
+	if r.BlockType != "" && !r.BlockType.IsValid() {
+		v.add("block_type", "%q is not a known block type", r.BlockType)
+	}
+	return v.err()
 }
 
 // BlockStorageUpdate struct is used to update Block Storage.
 type BlockStorageUpdate struct {
-	SizeGB int    `json:"size_gb,omitempty"`
-	Label  string `json:"label,omitempty"`
+	SizeGB Optional[int]    `json:"size_gb,omitzero"`
+	Label  Optional[string] `json:"label,omitzero"`
 }
 
 // BlockStorageAttach struct used to define if a attach should be restart the instance.
@@ -75,7 +106,7 @@ type blockStorageBase struct {
 }
 
 // Create builds out a block storage
-func (b *BlockStorageServiceHandler) Create(ctx context.Context, blockReq *BlockStorageCreate) (*BlockStorage, *http.Response, error) {
+func (b *BlockStorageServiceHandler) Create(ctx context.Context, blockReq *BlockStorageCreate) (*BlockStorage, *Response, error) {
 	uri := "/v2/blocks"
 
 	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, blockReq)
@@ -84,7 +115,7 @@ func (b *BlockStorageServiceHandler) Create(ctx context.Context, blockReq *Block
 	}
 
 	block := new(blockStorageBase)
-	resp, err := b.client.DoWithContext(ctx, req, block)
+	resp, err := doCreate(ctx, b.client, req, block, &block.Block, b.createMatch(blockReq))
 	if err != nil {
 		return nil, resp, err
 	}
@@ -92,8 +123,24 @@ func (b *BlockStorageServiceHandler) Create(ctx context.Context, blockReq *Block
 	return block.Block, resp, nil
 }
 
+// createMatch recognizes the block storages with the label, region and size of blockReq
+func (b *BlockStorageServiceHandler) createMatch(blockReq *BlockStorageCreate) *createMatch[BlockStorage] {
+	if blockReq == nil || blockReq.Label == "" {
+		return nil
+	}
+
+	return &createMatch[BlockStorage]{
+		list: b.List,
+		match: func(block *BlockStorage) bool {
+			return block.Label == blockReq.Label && block.Region == blockReq.Region && block.SizeGB == blockReq.SizeGB
+		},
+		id:          func(block *BlockStorage) string { return block.ID },
+		dateCreated: func(block *BlockStorage) Timestamp { return block.DateCreated },
+	}
+}
+
 // Get returns a single block storage instance based ony our blockID you provide from your Vultr Account
-func (b *BlockStorageServiceHandler) Get(ctx context.Context, blockID string) (*BlockStorage, *http.Response, error) {
+func (b *BlockStorageServiceHandler) Get(ctx context.Context, blockID string) (*BlockStorage, *Response, error) {
 	uri := fmt.Sprintf("/v2/blocks/%s", blockID)
 
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -111,31 +158,29 @@ func (b *BlockStorageServiceHandler) Get(ctx context.Context, blockID string) (*
 }
 
 // Update a block storage subscription.
-func (b *BlockStorageServiceHandler) Update(ctx context.Context, blockID string, blockReq *BlockStorageUpdate) error {
+func (b *BlockStorageServiceHandler) Update(ctx context.Context, blockID string, blockReq *BlockStorageUpdate) (*Response, error) {
 	uri := fmt.Sprintf("/v2/blocks/%s", blockID)
 
 	req, err := b.client.NewRequest(ctx, http.MethodPatch, uri, blockReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = b.client.DoWithContext(ctx, req, nil)
-	return err
+	return b.client.DoWithContext(ctx, req, nil)
 }
 
 // Delete a block storage subscription from your Vultr account
-func (b *BlockStorageServiceHandler) Delete(ctx context.Context, blockID string) error {
+func (b *BlockStorageServiceHandler) Delete(ctx context.Context, blockID string) (*Response, error) {
 	uri := fmt.Sprintf("/v2/blocks/%s", blockID)
 
 	req, err := b.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = b.client.DoWithContext(ctx, req, nil)
-	return err
+	return b.client.DoWithContext(ctx, req, nil)
 }
 
 // List returns a list of all block storage instances on your Vultr Account
-func (b *BlockStorageServiceHandler) List(ctx context.Context, options *ListOptions) ([]BlockStorage, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (b *BlockStorageServiceHandler) List(ctx context.Context, options *ListOptions) ([]BlockStorage, *Meta, *Response, error) { //nolint:dupl,lll
 	uri := "/v2/blocks"
 
 	req, err := b.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -161,26 +206,24 @@ func (b *BlockStorageServiceHandler) List(ctx context.Context, options *ListOpti
 
 // Attach will link a given block storage to a given Vultr instance
 // If Live is set to true the block storage will be attached without reloading the instance
-func (b *BlockStorageServiceHandler) Attach(ctx context.Context, blockID string, attach *BlockStorageAttach) error {
+func (b *BlockStorageServiceHandler) Attach(ctx context.Context, blockID string, attach *BlockStorageAttach) (*Response, error) {
 	uri := fmt.Sprintf("/v2/blocks/%s/attach", blockID)
 
 	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, attach)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = b.client.DoWithContext(ctx, req, nil)
-	return err
+	return b.client.DoWithContext(ctx, req, nil)
 }
 
 // Detach will de-link a given block storage to the Vultr instance it is attached to
 // If Live is set to true the block storage will be detached without reloading the instance
-func (b *BlockStorageServiceHandler) Detach(ctx context.Context, blockID string, detach *BlockStorageDetach) error {
+func (b *BlockStorageServiceHandler) Detach(ctx context.Context, blockID string, detach *BlockStorageDetach) (*Response, error) {
 	uri := fmt.Sprintf("/v2/blocks/%s/detach", blockID)
 
 	req, err := b.client.NewRequest(ctx, http.MethodPost, uri, detach)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = b.client.DoWithContext(ctx, req, nil)
-	return err
+	return b.client.DoWithContext(ctx, req, nil)
 }
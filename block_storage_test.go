@@ -19,7 +19,7 @@ func TestBlockStorageServiceHandler_Create(t *testing.T) {
 		Region:    "ewr",
 		SizeGB:    100,
 		Label:     "mylabel",
-		BlockType: "test",
+		BlockType: BlockTypeHighPerf,
 	}
 	blockStorage, _, err := client.BlockStorage.Create(ctx, blockReq)
 	if err != nil {
@@ -32,7 +32,7 @@ func TestBlockStorageServiceHandler_Create(t *testing.T) {
 		Status:             "active",
 		SizeGB:             100,
 		Region:             "ewr",
-		DateCreated:        "01-01-1960",
+		DateCreated:        timestamp("01-01-1960"),
 		AttachedToInstance: "",
 		Label:              "mylabel",
 		MountID:            "ewr-123abc",
@@ -64,7 +64,7 @@ func TestBlockStorageServiceHandler_Get(t *testing.T) {
 		Status:             "active",
 		SizeGB:             100,
 		Region:             "ewr",
-		DateCreated:        "01-01-1960",
+		DateCreated:        timestamp("01-01-1960"),
 		AttachedToInstance: "",
 		Label:              "mylabel",
 		MountID:            "123abc",
@@ -85,9 +85,9 @@ func TestBlockStorageServiceHandler_Update(t *testing.T) {
 	})
 
 	blockUpdate := &BlockStorageUpdate{
-		Label: "unit-test-label-setter",
+		Label: NewOptional("unit-test-label-setter"),
 	}
-	err := client.BlockStorage.Update(ctx, "123456", blockUpdate)
+	_, err := client.BlockStorage.Update(ctx, "123456", blockUpdate)
 	if err != nil {
 		t.Errorf("BlockStorage.SetLabel returned %+v, expected %+v", err, nil)
 	}
@@ -101,7 +101,7 @@ func TestBlockStorageServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.BlockStorage.Delete(ctx, "123456")
+	_, err := client.BlockStorage.Delete(ctx, "123456")
 	if err != nil {
 		t.Errorf("BlockStorage.Delete returned %+v, expected %+v", err, nil)
 	}
@@ -128,7 +128,7 @@ func TestBlockStorageServiceHandler_List(t *testing.T) {
 			Status:             "active",
 			SizeGB:             100,
 			Region:             "ewr",
-			DateCreated:        "01-01-1960",
+			DateCreated:        timestamp("01-01-1960"),
 			AttachedToInstance: "",
 			Label:              "mylabel",
 			MountID:            "123abc",
@@ -165,7 +165,7 @@ func TestBlockStorageServiceHandler_Attach(t *testing.T) {
 		InstanceID: "1234",
 		Live:       BoolToBoolPtr(true),
 	}
-	err := client.BlockStorage.Attach(ctx, "12345", attach)
+	_, err := client.BlockStorage.Attach(ctx, "12345", attach)
 	if err != nil {
 		t.Errorf("BlockStorage.Attach returned %+v, expected %+v", err, nil)
 	}
@@ -179,7 +179,7 @@ func TestBlockStorageServiceHandler_Detach(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 	detach := &BlockStorageDetach{Live: BoolToBoolPtr(true)}
-	err := client.BlockStorage.Detach(ctx, "123456", detach)
+	_, err := client.BlockStorage.Detach(ctx, "123456", detach)
 	if err != nil {
 		t.Errorf("BlockStorage.Detach returned %+v, expected %+v", err, nil)
 	}
@@ -0,0 +1,297 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrBulkAborted is the error of the operations skipped by a fail-fast bulk operation after
+// another one failed
+var ErrBulkAborted = errors.New("bulk operation aborted")
+
+// BulkOption configures Bulk and the bulk executors
+type BulkOption func(*bulkOptions)
+
+type bulkOptions struct {
+	concurrency int
+	failFast    bool
+	progress    func(BulkProgress)
+}
+
+func defaultBulkOptions() *bulkOptions {
+	return &bulkOptions{concurrency: defaultRequestBurst}
+}
+
+// WithConcurrency bounds the number of operations in flight, 10 by default. Requests still go
+// through the rate limiter of the client, which bounds how many are sent per second.
+func WithConcurrency(n int) BulkOption {
+	return func(o *bulkOptions) {
+		if n > 0 {
+			o.concurrency = n
+		}
+	}
+}
+
+// WithFailFast stops starting operations once one fails, and cancels those in flight. The
+// operations not started fail with ErrBulkAborted. By default every operation is run whatever
+// the errors.
+func WithFailFast() BulkOption {
+	return func(o *bulkOptions) {
+		o.failFast = true
+	}
+}
+
+// WithBulkProgress calls fn after every operation completes, including the operations that did
+// not start, so the last call has Completed equal to Total. Calls are never concurrent.
+func WithBulkProgress(fn func(BulkProgress)) BulkOption {
+	return func(o *bulkOptions) {
+		o.progress = fn
+	}
+}
+
+// BulkProgress describes an operation of a bulk operation that just completed, or that failed
+// without starting
+type BulkProgress struct {
+	// ID and Err are the resource and error of the operation
+	ID  string
+	Err error
+	// Completed is the number of operations completed so far, Failed the number of them that failed
+	Completed int
+	Failed    int
+	Total     int
+}
+
+// BulkResult is the outcome of a bulk operation for a resource
+type BulkResult[T any] struct {
+	ID string
+	// Value is returned by the operation, such as the updated resource
+	Value    T
+	Response *Response
+	Err      error
+}
+
+// BulkResults are the outcome of a bulk operation, in the order of the IDs it was given
+type BulkResults[T any] []BulkResult[T]
+
+// Failed returns the results with an error
+func (r BulkResults[T]) Failed() BulkResults[T] {
+	var failed BulkResults[T]
+	for _, result := range r {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Err returns the errors of the failed results joined, each prefixed by its ID, or nil when
+// every operation succeeded
+func (r BulkResults[T]) Err() error {
+	var errs []error
+	for _, result := range r.Failed() {
+		errs = append(errs, fmt.Errorf("%s: %w", result.ID, result.Err))
+	}
+	return errors.Join(errs...)
+}
+
+// Bulk runs fn for every ID concurrently and returns a result per ID. Operations that did not
+// start because ctx was done fail with its error.
+func Bulk[T any](ctx context.Context, ids []string, fn func(ctx context.Context, id string) (T, *Response, error), opts ...BulkOption) BulkResults[T] { //nolint:lll
+	o := defaultBulkOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	results := make(BulkResults[T], len(ids))
+	progress := BulkProgress{Total: len(ids)}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, o.concurrency)
+
+	report := func(id string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		progress.ID, progress.Err = id, err
+		progress.Completed++
+		if err != nil {
+			progress.Failed++
+			if o.failFast {
+				cancel(fmt.Errorf("%w: %s failed", ErrBulkAborted, id))
+			}
+		}
+		if o.progress != nil {
+			o.progress(progress)
+		}
+	}
+
+	for i, id := range ids {
+		results[i].ID = id
+
+		if err := acquire(ctx, sem); err != nil {
+			results[i].Err = err
+			report(id, err)
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *BulkResult[T]) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result.Value, result.Response, result.Err = fn(ctx, result.ID)
+			report(result.ID, result.Err)
+		}(&results[i])
+	}
+
+	wg.Wait()
+	return results
+}
+
+// acquire takes a slot of sem, unless ctx is done
+func acquire(ctx context.Context, sem chan struct{}) error {
+	select {
+	case sem <- struct{}{}:
+		if ctx.Err() != nil {
+			<-sem
+			return context.Cause(ctx)
+		}
+		return nil
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
+
+// InstanceBulk runs operations on many instances at once. Unlike MassStart, MassHalt and
+// MassReboot, it reports the outcome of every instance.
+type InstanceBulk struct {
+	client *Client
+	opts   []BulkOption
+}
+
+// NewInstanceBulk returns an InstanceBulk using the services of client, configured by opts
+func NewInstanceBulk(client *Client, opts ...BulkOption) *InstanceBulk {
+	return &InstanceBulk{client: client, opts: opts}
+}
+
+// Do runs fn for every instance
+func (b *InstanceBulk) Do(ctx context.Context, instanceIDs []string, fn func(ctx context.Context, instanceID string) (*Response, error)) BulkResults[struct{}] { //nolint:lll
+	return Bulk(ctx, instanceIDs, func(ctx context.Context, id string) (struct{}, *Response, error) {
+		resp, err := fn(ctx, id)
+		return struct{}{}, resp, err
+	}, b.opts...)
+}
+
+// Start starts the instances
+func (b *InstanceBulk) Start(ctx context.Context, instanceIDs []string) BulkResults[struct{}] {
+	return b.Do(ctx, instanceIDs, b.client.Instance.Start)
+}
+
+// Halt halts the instances
+func (b *InstanceBulk) Halt(ctx context.Context, instanceIDs []string) BulkResults[struct{}] {
+	return b.Do(ctx, instanceIDs, b.client.Instance.Halt)
+}
+
+// Reboot reboots the instances
+func (b *InstanceBulk) Reboot(ctx context.Context, instanceIDs []string) BulkResults[struct{}] {
+	return b.Do(ctx, instanceIDs, b.client.Instance.Reboot)
+}
+
+// Delete deletes the instances
+func (b *InstanceBulk) Delete(ctx context.Context, instanceIDs []string) BulkResults[struct{}] {
+	return b.Do(ctx, instanceIDs, b.client.Instance.Delete)
+}
+
+// Update applies the same update to the instances
+func (b *InstanceBulk) Update(ctx context.Context, instanceIDs []string, req *InstanceUpdateReq) BulkResults[*Instance] {
+	return Bulk(ctx, instanceIDs, func(ctx context.Context, id string) (*Instance, *Response, error) {
+		return b.client.Instance.Update(ctx, id, req)
+	}, b.opts...)
+}
+
+// UpdateTags replaces the tags of the instances
+func (b *InstanceBulk) UpdateTags(ctx context.Context, instanceIDs []string, tags []string) BulkResults[*Instance] {
+	if tags == nil {
+		tags = []string{}
+	}
+	return b.Update(ctx, instanceIDs, &InstanceUpdateReq{Tags: NewOptional(tags)})
+}
+
+// AttachFirewallGroup puts the instances in a firewall group
+func (b *InstanceBulk) AttachFirewallGroup(ctx context.Context, instanceIDs []string, firewallGroupID string) BulkResults[*Instance] {
+	return b.Update(ctx, instanceIDs, &InstanceUpdateReq{FirewallGroupID: NewOptional(firewallGroupID)})
+}
+
+// SetBackupSchedule sets the same backup schedule on the instances
+func (b *InstanceBulk) SetBackupSchedule(ctx context.Context, instanceIDs []string, backup *BackupScheduleReq) BulkResults[struct{}] {
+	return b.Do(ctx, instanceIDs, func(ctx context.Context, id string) (*Response, error) {
+		return b.client.Instance.SetBackupSchedule(ctx, id, backup)
+	})
+}
+
+// Snapshot takes a snapshot of every instance, with the same description
+func (b *InstanceBulk) Snapshot(ctx context.Context, instanceIDs []string, description string) BulkResults[*Snapshot] {
+	return Bulk(ctx, instanceIDs, func(ctx context.Context, id string) (*Snapshot, *Response, error) {
+		return b.client.Snapshot.Create(ctx, &SnapshotReq{InstanceID: id, Description: description})
+	}, b.opts...)
+}
+
+// BareMetalBulk runs operations on many bare metal servers at once. Unlike MassStart, MassHalt
+// and MassReboot, it reports the outcome of every server.
+type BareMetalBulk struct {
+	client *Client
+	opts   []BulkOption
+}
+
+// NewBareMetalBulk returns a BareMetalBulk using the services of client, configured by opts
+func NewBareMetalBulk(client *Client, opts ...BulkOption) *BareMetalBulk {
+	return &BareMetalBulk{client: client, opts: opts}
+}
+
+// Do runs fn for every bare metal server
+func (b *BareMetalBulk) Do(ctx context.Context, serverIDs []string, fn func(ctx context.Context, serverID string) (*Response, error)) BulkResults[struct{}] { //nolint:lll
+	return Bulk(ctx, serverIDs, func(ctx context.Context, id string) (struct{}, *Response, error) {
+		resp, err := fn(ctx, id)
+		return struct{}{}, resp, err
+	}, b.opts...)
+}
+
+// Start starts the bare metal servers
+func (b *BareMetalBulk) Start(ctx context.Context, serverIDs []string) BulkResults[struct{}] {
+	return b.Do(ctx, serverIDs, b.client.BareMetalServer.Start)
+}
+
+// Halt halts the bare metal servers
+func (b *BareMetalBulk) Halt(ctx context.Context, serverIDs []string) BulkResults[struct{}] {
+	return b.Do(ctx, serverIDs, b.client.BareMetalServer.Halt)
+}
+
+// Reboot reboots the bare metal servers
+func (b *BareMetalBulk) Reboot(ctx context.Context, serverIDs []string) BulkResults[struct{}] {
+	return b.Do(ctx, serverIDs, b.client.BareMetalServer.Reboot)
+}
+
+// Delete deletes the bare metal servers
+func (b *BareMetalBulk) Delete(ctx context.Context, serverIDs []string) BulkResults[struct{}] {
+	return b.Do(ctx, serverIDs, b.client.BareMetalServer.Delete)
+}
+
+// Update applies the same update to the bare metal servers
+func (b *BareMetalBulk) Update(ctx context.Context, serverIDs []string, req *BareMetalUpdate) BulkResults[*BareMetalServer] {
+	return Bulk(ctx, serverIDs, func(ctx context.Context, id string) (*BareMetalServer, *Response, error) {
+		return b.client.BareMetalServer.Update(ctx, id, req)
+	}, b.opts...)
+}
+
+// UpdateTags replaces the tags of the bare metal servers
+func (b *BareMetalBulk) UpdateTags(ctx context.Context, serverIDs []string, tags []string) BulkResults[*BareMetalServer] {
+	if tags == nil {
+		tags = []string{}
+	}
+	return b.Update(ctx, serverIDs, &BareMetalUpdate{Tags: NewOptional(tags)})
+}
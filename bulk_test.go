@@ -0,0 +1,155 @@
+package govultr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBulk(t *testing.T) {
+	var inFlight, maxInFlight int32
+	var progress []BulkProgress
+
+	ids := []string{"a", "b", "c", "d", "e", "f"}
+	results := Bulk(context.Background(), ids, func(ctx context.Context, id string) (string, *Response, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			peak := atomic.LoadInt32(&maxInFlight)
+			if n <= peak || atomic.CompareAndSwapInt32(&maxInFlight, peak, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		if id == "c" {
+			return "", nil, errors.New("not found")
+		}
+		return strings.ToUpper(id), nil, nil
+	}, WithConcurrency(2), WithBulkProgress(func(p BulkProgress) {
+		progress = append(progress, p)
+	}))
+
+	if maxInFlight != 2 {
+		t.Errorf("Bulk ran %d operations at once, expected 2", maxInFlight)
+	}
+	for i, result := range results {
+		if result.ID != ids[i] || (result.Err == nil) == (result.ID == "c") {
+			t.Errorf("Bulk returned %+v for %s", result, ids[i])
+		}
+		if result.Err == nil && result.Value != strings.ToUpper(result.ID) {
+			t.Errorf("Bulk returned value %q for %s", result.Value, result.ID)
+		}
+	}
+	if last := progress[len(progress)-1]; len(progress) != 6 || last.Completed != 6 || last.Failed != 1 || last.Total != 6 {
+		t.Errorf("Bulk reported progress %+v", progress)
+	}
+	if err := results.Err(); err == nil || err.Error() != "c: not found" {
+		t.Errorf("BulkResults.Err returned %v, expected c: not found", err)
+	}
+}
+
+func TestBulk_FailFast(t *testing.T) {
+	var calls int32
+	var last BulkProgress
+	results := Bulk(context.Background(), []string{"a", "b", "c"}, func(ctx context.Context, id string) (struct{}, *Response, error) {
+		atomic.AddInt32(&calls, 1)
+		return struct{}{}, nil, fmt.Errorf("%s failed", id)
+	}, WithConcurrency(1), WithFailFast(), WithBulkProgress(func(p BulkProgress) { last = p }))
+
+	if calls != 1 {
+		t.Errorf("Bulk ran %d operations, expected 1", calls)
+	}
+	if len(results.Failed()) != 3 {
+		t.Errorf("Bulk returned %+v, expected 3 failures", results)
+	}
+	for _, result := range results[1:] {
+		if !errors.Is(result.Err, ErrBulkAborted) {
+			t.Errorf("Bulk returned %+v for %s, expected ErrBulkAborted", result.Err, result.ID)
+		}
+	}
+	if last.Completed != 3 || last.Failed != 3 || last.Total != 3 {
+		t.Errorf("Bulk reported %+v last, expected the aborted operations to be counted", last)
+	}
+}
+
+func TestInstanceBulk(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances/", func(writer http.ResponseWriter, request *http.Request) {
+		id := strings.TrimPrefix(request.URL.Path, "/v2/instances/")
+		if id == "missing" {
+			http.Error(writer, `{"error":"invalid instance ID","status":404}`, http.StatusNotFound)
+			return
+		}
+
+		body, _ := io.ReadAll(request.Body)
+		if request.Method != http.MethodPatch || string(body) != `{"tags":["web","prod"]}`+"\n" {
+			t.Errorf("Instance.Update sent %s %s", request.Method, body)
+		}
+		fmt.Fprintf(writer, `{"instance":{"id":%q,"tags":["web","prod"]}}`, id)
+	})
+
+	results := NewInstanceBulk(client).UpdateTags(ctx, []string{"i-1", "missing", "i-2"}, []string{"web", "prod"})
+
+	if len(results) != 3 || len(results.Failed()) != 1 || results[1].ID != "missing" {
+		t.Fatalf("InstanceBulk.UpdateTags returned %+v", results)
+	}
+	if results[1].Response == nil || results[1].Response.StatusCode != http.StatusNotFound {
+		t.Errorf("InstanceBulk.UpdateTags returned %+v for the missing instance, expected its response", results[1])
+	}
+	for _, result := range []BulkResult[*Instance]{results[0], results[2]} {
+		if result.Err != nil || result.Value.ID != result.ID || !reflect.DeepEqual(result.Value.Tags, []string{"web", "prod"}) {
+			t.Errorf("InstanceBulk.UpdateTags returned %+v", result)
+		}
+	}
+}
+
+func TestInstanceBulk_Snapshot(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/snapshots", func(writer http.ResponseWriter, request *http.Request) {
+		var req SnapshotReq
+		_ = json.NewDecoder(request.Body).Decode(&req)
+		fmt.Fprintf(writer, `{"snapshot":{"id":"snap-%s","description":%q}}`, req.InstanceID, req.Description)
+	})
+
+	results := NewInstanceBulk(client, WithConcurrency(1)).Snapshot(ctx, []string{"i-1", "i-2"}, "nightly")
+	if err := results.Err(); err != nil {
+		t.Fatalf("InstanceBulk.Snapshot returned %+v", err)
+	}
+	for _, result := range results {
+		if result.Value.ID != "snap-"+result.ID || result.Value.Description != "nightly" {
+			t.Errorf("InstanceBulk.Snapshot returned %+v for %s", result.Value, result.ID)
+		}
+	}
+}
+
+func TestBareMetalBulk_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var deleted int32
+	mux.HandleFunc("/v2/bare-metals/", func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodDelete {
+			t.Errorf("BareMetalServer.Delete sent %s", request.Method)
+		}
+		atomic.AddInt32(&deleted, 1)
+		writer.WriteHeader(http.StatusNoContent)
+	})
+
+	results := NewBareMetalBulk(client).Delete(ctx, []string{"bm-1", "bm-2", "bm-3"})
+	if err := results.Err(); err != nil || deleted != 3 {
+		t.Errorf("BareMetalBulk.Delete returned %+v after %d deletions", err, deleted)
+	}
+}
@@ -0,0 +1,375 @@
+package govultr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	urlpath "path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTLs are the endpoints cached by a Cache unless configured otherwise: the catalog of
+// plans, regions, operating systems, applications and public ISOs. Keys are path.Match patterns
+// of the request path.
+var DefaultCacheTTLs = map[string]time.Duration{
+	"/v2/plans":                  time.Hour,
+	"/v2/plans-metal":            time.Hour,
+	"/v2/regions":                24 * time.Hour,
+	"/v2/regions/*/availability": 5 * time.Minute,
+	"/v2/os":                     24 * time.Hour,
+	"/v2/applications":           24 * time.Hour,
+	"/v2/iso-public":             24 * time.Hour,
+}
+
+// CacheEntry is a response stored by a Cache
+type CacheEntry struct {
+	Key        string      `json:"key"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	StoredAt   time.Time   `json:"stored_at"`
+	Expires    time.Time   `json:"expires"`
+}
+
+// revalidatable reports whether the entry can be refreshed with a conditional request
+func (e *CacheEntry) revalidatable() bool {
+	return e.Header.Get("ETag") != "" || e.Header.Get("Last-Modified") != ""
+}
+
+// CacheStore holds the entries of a Cache. Implementations must be safe for concurrent use.
+type CacheStore interface {
+	// Get returns the entry stored under key, if any
+	Get(key string) (*CacheEntry, bool)
+	// Set stores entry under key, replacing any previous entry
+	Set(key string, entry *CacheEntry) error
+	// Delete removes the entries whose key is matched by match
+	Delete(match func(key string) bool) error
+}
+
+// MemoryCacheStore is a CacheStore keeping its entries in memory
+type MemoryCacheStore struct {
+	mu      sync.Mutex
+	entries map[string]*CacheEntry
+}
+
+// NewMemoryCacheStore returns an empty MemoryCacheStore
+func NewMemoryCacheStore() *MemoryCacheStore {
+	return &MemoryCacheStore{entries: make(map[string]*CacheEntry)}
+}
+
+// Get returns the entry stored under key, if any
+func (s *MemoryCacheStore) Get(key string) (*CacheEntry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	return entry, ok
+}
+
+// Set stores entry under key
+func (s *MemoryCacheStore) Set(key string, entry *CacheEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = entry
+	return nil
+}
+
+// Delete removes the entries whose key is matched by match
+func (s *MemoryCacheStore) Delete(match func(key string) bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key := range s.entries {
+		if match(key) {
+			delete(s.entries, key)
+		}
+	}
+	return nil
+}
+
+// FileCacheStore is a CacheStore keeping every entry in a JSON file of a directory, so it
+// survives between runs and can be shared by processes on the same machine.
+type FileCacheStore struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileCacheStore returns a FileCacheStore writing to dir, which is created when missing
+func NewFileCacheStore(dir string) (*FileCacheStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileCacheStore{dir: dir}, nil
+}
+
+// Get returns the entry stored under key, if any. Unreadable entries are treated as missing.
+func (s *FileCacheStore) Get(key string) (*CacheEntry, bool) {
+	data, err := os.ReadFile(s.file(key))
+	if err != nil {
+		return nil, false
+	}
+
+	entry := new(CacheEntry)
+	if err := json.Unmarshal(data, entry); err != nil || entry.Key != key {
+		return nil, false
+	}
+	return entry, true
+}
+
+// Set stores entry under key. The file is replaced atomically, so readers never see a partial entry.
+func (s *FileCacheStore) Set(key string, entry *CacheEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp, err := os.CreateTemp(s.dir, ".entry-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close() //nolint:errcheck,gosec
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.file(key))
+}
+
+// Delete removes the entries whose key is matched by match
+func (s *FileCacheStore) Delete(match func(key string) bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	files, err := filepath.Glob(filepath.Join(s.dir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			continue
+		}
+
+		entry := new(CacheEntry)
+		if json.Unmarshal(data, entry) != nil || match(entry.Key) {
+			if err := os.Remove(file); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *FileCacheStore) file(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// CacheOption configures a Cache
+type CacheOption func(*Cache)
+
+// WithCacheTTL caches the GET requests whose path is matched by the path.Match pattern for ttl,
+// overriding DefaultCacheTTLs. A zero ttl disables caching for the pattern.
+func WithCacheTTL(pattern string, ttl time.Duration) CacheOption {
+	return func(c *Cache) {
+		c.ttls[pattern] = ttl
+	}
+}
+
+// Cache stores the responses of slowly changing endpoints, the catalog of plans, regions,
+// operating systems and applications by default. Fresh entries are served without calling the
+// API. Expired entries whose response had an ETag or a Last-Modified header are revalidated with
+// a conditional request, and served again when the API answers 304 Not Modified. Responses
+// served from the cache have Response.Cached set.
+//
+// A Cache is installed on a client with WithCache, and may be shared by several clients. Entries are
+// kept apart per API key, so clients of different accounts never serve each other's responses.
+type Cache struct {
+	store CacheStore
+	ttls  map[string]time.Duration
+	now   func() time.Time
+}
+
+// NewCache returns a Cache keeping its entries in store, in memory when store is nil
+func NewCache(store CacheStore, opts ...CacheOption) *Cache {
+	if store == nil {
+		store = NewMemoryCacheStore()
+	}
+
+	c := &Cache{store: store, ttls: make(map[string]time.Duration), now: time.Now}
+	for pattern, ttl := range DefaultCacheTTLs {
+		c.ttls[pattern] = ttl
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Invalidate removes the entries of the requests whose path is matched by one of the path.Match
+// patterns, such as "/v2/plans" or "/v2/regions/*/availability"
+func (c *Cache) Invalidate(patterns ...string) error {
+	return c.store.Delete(func(key string) bool {
+		for _, pattern := range patterns {
+			if ok, _ := urlpath.Match(pattern, cacheKeyPath(key)); ok {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Clear removes every entry
+func (c *Cache) Clear() error {
+	return c.store.Delete(func(string) bool { return true })
+}
+
+// Middleware returns the middleware serving requests from the cache
+func (c *Cache) Middleware() Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			ttl := c.ttl(req)
+			if ttl <= 0 {
+				return next.Do(req)
+			}
+
+			key := cacheKey(req)
+			entry, ok := c.store.Get(key)
+			switch {
+			case ok && c.now().Before(entry.Expires):
+				return c.serve(req, entry), nil
+			case ok && entry.revalidatable():
+				req = conditional(req, entry)
+			}
+
+			res, err := next.Do(req)
+			if ok && res != nil && res.StatusCode == http.StatusNotModified {
+				refreshed := *entry
+				refreshed.Expires = c.now().Add(ttl)
+				_ = c.store.Set(key, &refreshed)
+				return c.serve(req, &refreshed), nil
+			}
+
+			if err == nil && res.StatusCode == http.StatusOK && !noStore(res.Header) {
+				_ = c.store.Set(key, c.entry(key, res, ttl))
+			}
+			return res, err
+		})
+	}
+}
+
+// ttl returns how long the response to req is cached, zero when it is not
+func (c *Cache) ttl(req *http.Request) time.Duration {
+	if req.Method != http.MethodGet {
+		return 0
+	}
+
+	if ttl, ok := c.ttls[req.URL.Path]; ok {
+		return ttl
+	}
+	for pattern, ttl := range c.ttls {
+		if ok, _ := urlpath.Match(pattern, req.URL.Path); ok {
+			return ttl
+		}
+	}
+	return 0
+}
+
+func (c *Cache) entry(key string, res *http.Response, ttl time.Duration) *CacheEntry {
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close() //nolint:errcheck,gosec
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	now := c.now()
+	return &CacheEntry{
+		Key:        key,
+		StatusCode: res.StatusCode,
+		Header:     res.Header.Clone(),
+		Body:       body,
+		StoredAt:   now,
+		Expires:    now.Add(ttl),
+	}
+}
+
+// serve builds the response to req from entry, and marks the request as served from the cache
+func (c *Cache) serve(req *http.Request, entry *CacheEntry) *http.Response {
+	if stats := requestStatsFrom(req.Context()); stats != nil {
+		stats.cached = true
+	}
+
+	header := entry.Header.Clone()
+	header.Set("Age", strconv.Itoa(int(c.now().Sub(entry.StoredAt).Seconds())))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.StatusCode, http.StatusText(entry.StatusCode)),
+		StatusCode:    entry.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       req,
+	}
+}
+
+// conditional returns a copy of req asking the API to only answer if entry changed
+func conditional(req *http.Request, entry *CacheEntry) *http.Request {
+	req = req.Clone(req.Context())
+	if etag := entry.Header.Get("ETag"); etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if modified := entry.Header.Get("Last-Modified"); modified != "" {
+		req.Header.Set("If-Modified-Since", modified)
+	}
+	return req
+}
+
+func noStore(header http.Header) bool {
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		switch strings.TrimSpace(strings.ToLower(directive)) {
+		case "no-store":
+			return true
+		}
+	}
+	return false
+}
+
+// cacheKey identifies a request by its host, credentials, path and query, so clients talking to
+// different endpoints or accounts can share a store without serving each other's responses. The
+// credentials are hashed, so they are never written to the store.
+func cacheKey(req *http.Request) string {
+	account := "-"
+	if authorization := req.Header.Get("Authorization"); authorization != "" {
+		sum := sha256.Sum256([]byte(authorization))
+		account = hex.EncodeToString(sum[:8])
+	}
+	return req.URL.Host + " " + account + " " + req.Method + " " + req.URL.Path + "?" + req.URL.Query().Encode()
+}
+
+// cacheKeyPath returns the request path of a cache key
+func cacheKeyPath(key string) string {
+	requestPath, _, _ := strings.Cut(key[strings.LastIndex(key, " ")+1:], "?")
+	return requestPath
+}
@@ -0,0 +1,178 @@
+package govultr
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/v2/plans", func(writer http.ResponseWriter, request *http.Request) {
+		calls++
+		fmt.Fprintf(writer, `{"plans":[{"id":"vc2-1c-1gb"}],"meta":{"total":1}}`)
+	})
+	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
+		calls++
+		fmt.Fprint(writer, `{"instances":[],"meta":{"total":0}}`)
+	})
+
+	cache := NewCache(nil)
+	c, _ := client.With(WithCache(cache))
+
+	for i := 0; i < 2; i++ {
+		plans, _, res, err := c.Plan.List(ctx, "", nil)
+		if err != nil {
+			t.Fatalf("Plan.List returned %+v", err)
+		}
+		if len(plans) != 1 || plans[0].ID != "vc2-1c-1gb" {
+			t.Errorf("Plan.List returned %+v", plans)
+		}
+		if res.Cached != (i == 1) {
+			t.Errorf("call %d: Response.Cached = %v", i+1, res.Cached)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("the API was called %d times, expected once", calls)
+	}
+
+	if _, _, _, err := c.Plan.List(ctx, "vbm", nil); err != nil {
+		t.Fatalf("Plan.List returned %+v", err)
+	}
+	if calls != 2 {
+		t.Errorf("the API was called %d times, expected a call for another query", calls)
+	}
+
+	if err := cache.Invalidate("/v2/plans"); err != nil {
+		t.Fatalf("Cache.Invalidate returned %+v", err)
+	}
+	if _, _, res, _ := c.Plan.List(ctx, "", nil); res.Cached || calls != 3 {
+		t.Errorf("Plan.List was served from the cache after an invalidation")
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, _, res, _ := c.Instance.List(ctx, nil); res.Cached {
+			t.Errorf("Instance.List was served from the cache")
+		}
+	}
+	if calls != 5 {
+		t.Errorf("the API was called %d times, expected 5", calls)
+	}
+}
+
+func TestCache_Revalidate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/v2/regions/ewr/availability", func(writer http.ResponseWriter, request *http.Request) {
+		calls++
+		writer.Header().Set("ETag", `"v1"`)
+		if request.Header.Get("If-None-Match") == `"v1"` {
+			writer.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprint(writer, `{"available_plans":["vc2-1c-1gb"]}`)
+	})
+
+	now := time.Now()
+	cache := NewCache(nil, WithCacheTTL("/v2/regions/*/availability", time.Minute))
+	cache.now = func() time.Time { return now }
+	c, _ := client.With(WithCache(cache))
+
+	if _, _, err := c.Region.Availability(ctx, "ewr", ""); err != nil {
+		t.Fatalf("Region.Availability returned %+v", err)
+	}
+
+	now = now.Add(2 * time.Minute)
+	availability, res, err := c.Region.Availability(ctx, "ewr", "")
+	if err != nil {
+		t.Fatalf("Region.Availability returned %+v", err)
+	}
+	if len(availability.AvailablePlans) != 1 {
+		t.Errorf("Region.Availability returned %+v", availability)
+	}
+	if !res.Cached || res.StatusCode != http.StatusOK || res.Header.Get("Age") != "120" {
+		t.Errorf("Response = %d cached %v age %s, expected a cached 200 of age 120", res.StatusCode, res.Cached, res.Header.Get("Age"))
+	}
+	if calls != 2 {
+		t.Errorf("the API was called %d times, expected a conditional request", calls)
+	}
+
+	if _, res, _ := c.Region.Availability(ctx, "ewr", ""); !res.Cached || calls != 2 {
+		t.Errorf("Region.Availability was not served from the revalidated entry")
+	}
+}
+
+func TestFileCacheStore(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/v2/os", func(writer http.ResponseWriter, request *http.Request) {
+		calls++
+		fmt.Fprint(writer, `{"os":[{"id":387,"name":"Ubuntu 20.04 x64"}],"meta":{"total":1}}`)
+	})
+
+	dir := t.TempDir()
+	for i := 0; i < 2; i++ {
+		store, err := NewFileCacheStore(dir)
+		if err != nil {
+			t.Fatalf("NewFileCacheStore returned %+v", err)
+		}
+
+		c, _ := client.With(WithCache(NewCache(store)))
+		os, _, res, err := c.OS.List(ctx, nil)
+		if err != nil {
+			t.Fatalf("OS.List returned %+v", err)
+		}
+		if len(os) != 1 || os[0].ID != 387 || res.Cached != (i == 1) {
+			t.Errorf("run %d: OS.List returned %+v, cached %v", i+1, os, res.Cached)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("the API was called %d times, expected once", calls)
+	}
+
+	store, _ := NewFileCacheStore(dir)
+	if err := NewCache(store).Clear(); err != nil {
+		t.Fatalf("Cache.Clear returned %+v", err)
+	}
+	if _, ok := store.Get(client.BaseURL.Host + " GET /v2/os?"); ok {
+		t.Errorf("FileCacheStore kept an entry after Clear")
+	}
+}
+
+func TestCache_PerAccount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/regions/ewr/availability", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprintf(writer, `{"available_plans":[%q]}`, request.Header.Get("Authorization"))
+	})
+
+	cache := NewCache(nil)
+	first, _ := client.With(WithCache(cache), WithAPIKey("first"))
+	second, _ := client.With(WithCache(cache), WithAPIKey("second"))
+
+	if _, _, err := first.Region.Availability(ctx, "ewr", ""); err != nil {
+		t.Fatalf("Region.Availability returned %+v", err)
+	}
+
+	plans, res, err := second.Region.Availability(ctx, "ewr", "")
+	if err != nil {
+		t.Fatalf("Region.Availability returned %+v", err)
+	}
+	if res.Cached || len(plans.AvailablePlans) != 1 || plans.AvailablePlans[0] != "Bearer second" {
+		t.Errorf("Region.Availability returned %+v, expected the response for the second account", plans)
+	}
+
+	_, res, _ = first.Region.Availability(ctx, "ewr", "")
+	if !res.Cached || res.Status != "200 OK" {
+		t.Errorf("Response.Cached = %v and Response.Status = %q, expected a cached 200 OK", res.Cached, res.Status)
+	}
+}
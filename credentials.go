@@ -0,0 +1,206 @@
+package govultr
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// APIKeyEnvVar is the environment variable read by EnvCredentials
+	APIKeyEnvVar = "VULTR_API_KEY"
+	// ConfigFileEnvVar overrides the config file path read by ConfigFileCredentials
+	ConfigFileEnvVar = "VULTR_CONFIG"
+	// ProfileEnvVar selects the named profile read by ConfigFileCredentials
+	ProfileEnvVar = "VULTR_PROFILE"
+
+	defaultConfigFile = ".vultr-cli.yaml"
+	defaultProfile    = "default"
+)
+
+// ErrNoCredentials is returned by a CredentialsProvider that has no API key to offer.
+// A credential chain moves on to the next provider when it sees this error.
+var ErrNoCredentials = errors.New("no vultr credentials found")
+
+// CredentialSource describes where the API key used by a Client was resolved from
+type CredentialSource string
+
+// Credential sources reported by Client.CredentialSource
+const (
+	CredentialSourceNone        CredentialSource = ""
+	CredentialSourceExplicit    CredentialSource = "explicit"
+	CredentialSourceEnvironment CredentialSource = "environment"
+	CredentialSourceConfigFile  CredentialSource = "config-file"
+)
+
+// Credentials holds a resolved API key. The key is redacted whenever the value is formatted.
+type Credentials struct {
+	APIKey string
+	Source CredentialSource
+}
+
+// String returns a redacted representation of the credentials
+func (c Credentials) String() string {
+	return fmt.Sprintf("{APIKey:%s Source:%s}", redactAPIKey(c.APIKey), c.Source)
+}
+
+// GoString returns a redacted representation of the credentials
+func (c Credentials) GoString() string {
+	return fmt.Sprintf("govultr.Credentials{APIKey:%q, Source:%q}", redactAPIKey(c.APIKey), c.Source)
+}
+
+func redactAPIKey(key string) string {
+	if key == "" {
+		return ""
+	}
+	return "[REDACTED]"
+}
+
+// CredentialsProvider resolves the API key used to authenticate with the Vultr API
+type CredentialsProvider interface {
+	Retrieve() (*Credentials, error)
+}
+
+// StaticCredentials provides an explicitly supplied API key
+type StaticCredentials struct {
+	APIKey string
+}
+
+// Retrieve returns the static API key or ErrNoCredentials if it is empty
+func (s *StaticCredentials) Retrieve() (*Credentials, error) {
+	if s.APIKey == "" {
+		return nil, ErrNoCredentials
+	}
+	return &Credentials{APIKey: s.APIKey, Source: CredentialSourceExplicit}, nil
+}
+
+// EnvCredentials provides the API key stored in the VULTR_API_KEY environment variable
+type EnvCredentials struct{}
+
+// Retrieve returns the API key from the environment or ErrNoCredentials if it is unset
+func (e *EnvCredentials) Retrieve() (*Credentials, error) {
+	key := strings.TrimSpace(os.Getenv(APIKeyEnvVar))
+	if key == "" {
+		return nil, ErrNoCredentials
+	}
+	return &Credentials{APIKey: key, Source: CredentialSourceEnvironment}, nil
+}
+
+// ConfigFileCredentials provides the API key stored in a vultr-cli style YAML config file.
+//
+// The top level api-key is used as the default profile, additional named profiles can be
+// declared under profiles:
+//
+//	api-key: DEFAULT-KEY
+//	profiles:
+//	  staging:
+//	    api-key: STAGING-KEY
+type ConfigFileCredentials struct {
+	// Path of the config file. Defaults to $VULTR_CONFIG or $HOME/.vultr-cli.yaml
+	Path string
+	// Profile to read. Defaults to $VULTR_PROFILE or the top level api-key
+	Profile string
+}
+
+type configFile struct {
+	APIKey   string                   `yaml:"api-key"`
+	Profiles map[string]configProfile `yaml:"profiles"`
+}
+
+type configProfile struct {
+	APIKey string `yaml:"api-key"`
+}
+
+// Retrieve returns the API key of the selected profile. ErrNoCredentials is returned when
+// the config file does not exist or has no default key, a missing named profile is an error.
+func (f *ConfigFileCredentials) Retrieve() (*Credentials, error) {
+	path, err := f.path()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Clean(path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNoCredentials
+	} else if err != nil {
+		return nil, err
+	}
+
+	// yaml errors can quote the offending value, so the underlying error is not wrapped
+	config := new(configFile)
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("unable to parse vultr config file %s: invalid YAML", path)
+	}
+
+	profile := f.Profile
+	if profile == "" {
+		profile = os.Getenv(ProfileEnvVar)
+	}
+
+	key := config.APIKey
+	if profile != "" && profile != defaultProfile {
+		p, ok := config.Profiles[profile]
+		if !ok {
+			return nil, fmt.Errorf("profile %q not found in vultr config file %s", profile, path)
+		}
+		key = p.APIKey
+	} else if p, ok := config.Profiles[defaultProfile]; ok && key == "" {
+		key = p.APIKey
+	}
+
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return nil, ErrNoCredentials
+	}
+
+	return &Credentials{APIKey: key, Source: CredentialSourceConfigFile}, nil
+}
+
+func (f *ConfigFileCredentials) path() (string, error) {
+	if f.Path != "" {
+		return f.Path, nil
+	}
+
+	if path := os.Getenv(ConfigFileEnvVar); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, defaultConfigFile), nil
+}
+
+// CredentialChain tries each provider in order and returns the first API key found
+type CredentialChain []CredentialsProvider
+
+// Retrieve returns the credentials of the first provider that has an API key
+func (c CredentialChain) Retrieve() (*Credentials, error) {
+	for _, p := range c {
+		creds, err := p.Retrieve()
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		return creds, nil
+	}
+	return nil, ErrNoCredentials
+}
+
+// DefaultCredentials returns the default credential chain: the supplied API key, the
+// VULTR_API_KEY environment variable and finally the vultr-cli config file.
+func DefaultCredentials(apiKey string) CredentialsProvider {
+	return CredentialChain{
+		&StaticCredentials{APIKey: apiKey},
+		&EnvCredentials{},
+		&ConfigFileCredentials{},
+	}
+}
@@ -0,0 +1,149 @@
+package govultr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testConfigFile = `api-key: default-key
+profiles:
+  staging:
+    api-key: staging-key
+`
+
+func writeTestConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "vultr-cli.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestClient_SetAPIKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		if auth := request.Header.Get("Authorization"); auth != "Bearer secret-key" {
+			t.Errorf("Authorization header = %v, expected %v", auth, "Bearer secret-key")
+		}
+		fmt.Fprint(writer, `{"account":{}}`)
+	})
+
+	client.SetAPIKey("secret-key")
+	if _, _, err := client.Account.Get(ctx); err != nil {
+		t.Fatalf("Account.Get returned %+v", err)
+	}
+
+	if source := client.CredentialSource(); source != CredentialSourceExplicit {
+		t.Errorf("CredentialSource = %v, expected %v", source, CredentialSourceExplicit)
+	}
+
+	client.SetAPIKey("")
+	if source := client.CredentialSource(); source != CredentialSourceNone {
+		t.Errorf("CredentialSource = %v, expected %v", source, CredentialSourceNone)
+	}
+}
+
+func TestDefaultCredentials(t *testing.T) {
+	path := writeTestConfig(t, testConfigFile)
+	t.Setenv(ConfigFileEnvVar, path)
+	t.Setenv(ProfileEnvVar, "")
+
+	tests := []struct {
+		name     string
+		explicit string
+		env      string
+		key      string
+		source   CredentialSource
+	}{
+		{"explicit", "explicit-key", "env-key", "explicit-key", CredentialSourceExplicit},
+		{"environment", "", "env-key", "env-key", CredentialSourceEnvironment},
+		{"config file", "", "", "default-key", CredentialSourceConfigFile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(APIKeyEnvVar, tt.env)
+
+			c := NewClient(nil)
+			if err := c.SetCredentials(DefaultCredentials(tt.explicit)); err != nil {
+				t.Fatalf("SetCredentials returned %+v", err)
+			}
+
+			if c.CredentialSource() != tt.source {
+				t.Errorf("CredentialSource = %v, expected %v", c.CredentialSource(), tt.source)
+			}
+
+			req, _ := c.NewRequest(ctx, http.MethodGet, "/", nil)
+			if auth := req.Header.Get("Authorization"); auth != "Bearer "+tt.key {
+				t.Errorf("Authorization header = %v, expected %v", auth, "Bearer "+tt.key)
+			}
+		})
+	}
+}
+
+func TestDefaultCredentials_None(t *testing.T) {
+	t.Setenv(APIKeyEnvVar, "")
+	t.Setenv(ConfigFileEnvVar, filepath.Join(t.TempDir(), "missing.yaml"))
+
+	c := NewClient(nil)
+	if err := c.SetCredentials(DefaultCredentials("")); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("SetCredentials returned %v, expected %v", err, ErrNoCredentials)
+	}
+}
+
+func TestConfigFileCredentials_Profile(t *testing.T) {
+	path := writeTestConfig(t, testConfigFile)
+	t.Setenv(ProfileEnvVar, "")
+
+	creds, err := (&ConfigFileCredentials{Path: path, Profile: "staging"}).Retrieve()
+	if err != nil {
+		t.Fatalf("Retrieve returned %+v", err)
+	}
+	if creds.APIKey != "staging-key" {
+		t.Errorf("APIKey = %v, expected %v", creds.APIKey, "staging-key")
+	}
+
+	t.Setenv(ProfileEnvVar, "staging")
+	creds, err = (&ConfigFileCredentials{Path: path}).Retrieve()
+	if err != nil {
+		t.Fatalf("Retrieve returned %+v", err)
+	}
+	if creds.APIKey != "staging-key" {
+		t.Errorf("APIKey = %v, expected %v", creds.APIKey, "staging-key")
+	}
+
+	_, err = (&ConfigFileCredentials{Path: path, Profile: "prod"}).Retrieve()
+	if err == nil || errors.Is(err, ErrNoCredentials) {
+		t.Errorf("expected missing profile to fail, got %v", err)
+	}
+}
+
+func TestConfigFileCredentials_InvalidYAML(t *testing.T) {
+	path := writeTestConfig(t, "api-key: [leaked-key")
+
+	_, err := (&ConfigFileCredentials{Path: path}).Retrieve()
+	if err == nil {
+		t.Fatal("expected invalid YAML to fail")
+	}
+	if strings.Contains(err.Error(), "leaked-key") {
+		t.Errorf("error leaks the config file content: %v", err)
+	}
+}
+
+func TestCredentials_Redacted(t *testing.T) {
+	creds := Credentials{APIKey: "secret-key", Source: CredentialSourceExplicit}
+
+	for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+		if out := fmt.Sprintf(format, creds); strings.Contains(out, "secret-key") {
+			t.Errorf("%s leaks the API key: %v", format, out)
+		}
+	}
+}
@@ -13,51 +13,51 @@ const databasePath = "/v2/databases"
 // DatabaseService is the interface to interact with the Database endpoints on the Vultr API
 // Link: https://www.vultr.com/api/#tag/managed-databases
 type DatabaseService interface {
-	ListPlans(ctx context.Context, options *DBPlanListOptions) ([]DatabasePlan, *Meta, *http.Response, error)
+	ListPlans(ctx context.Context, options *DBPlanListOptions) ([]DatabasePlan, *Meta, *Response, error)
 
-	List(ctx context.Context, options *DBListOptions) ([]Database, *Meta, *http.Response, error)
-	Create(ctx context.Context, databaseReq *DatabaseCreateReq) (*Database, *http.Response, error)
-	Get(ctx context.Context, databaseID string) (*Database, *http.Response, error)
-	Update(ctx context.Context, databaseID string, databaseReq *DatabaseUpdateReq) (*Database, *http.Response, error)
-	Delete(ctx context.Context, databaseID string) error
+	List(ctx context.Context, options *DBListOptions) ([]Database, *Meta, *Response, error)
+	Create(ctx context.Context, databaseReq *DatabaseCreateReq) (*Database, *Response, error)
+	Get(ctx context.Context, databaseID string) (*Database, *Response, error)
+	Update(ctx context.Context, databaseID string, databaseReq *DatabaseUpdateReq) (*Database, *Response, error)
+	Delete(ctx context.Context, databaseID string) (*Response, error)
 
-	ListUsers(ctx context.Context, databaseID string) ([]DatabaseUser, *Meta, *http.Response, error)
-	CreateUser(ctx context.Context, databaseID string, databaseUserReq *DatabaseUserCreateReq) (*DatabaseUser, *http.Response, error)
-	GetUser(ctx context.Context, databaseID string, username string) (*DatabaseUser, *http.Response, error)
-	UpdateUser(ctx context.Context, databaseID string, username string, databaseUserReq *DatabaseUserUpdateReq) (*DatabaseUser, *http.Response, error) //nolint:lll
-	DeleteUser(ctx context.Context, databaseID string, username string) error
+	ListUsers(ctx context.Context, databaseID string, options *ListOptions) ([]DatabaseUser, *Meta, *Response, error)
+	CreateUser(ctx context.Context, databaseID string, databaseUserReq *DatabaseUserCreateReq) (*DatabaseUser, *Response, error)
+	GetUser(ctx context.Context, databaseID string, username string) (*DatabaseUser, *Response, error)
+	UpdateUser(ctx context.Context, databaseID string, username string, databaseUserReq *DatabaseUserUpdateReq) (*DatabaseUser, *Response, error) //nolint:lll
+	DeleteUser(ctx context.Context, databaseID string, username string) (*Response, error)
 
-	ListDBs(ctx context.Context, databaseID string) ([]DatabaseDB, *Meta, *http.Response, error)
-	CreateDB(ctx context.Context, databaseID string, databaseDBReq *DatabaseDBCreateReq) (*DatabaseDB, *http.Response, error)
-	GetDB(ctx context.Context, databaseID string, dbname string) (*DatabaseDB, *http.Response, error)
-	DeleteDB(ctx context.Context, databaseID string, dbname string) error
+	ListDBs(ctx context.Context, databaseID string, options *ListOptions) ([]DatabaseDB, *Meta, *Response, error)
+	CreateDB(ctx context.Context, databaseID string, databaseDBReq *DatabaseDBCreateReq) (*DatabaseDB, *Response, error)
+	GetDB(ctx context.Context, databaseID string, dbname string) (*DatabaseDB, *Response, error)
+	DeleteDB(ctx context.Context, databaseID string, dbname string) (*Response, error)
 
-	ListMaintenanceUpdates(ctx context.Context, databaseID string) ([]string, *http.Response, error)
-	StartMaintenance(ctx context.Context, databaseID string) (string, *http.Response, error)
+	ListMaintenanceUpdates(ctx context.Context, databaseID string) ([]string, *Response, error)
+	StartMaintenance(ctx context.Context, databaseID string) (string, *Response, error)
 
-	ListServiceAlerts(ctx context.Context, databaseID string, databaseAlertsReq *DatabaseListAlertsReq) ([]DatabaseAlert, *http.Response, error) //nolint:lll
+	ListServiceAlerts(ctx context.Context, databaseID string, databaseAlertsReq *DatabaseListAlertsReq) ([]DatabaseAlert, *Response, error) //nolint:lll
 
-	GetMigrationStatus(ctx context.Context, databaseID string) (*DatabaseMigration, *http.Response, error)
-	StartMigration(ctx context.Context, databaseID string, databaseMigrationReq *DatabaseMigrationStartReq) (*DatabaseMigration, *http.Response, error) //nolint:lll
-	DetachMigration(ctx context.Context, databaseID string) error
+	GetMigrationStatus(ctx context.Context, databaseID string) (*DatabaseMigration, *Response, error)
+	StartMigration(ctx context.Context, databaseID string, databaseMigrationReq *DatabaseMigrationStartReq) (*DatabaseMigration, *Response, error) //nolint:lll
+	DetachMigration(ctx context.Context, databaseID string) (*Response, error)
 
-	AddReadOnlyReplica(ctx context.Context, databaseID string, databaseReplicaReq *DatabaseAddReplicaReq) (*Database, *http.Response, error)
+	AddReadOnlyReplica(ctx context.Context, databaseID string, databaseReplicaReq *DatabaseAddReplicaReq) (*Database, *Response, error)
 
-	GetBackupInformation(ctx context.Context, databaseID string) (*DatabaseBackups, *http.Response, error)
-	RestoreFromBackup(ctx context.Context, databaseID string, databaseRestoreReq *DatabaseBackupRestoreReq) (*Database, *http.Response, error)
-	Fork(ctx context.Context, databaseID string, databaseForkReq *DatabaseForkReq) (*Database, *http.Response, error)
+	GetBackupInformation(ctx context.Context, databaseID string) (*DatabaseBackups, *Response, error)
+	RestoreFromBackup(ctx context.Context, databaseID string, databaseRestoreReq *DatabaseBackupRestoreReq) (*Database, *Response, error)
+	Fork(ctx context.Context, databaseID string, databaseForkReq *DatabaseForkReq) (*Database, *Response, error)
 
-	ListConnectionPools(ctx context.Context, databaseID string) (*DatabaseConnections, []DatabaseConnectionPool, *Meta, *http.Response, error)
-	CreateConnectionPool(ctx context.Context, databaseID string, databaseConnectionPoolReq *DatabaseConnectionPoolCreateReq) (*DatabaseConnectionPool, *http.Response, error) //nolint:lll
-	GetConnectionPool(ctx context.Context, databaseID string, poolName string) (*DatabaseConnectionPool, *http.Response, error)
-	UpdateConnectionPool(ctx context.Context, databaseID string, poolName string, databaseConnectionPoolReq *DatabaseConnectionPoolUpdateReq) (*DatabaseConnectionPool, *http.Response, error) //nolint:lll
-	DeleteConnectionPool(ctx context.Context, databaseID string, poolName string) error
+	ListConnectionPools(ctx context.Context, databaseID string, options *ListOptions) (*DatabaseConnections, []DatabaseConnectionPool, *Meta, *Response, error)          //nolint:lll
+	CreateConnectionPool(ctx context.Context, databaseID string, databaseConnectionPoolReq *DatabaseConnectionPoolCreateReq) (*DatabaseConnectionPool, *Response, error) //nolint:lll
+	GetConnectionPool(ctx context.Context, databaseID string, poolName string) (*DatabaseConnectionPool, *Response, error)
+	UpdateConnectionPool(ctx context.Context, databaseID string, poolName string, databaseConnectionPoolReq *DatabaseConnectionPoolUpdateReq) (*DatabaseConnectionPool, *Response, error) //nolint:lll
+	DeleteConnectionPool(ctx context.Context, databaseID string, poolName string) (*Response, error)
 
-	ListAdvancedOptions(ctx context.Context, databaseID string) (*DatabaseAdvancedOptions, []AvailableOption, *http.Response, error)
-	UpdateAdvancedOptions(ctx context.Context, databaseID string, databaseAdvancedOptionsReq *DatabaseAdvancedOptions) (*DatabaseAdvancedOptions, []AvailableOption, *http.Response, error) //nolint:lll
+	ListAdvancedOptions(ctx context.Context, databaseID string) (*DatabaseAdvancedOptions, []AvailableOption, *Response, error)
+	UpdateAdvancedOptions(ctx context.Context, databaseID string, databaseAdvancedOptionsReq *DatabaseAdvancedOptions) (*DatabaseAdvancedOptions, []AvailableOption, *Response, error) //nolint:lll
 
-	ListAvailableVersions(ctx context.Context, databaseID string) ([]string, *http.Response, error)
-	StartVersionUpgrade(ctx context.Context, databaseID string, databaseVersionUpgradeReq *DatabaseVersionUpgradeReq) (string, *http.Response, error) //nolint:lll
+	ListAvailableVersions(ctx context.Context, databaseID string) ([]string, *Response, error)
+	StartVersionUpgrade(ctx context.Context, databaseID string, databaseVersionUpgradeReq *DatabaseVersionUpgradeReq) (string, *Response, error) //nolint:lll
 }
 
 // DatabaseServiceHandler handles interaction with the server methods for the Vultr API
@@ -114,37 +114,58 @@ type DBListOptions struct {
 
 // Database represents a Managed Database subscription
 type Database struct {
-	ID                     string        `json:"id"`
-	DateCreated            string        `json:"date_created"`
-	Plan                   string        `json:"plan"`
-	PlanDisk               int           `json:"plan_disk"`
-	PlanRAM                int           `json:"plan_ram"`
-	PlanVCPUs              int           `json:"plan_vcpus"`
-	PlanReplicas           int           `json:"plan_replicas"`
-	Region                 string        `json:"region"`
-	DatabaseEngine         string        `json:"database_engine"`
-	DatabaseEngineVersion  string        `json:"database_engine_version"`
-	VPCID                  string        `json:"vpc_id"`
-	Status                 string        `json:"status"`
-	Label                  string        `json:"label"`
-	Tag                    string        `json:"tag"`
-	DBName                 string        `json:"dbname,omitempty"`
-	Host                   string        `json:"host"`
-	User                   string        `json:"user"`
-	Password               string        `json:"password"`
-	Port                   string        `json:"port"`
-	MaintenanceDOW         string        `json:"maintenance_dow"`
-	MaintenanceTime        string        `json:"maintenance_time"`
-	LatestBackup           string        `json:"latest_backup"`
-	TrustedIPs             []string      `json:"trusted_ips"`
-	MySQLSQLModes          []string      `json:"mysql_sql_modes,omitempty"`
-	MySQLRequirePrimaryKey *bool         `json:"mysql_require_primary_key,omitempty"`
-	MySQLSlowQueryLog      *bool         `json:"mysql_slow_query_log,omitempty"`
-	MySQLLongQueryTime     int           `json:"mysql_long_query_time,omitempty"`
-	PGAvailableExtensions  []PGExtension `json:"pg_available_extensions,omitempty"`
-	RedisEvictionPolicy    string        `json:"redis_eviction_policy,omitempty"`
-	ClusterTimeZone        string        `json:"cluster_time_zone,omitempty"`
-	ReadReplicas           []Database    `json:"read_replicas,omitempty"`
+	ID                     string         `json:"id"`
+	DateCreated            Timestamp      `json:"date_created"`
+	Plan                   string         `json:"plan"`
+	PlanDisk               int            `json:"plan_disk"`
+	PlanRAM                int            `json:"plan_ram"`
+	PlanVCPUs              int            `json:"plan_vcpus"`
+	PlanReplicas           int            `json:"plan_replicas"`
+	Region                 string         `json:"region"`
+	DatabaseEngine         DatabaseEngine `json:"database_engine"`
+	DatabaseEngineVersion  string         `json:"database_engine_version"`
+	VPCID                  string         `json:"vpc_id"`
+	Status                 string         `json:"status"`
+	Label                  string         `json:"label"`
+	Tag                    string         `json:"tag"`
+	DBName                 string         `json:"dbname,omitempty"`
+	Host                   string         `json:"host"`
+	User                   string         `json:"user"`
+	Password               string         `json:"password"`
+	Port                   string         `json:"port"`
+	MaintenanceDOW         string         `json:"maintenance_dow"`
+	MaintenanceTime        string         `json:"maintenance_time"`
+	LatestBackup           string         `json:"latest_backup"`
+	TrustedIPs             []string       `json:"trusted_ips"`
+	MySQLSQLModes          []string       `json:"mysql_sql_modes,omitempty"`
+	MySQLRequirePrimaryKey *bool          `json:"mysql_require_primary_key,omitempty"`
+	MySQLSlowQueryLog      *bool          `json:"mysql_slow_query_log,omitempty"`
+	MySQLLongQueryTime     int            `json:"mysql_long_query_time,omitempty"`
+	PGAvailableExtensions  []PGExtension  `json:"pg_available_extensions,omitempty"`
+	RedisEvictionPolicy    string         `json:"redis_eviction_policy,omitempty"`
+	ClusterTimeZone        string         `json:"cluster_time_zone,omitempty"`
+	ReadReplicas           []Database     `json:"read_replicas,omitempty"`
+}
+
+// DatabaseEngine is the engine of a Managed Database
+type DatabaseEngine string
+
+// Database engines
+const (
+	DatabaseEngineMySQL  DatabaseEngine = "mysql"
+	DatabaseEnginePG     DatabaseEngine = "pg"
+	DatabaseEngineRedis  DatabaseEngine = "redis"
+	DatabaseEngineValkey DatabaseEngine = "valkey"
+	DatabaseEngineKafka  DatabaseEngine = "kafka"
+)
+
+// IsValid reports whether e is a known database engine
+func (e DatabaseEngine) IsValid() bool {
+	switch e {
+	case DatabaseEngineMySQL, DatabaseEnginePG, DatabaseEngineRedis, DatabaseEngineValkey, DatabaseEngineKafka:
+		return true
+	}
+	return false
 }
 
 // PGExtension represents an object containing extension name and version information
@@ -166,39 +187,49 @@ type databaseBase struct {
 
 // DatabaseCreateReq struct used to create a database.
 type DatabaseCreateReq struct {
-	DatabaseEngine         string   `json:"database_engine,omitempty"`
-	DatabaseEngineVersion  string   `json:"database_engine_version,omitempty"`
-	Region                 string   `json:"region,omitempty"`
-	Plan                   string   `json:"plan,omitempty"`
-	Label                  string   `json:"label,omitempty"`
-	Tag                    string   `json:"tag,omitempty"`
-	VPCID                  string   `json:"vpc_id,omitempty"`
-	MaintenanceDOW         string   `json:"maintenance_dow,omitempty"`
-	MaintenanceTime        string   `json:"maintenance_time,omitempty"`
-	TrustedIPs             []string `json:"trusted_ips,omitempty"`
-	MySQLSQLModes          []string `json:"mysql_sql_modes,omitempty"`
-	MySQLRequirePrimaryKey *bool    `json:"mysql_require_primary_key,omitempty"`
-	MySQLSlowQueryLog      *bool    `json:"mysql_slow_query_log,omitempty"`
-	MySQLLongQueryTime     int      `json:"mysql_long_query_time,omitempty"`
-	RedisEvictionPolicy    string   `json:"redis_eviction_policy,omitempty"`
+	DatabaseEngine         DatabaseEngine `json:"database_engine,omitempty"`
+	DatabaseEngineVersion  string         `json:"database_engine_version,omitempty"`
+	Region                 string         `json:"region,omitempty"`
+	Plan                   string         `json:"plan,omitempty"`
+	Label                  string         `json:"label,omitempty"`
+	Tag                    string         `json:"tag,omitempty"`
+	VPCID                  string         `json:"vpc_id,omitempty"`
+	MaintenanceDOW         string         `json:"maintenance_dow,omitempty"`
+	MaintenanceTime        string         `json:"maintenance_time,omitempty"`
+	TrustedIPs             []string       `json:"trusted_ips,omitempty"`
+	MySQLSQLModes          []string       `json:"mysql_sql_modes,omitempty"`
+	MySQLRequirePrimaryKey *bool          `json:"mysql_require_primary_key,omitempty"`
+	MySQLSlowQueryLog      *bool          `json:"mysql_slow_query_log,omitempty"`
+	MySQLLongQueryTime     int            `json:"mysql_long_query_time,omitempty"`
+	RedisEvictionPolicy    string         `json:"redis_eviction_policy,omitempty"`
+}
+
+// Validate checks that the engine is supported and that the region, plan and label are given
+func (r DatabaseCreateReq) Validate() error {
+	v := new(validation)
+	if !r.DatabaseEngine.IsValid() {
+		v.add("database_engine", "%q is not a supported engine", r.DatabaseEngine)
+	}
+	v.required("region", r.Region, "plan", r.Plan, "label", r.Label)
+	return v.err()
 }
 
 // DatabaseUpdateReq struct used to update a dataase.
 type DatabaseUpdateReq struct {
-	Region                 string   `json:"region,omitempty"`
-	Plan                   string   `json:"plan,omitempty"`
-	Label                  string   `json:"label,omitempty"`
-	Tag                    string   `json:"tag,omitempty"`
-	VPCID                  string   `json:"vpc_id,omitempty"`
-	MaintenanceDOW         string   `json:"maintenance_dow,omitempty"`
-	MaintenanceTime        string   `json:"maintenance_time,omitempty"`
-	ClusterTimeZone        string   `json:"cluster_time_zone,omitempty"`
-	TrustedIPs             []string `json:"trusted_ips,omitempty"`
-	MySQLSQLModes          []string `json:"mysql_sql_modes,omitempty"`
-	MySQLRequirePrimaryKey *bool    `json:"mysql_require_primary_key,omitempty"`
-	MySQLSlowQueryLog      *bool    `json:"mysql_slow_query_log,omitempty"`
-	MySQLLongQueryTime     int      `json:"mysql_long_query_time,omitempty"`
-	RedisEvictionPolicy    string   `json:"redis_eviction_policy,omitempty"`
+	Region                 Optional[string]   `json:"region,omitzero"`
+	Plan                   Optional[string]   `json:"plan,omitzero"`
+	Label                  Optional[string]   `json:"label,omitzero"`
+	Tag                    Optional[string]   `json:"tag,omitzero"`
+	VPCID                  Optional[string]   `json:"vpc_id,omitzero"`
+	MaintenanceDOW         Optional[string]   `json:"maintenance_dow,omitzero"`
+	MaintenanceTime        Optional[string]   `json:"maintenance_time,omitzero"`
+	ClusterTimeZone        Optional[string]   `json:"cluster_time_zone,omitzero"`
+	TrustedIPs             Optional[[]string] `json:"trusted_ips,omitzero"`
+	MySQLSQLModes          Optional[[]string] `json:"mysql_sql_modes,omitzero"`
+	MySQLRequirePrimaryKey Optional[bool]     `json:"mysql_require_primary_key,omitzero"`
+	MySQLSlowQueryLog      Optional[bool]     `json:"mysql_slow_query_log,omitzero"`
+	MySQLLongQueryTime     Optional[int]      `json:"mysql_long_query_time,omitzero"`
+	RedisEvictionPolicy    Optional[string]   `json:"redis_eviction_policy,omitzero"`
 }
 
 // DatabaseUser represents a user within a Managed Database cluster
@@ -226,9 +257,16 @@ type DatabaseUserCreateReq struct {
 	Encryption string `json:"encryption,omitempty"`
 }
 
+// Validate checks that the username is given
+func (r DatabaseUserCreateReq) Validate() error {
+	v := new(validation)
+	v.required("username", r.Username)
+	return v.err()
+}
+
 // DatabaseUserUpdateReq struct used to update a user within a Managed Database.
 type DatabaseUserUpdateReq struct {
-	Password string `json:"password"`
+	Password Optional[string] `json:"password,omitzero"`
 }
 
 // DatabaseDB represents a logical database within a Managed Database cluster
@@ -252,6 +290,13 @@ type DatabaseDBCreateReq struct {
 	Name string `json:"name"`
 }
 
+// Validate checks that the name is given
+func (r DatabaseDBCreateReq) Validate() error {
+	v := new(validation)
+	v.required("name", r.Name)
+	return v.err()
+}
+
 // databaseDBsBase holds the API response for retrieving a list of available maintenance updates within a Managed Database
 type databaseUpdatesBase struct {
 	AvailableUpdates []string `json:"available_updates"`
@@ -264,13 +309,13 @@ type databaseMessage struct {
 
 // DatabaseAlert represents a service alert for a Managed Database cluster
 type DatabaseAlert struct {
-	Timestamp            string `json:"timestamp"`
-	MessageType          string `json:"message_type"`
-	Description          string `json:"description"`
-	Recommendation       string `json:"recommendation,omitempty"`
-	MaintenanceScheduled string `json:"maintenance_scheduled,omitempty"`
-	ResourceType         string `json:"resource_type,omitempty"`
-	TableCount           int    `json:"table_count,omitempty"`
+	Timestamp            Timestamp `json:"timestamp"`
+	MessageType          string    `json:"message_type"`
+	Description          string    `json:"description"`
+	Recommendation       string    `json:"recommendation,omitempty"`
+	MaintenanceScheduled string    `json:"maintenance_scheduled,omitempty"`
+	ResourceType         string    `json:"resource_type,omitempty"`
+	TableCount           int       `json:"table_count,omitempty"`
 }
 
 // databaseDBsBase holds the API response for querying service alerts within a Managed Database
@@ -391,12 +436,26 @@ type DatabaseConnectionPoolCreateReq struct {
 	Size     int    `json:"size,omitempty"`
 }
 
+// Validate checks that the name, database, username, mode and size are given, and that the mode
+// is session, transaction or statement
+func (r DatabaseConnectionPoolCreateReq) Validate() error {
+	v := new(validation)
+	v.required("name", r.Name, "database", r.Database, "username", r.Username, "mode", r.Mode)
+	if r.Mode != "" && r.Mode != "session" && r.Mode != "transaction" && r.Mode != "statement" {
+		v.add("mode", "%q is not one of session, transaction or statement", r.Mode)
+	}
+	if r.Size < 1 {
+		v.add("size", "%d is not a positive pool size", r.Size)
+	}
+	return v.err()
+}
+
 // DatabaseConnectionPoolUpdateReq struct used to update a connection pool within a PostgreSQL Managed Database.
 type DatabaseConnectionPoolUpdateReq struct {
-	Database string `json:"database,omitempty"`
-	Username string `json:"username,omitempty"`
-	Mode     string `json:"mode,omitempty"`
-	Size     int    `json:"size,omitempty"`
+	Database Optional[string] `json:"database,omitzero"`
+	Username Optional[string] `json:"username,omitzero"`
+	Mode     Optional[string] `json:"mode,omitzero"`
+	Size     Optional[int]    `json:"size,omitzero"`
 }
 
 // DatabaseAdvancedOptions represents user configurable advanced options within a PostgreSQL Managed Database cluster
@@ -474,8 +533,8 @@ type DatabaseVersionUpgradeReq struct {
 	Version string `json:"version,omitempty"`
 }
 
-// ListPlans retrieves all database plans
-func (d *DatabaseServiceHandler) ListPlans(ctx context.Context, options *DBPlanListOptions) ([]DatabasePlan, *Meta, *http.Response, error) {
+// ListPlans retrieves all database plans. The endpoint is not paginated, every plan is returned at once.
+func (d *DatabaseServiceHandler) ListPlans(ctx context.Context, options *DBPlanListOptions) ([]DatabasePlan, *Meta, *Response, error) {
 	uri := fmt.Sprintf("%s/plans", databasePath)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -493,14 +552,14 @@ func (d *DatabaseServiceHandler) ListPlans(ctx context.Context, options *DBPlanL
 	databasePlans := new(databasePlansBase)
 	resp, err := d.client.DoWithContext(ctx, req, databasePlans)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, resp, err
 	}
 
 	return databasePlans.DatabasePlans, databasePlans.Meta, resp, nil
 }
 
-// List retrieves all databases on your account
-func (d *DatabaseServiceHandler) List(ctx context.Context, options *DBListOptions) ([]Database, *Meta, *http.Response, error) { //nolint:dupl,lll
+// List retrieves all databases on your account. The endpoint is not paginated, every database is returned at once.
+func (d *DatabaseServiceHandler) List(ctx context.Context, options *DBListOptions) ([]Database, *Meta, *Response, error) { //nolint:dupl,lll
 	req, err := d.client.NewRequest(ctx, http.MethodGet, databasePath, nil)
 	if err != nil {
 		return nil, nil, nil, err
@@ -516,30 +575,53 @@ func (d *DatabaseServiceHandler) List(ctx context.Context, options *DBListOption
 	databases := new(databasesBase)
 	resp, err := d.client.DoWithContext(ctx, req, databases)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, resp, err
 	}
 
 	return databases.Databases, databases.Meta, resp, nil
 }
 
 // Create will create the Managed Database with the given parameters
-func (d *DatabaseServiceHandler) Create(ctx context.Context, databaseReq *DatabaseCreateReq) (*Database, *http.Response, error) {
+func (d *DatabaseServiceHandler) Create(ctx context.Context, databaseReq *DatabaseCreateReq) (*Database, *Response, error) {
 	req, err := d.client.NewRequest(ctx, http.MethodPost, databasePath, databaseReq)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	database := new(databaseBase)
-	resp, err := d.client.DoWithContext(ctx, req, database)
+	resp, err := doCreate(ctx, d.client, req, database, &database.Database, d.createMatch(databaseReq))
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return database.Database, resp, nil
 }
 
+// createMatch recognizes the Managed Databases with the label, tag and engine of databaseReq
+func (d *DatabaseServiceHandler) createMatch(databaseReq *DatabaseCreateReq) *createMatch[Database] {
+	if databaseReq == nil || (databaseReq.Label == "" && databaseReq.Tag == "") {
+		return nil
+	}
+
+	// List is not paginated and takes DBListOptions, so the cursor is ignored
+	list := func(ctx context.Context, _ *ListOptions) ([]Database, *Meta, *Response, error) {
+		return d.List(ctx, &DBListOptions{Label: databaseReq.Label, Tag: databaseReq.Tag, Region: databaseReq.Region})
+	}
+
+	return &createMatch[Database]{
+		list: list,
+		match: func(database *Database) bool {
+			return (databaseReq.Label == "" || database.Label == databaseReq.Label) &&
+				(databaseReq.Tag == "" || database.Tag == databaseReq.Tag) &&
+				database.DatabaseEngine == databaseReq.DatabaseEngine
+		},
+		id:          func(database *Database) string { return database.ID },
+		dateCreated: func(database *Database) Timestamp { return database.DateCreated },
+	}
+}
+
 // Get will get the Managed Database with the given databaseID
-func (d *DatabaseServiceHandler) Get(ctx context.Context, databaseID string) (*Database, *http.Response, error) {
+func (d *DatabaseServiceHandler) Get(ctx context.Context, databaseID string) (*Database, *Response, error) {
 	uri := fmt.Sprintf("%s/%s", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -550,14 +632,14 @@ func (d *DatabaseServiceHandler) Get(ctx context.Context, databaseID string) (*D
 	database := new(databaseBase)
 	resp, err := d.client.DoWithContext(ctx, req, database)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return database.Database, resp, nil
 }
 
 // Update will update the Managed Database with the given parameters
-func (d *DatabaseServiceHandler) Update(ctx context.Context, databaseID string, databaseReq *DatabaseUpdateReq) (*Database, *http.Response, error) { //nolint:lll
+func (d *DatabaseServiceHandler) Update(ctx context.Context, databaseID string, databaseReq *DatabaseUpdateReq) (*Database, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPut, uri, databaseReq)
@@ -568,27 +650,26 @@ func (d *DatabaseServiceHandler) Update(ctx context.Context, databaseID string,
 	database := new(databaseBase)
 	resp, err := d.client.DoWithContext(ctx, req, database)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return database.Database, resp, nil
 }
 
 // Delete a Managed database. All data will be permanently lost.
-func (d *DatabaseServiceHandler) Delete(ctx context.Context, databaseID string) error {
+func (d *DatabaseServiceHandler) Delete(ctx context.Context, databaseID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = d.client.DoWithContext(ctx, req, nil)
-	return err
+	return d.client.DoWithContext(ctx, req, nil)
 }
 
 // ListUsers retrieves all database users on your Managed Database.
-func (d *DatabaseServiceHandler) ListUsers(ctx context.Context, databaseID string) ([]DatabaseUser, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (d *DatabaseServiceHandler) ListUsers(ctx context.Context, databaseID string, options *ListOptions) ([]DatabaseUser, *Meta, *Response, error) { //nolint:dupl,lll
 	uri := fmt.Sprintf("%s/%s/users", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -596,17 +677,24 @@ func (d *DatabaseServiceHandler) ListUsers(ctx context.Context, databaseID strin
 		return nil, nil, nil, err
 	}
 
+	newValues, err := query.Values(options)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	req.URL.RawQuery = newValues.Encode()
+
 	databaseUsers := new(databaseUsersBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseUsers)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, resp, err
 	}
 
 	return databaseUsers.DatabaseUsers, databaseUsers.Meta, resp, nil
 }
 
 // CreateUser will create a user within the Managed Database with the given parameters
-func (d *DatabaseServiceHandler) CreateUser(ctx context.Context, databaseID string, databaseUserReq *DatabaseUserCreateReq) (*DatabaseUser, *http.Response, error) { //nolint:lll
+func (d *DatabaseServiceHandler) CreateUser(ctx context.Context, databaseID string, databaseUserReq *DatabaseUserCreateReq) (*DatabaseUser, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/users", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPost, uri, databaseUserReq)
@@ -617,14 +705,14 @@ func (d *DatabaseServiceHandler) CreateUser(ctx context.Context, databaseID stri
 	databaseUser := new(databaseUserBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseUser)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return databaseUser.DatabaseUser, resp, nil
 }
 
 // GetUser retrieves information on an individual user within a Managed Database based on a username and databaseID
-func (d *DatabaseServiceHandler) GetUser(ctx context.Context, databaseID, username string) (*DatabaseUser, *http.Response, error) {
+func (d *DatabaseServiceHandler) GetUser(ctx context.Context, databaseID, username string) (*DatabaseUser, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/users/%s", databasePath, databaseID, username)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -635,14 +723,14 @@ func (d *DatabaseServiceHandler) GetUser(ctx context.Context, databaseID, userna
 	databaseUser := new(databaseUserBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseUser)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return databaseUser.DatabaseUser, resp, nil
 }
 
 // UpdateUser will update a user within the Managed Database with the given parameters
-func (d *DatabaseServiceHandler) UpdateUser(ctx context.Context, databaseID, username string, databaseUserReq *DatabaseUserUpdateReq) (*DatabaseUser, *http.Response, error) { //nolint:lll,dupl
+func (d *DatabaseServiceHandler) UpdateUser(ctx context.Context, databaseID, username string, databaseUserReq *DatabaseUserUpdateReq) (*DatabaseUser, *Response, error) { //nolint:lll,dupl
 	uri := fmt.Sprintf("%s/%s/users/%s", databasePath, databaseID, username)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPut, uri, databaseUserReq)
@@ -653,27 +741,26 @@ func (d *DatabaseServiceHandler) UpdateUser(ctx context.Context, databaseID, use
 	databaseUser := new(databaseUserBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseUser)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return databaseUser.DatabaseUser, resp, nil
 }
 
 // DeleteUser will delete a user within the Managed database. All data will be permanently lost.
-func (d *DatabaseServiceHandler) DeleteUser(ctx context.Context, databaseID, username string) error {
+func (d *DatabaseServiceHandler) DeleteUser(ctx context.Context, databaseID, username string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/users/%s", databasePath, databaseID, username)
 
 	req, err := d.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = d.client.DoWithContext(ctx, req, nil)
-	return err
+	return d.client.DoWithContext(ctx, req, nil)
 }
 
 // ListDBs retrieves all logical databases on your Managed Database.
-func (d *DatabaseServiceHandler) ListDBs(ctx context.Context, databaseID string) ([]DatabaseDB, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (d *DatabaseServiceHandler) ListDBs(ctx context.Context, databaseID string, options *ListOptions) ([]DatabaseDB, *Meta, *Response, error) { //nolint:dupl,lll
 	uri := fmt.Sprintf("%s/%s/dbs", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -681,17 +768,24 @@ func (d *DatabaseServiceHandler) ListDBs(ctx context.Context, databaseID string)
 		return nil, nil, nil, err
 	}
 
+	newValues, err := query.Values(options)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	req.URL.RawQuery = newValues.Encode()
+
 	databaseDBs := new(databaseDBsBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseDBs)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, resp, err
 	}
 
 	return databaseDBs.DatabaseDBs, databaseDBs.Meta, resp, nil
 }
 
 // CreateDB will create a logical database within the Managed Database with the given parameters
-func (d *DatabaseServiceHandler) CreateDB(ctx context.Context, databaseID string, databaseDBReq *DatabaseDBCreateReq) (*DatabaseDB, *http.Response, error) { //nolint:lll
+func (d *DatabaseServiceHandler) CreateDB(ctx context.Context, databaseID string, databaseDBReq *DatabaseDBCreateReq) (*DatabaseDB, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/dbs", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPost, uri, databaseDBReq)
@@ -702,14 +796,14 @@ func (d *DatabaseServiceHandler) CreateDB(ctx context.Context, databaseID string
 	databaseDB := new(databaseDBBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseDB)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return databaseDB.DatabaseDB, resp, nil
 }
 
 // GetDB retrieves information on an individual logical database within a Managed Database based on a dbname and databaseID
-func (d *DatabaseServiceHandler) GetDB(ctx context.Context, databaseID, dbname string) (*DatabaseDB, *http.Response, error) {
+func (d *DatabaseServiceHandler) GetDB(ctx context.Context, databaseID, dbname string) (*DatabaseDB, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/dbs/%s", databasePath, databaseID, dbname)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -720,27 +814,26 @@ func (d *DatabaseServiceHandler) GetDB(ctx context.Context, databaseID, dbname s
 	databaseDB := new(databaseDBBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseDB)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return databaseDB.DatabaseDB, resp, nil
 }
 
 // DeleteDB will delete a user within the Managed database
-func (d *DatabaseServiceHandler) DeleteDB(ctx context.Context, databaseID, dbname string) error {
+func (d *DatabaseServiceHandler) DeleteDB(ctx context.Context, databaseID, dbname string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/dbs/%s", databasePath, databaseID, dbname)
 
 	req, err := d.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = d.client.DoWithContext(ctx, req, nil)
-	return err
+	return d.client.DoWithContext(ctx, req, nil)
 }
 
 // ListMaintenanceUpdates retrieves all available maintenance updates for your Managed Database.
-func (d *DatabaseServiceHandler) ListMaintenanceUpdates(ctx context.Context, databaseID string) ([]string, *http.Response, error) {
+func (d *DatabaseServiceHandler) ListMaintenanceUpdates(ctx context.Context, databaseID string) ([]string, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/maintenance", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -751,14 +844,14 @@ func (d *DatabaseServiceHandler) ListMaintenanceUpdates(ctx context.Context, dat
 	databaseUpdates := new(databaseUpdatesBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseUpdates)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return databaseUpdates.AvailableUpdates, resp, nil
 }
 
 // StartMaintenance will start the maintenance update process for your Managed Database
-func (d *DatabaseServiceHandler) StartMaintenance(ctx context.Context, databaseID string) (string, *http.Response, error) {
+func (d *DatabaseServiceHandler) StartMaintenance(ctx context.Context, databaseID string) (string, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/maintenance", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPost, uri, nil)
@@ -769,14 +862,14 @@ func (d *DatabaseServiceHandler) StartMaintenance(ctx context.Context, databaseI
 	databaseUpdates := new(databaseMessage)
 	resp, err := d.client.DoWithContext(ctx, req, databaseUpdates)
 	if err != nil {
-		return "", nil, err
+		return "", resp, err
 	}
 
 	return databaseUpdates.Message, resp, nil
 }
 
 // ListServiceAlerts queries for service alerts for the Managed Database using the given parameters
-func (d *DatabaseServiceHandler) ListServiceAlerts(ctx context.Context, databaseID string, databaseAlertsReq *DatabaseListAlertsReq) ([]DatabaseAlert, *http.Response, error) { //nolint:lll
+func (d *DatabaseServiceHandler) ListServiceAlerts(ctx context.Context, databaseID string, databaseAlertsReq *DatabaseListAlertsReq) ([]DatabaseAlert, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/alerts", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPost, uri, databaseAlertsReq)
@@ -787,14 +880,14 @@ func (d *DatabaseServiceHandler) ListServiceAlerts(ctx context.Context, database
 	databaseAlerts := new(databaseAlertsBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseAlerts)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return databaseAlerts.DatabaseAlerts, resp, nil
 }
 
 // GetMigrationStatus retrieves the migration status for your Managed Database.
-func (d *DatabaseServiceHandler) GetMigrationStatus(ctx context.Context, databaseID string) (*DatabaseMigration, *http.Response, error) {
+func (d *DatabaseServiceHandler) GetMigrationStatus(ctx context.Context, databaseID string) (*DatabaseMigration, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/migration", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -805,14 +898,14 @@ func (d *DatabaseServiceHandler) GetMigrationStatus(ctx context.Context, databas
 	databaseMigration := new(databaseMigrationBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseMigration)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return databaseMigration.Migration, resp, nil
 }
 
 // StartMigration will start a migration for the Managed Database using the given credentials.
-func (d *DatabaseServiceHandler) StartMigration(ctx context.Context, databaseID string, databaseMigrationReq *DatabaseMigrationStartReq) (*DatabaseMigration, *http.Response, error) { //nolint:lll
+func (d *DatabaseServiceHandler) StartMigration(ctx context.Context, databaseID string, databaseMigrationReq *DatabaseMigrationStartReq) (*DatabaseMigration, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/migration", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPost, uri, databaseMigrationReq)
@@ -823,27 +916,26 @@ func (d *DatabaseServiceHandler) StartMigration(ctx context.Context, databaseID
 	databaseMigration := new(databaseMigrationBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseMigration)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return databaseMigration.Migration, resp, nil
 }
 
 // DetachMigration will detach a migration from the Managed database.
-func (d *DatabaseServiceHandler) DetachMigration(ctx context.Context, databaseID string) error {
+func (d *DatabaseServiceHandler) DetachMigration(ctx context.Context, databaseID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/migration", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = d.client.DoWithContext(ctx, req, nil)
-	return err
+	return d.client.DoWithContext(ctx, req, nil)
 }
 
 // AddReadOnlyReplica will add a read-only replica node to the Managed Database with the given parameters
-func (d *DatabaseServiceHandler) AddReadOnlyReplica(ctx context.Context, databaseID string, databaseReplicaReq *DatabaseAddReplicaReq) (*Database, *http.Response, error) { //nolint:lll
+func (d *DatabaseServiceHandler) AddReadOnlyReplica(ctx context.Context, databaseID string, databaseReplicaReq *DatabaseAddReplicaReq) (*Database, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/read-replica", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPost, uri, databaseReplicaReq)
@@ -854,14 +946,14 @@ func (d *DatabaseServiceHandler) AddReadOnlyReplica(ctx context.Context, databas
 	database := new(databaseBase)
 	resp, err := d.client.DoWithContext(ctx, req, database)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return database.Database, resp, nil
 }
 
 // GetBackupInformation retrieves backup information for your Managed Database.
-func (d *DatabaseServiceHandler) GetBackupInformation(ctx context.Context, databaseID string) (*DatabaseBackups, *http.Response, error) {
+func (d *DatabaseServiceHandler) GetBackupInformation(ctx context.Context, databaseID string) (*DatabaseBackups, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/backups", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -872,14 +964,14 @@ func (d *DatabaseServiceHandler) GetBackupInformation(ctx context.Context, datab
 	databaseBackups := new(DatabaseBackups)
 	resp, err := d.client.DoWithContext(ctx, req, databaseBackups)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return databaseBackups, resp, nil
 }
 
 // RestoreFromBackup will create a new subscription of the same plan from a backup of the Managed Database using the given parameters
-func (d *DatabaseServiceHandler) RestoreFromBackup(ctx context.Context, databaseID string, databaseRestoreReq *DatabaseBackupRestoreReq) (*Database, *http.Response, error) { //nolint:lll
+func (d *DatabaseServiceHandler) RestoreFromBackup(ctx context.Context, databaseID string, databaseRestoreReq *DatabaseBackupRestoreReq) (*Database, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/restore", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPost, uri, databaseRestoreReq)
@@ -890,14 +982,14 @@ func (d *DatabaseServiceHandler) RestoreFromBackup(ctx context.Context, database
 	database := new(databaseBase)
 	resp, err := d.client.DoWithContext(ctx, req, database)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return database.Database, resp, nil
 }
 
 // Fork will create a new subscription of any plan from a backup of the Managed Database using the given parameters
-func (d *DatabaseServiceHandler) Fork(ctx context.Context, databaseID string, databaseForkReq *DatabaseForkReq) (*Database, *http.Response, error) { //nolint:lll
+func (d *DatabaseServiceHandler) Fork(ctx context.Context, databaseID string, databaseForkReq *DatabaseForkReq) (*Database, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/fork", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPost, uri, databaseForkReq)
@@ -908,14 +1000,14 @@ func (d *DatabaseServiceHandler) Fork(ctx context.Context, databaseID string, da
 	database := new(databaseBase)
 	resp, err := d.client.DoWithContext(ctx, req, database)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return database.Database, resp, nil
 }
 
 // ListConnectionPools retrieves all connection pools within your PostgreSQL Managed Database.
-func (d *DatabaseServiceHandler) ListConnectionPools(ctx context.Context, databaseID string) (*DatabaseConnections, []DatabaseConnectionPool, *Meta, *http.Response, error) { //nolint:lll
+func (d *DatabaseServiceHandler) ListConnectionPools(ctx context.Context, databaseID string, options *ListOptions) (*DatabaseConnections, []DatabaseConnectionPool, *Meta, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/connection-pools", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -923,17 +1015,24 @@ func (d *DatabaseServiceHandler) ListConnectionPools(ctx context.Context, databa
 		return nil, nil, nil, nil, err
 	}
 
+	newValues, err := query.Values(options)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	req.URL.RawQuery = newValues.Encode()
+
 	databaseConnectionPools := new(databaseConnectionPoolsBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseConnectionPools)
 	if err != nil {
-		return nil, nil, nil, nil, err
+		return nil, nil, nil, resp, err
 	}
 
 	return databaseConnectionPools.Connections, databaseConnectionPools.ConnectionPools, databaseConnectionPools.Meta, resp, nil
 }
 
 // CreateConnectionPool will create a connection pool within the PostgreSQL Managed Database with the given parameters
-func (d *DatabaseServiceHandler) CreateConnectionPool(ctx context.Context, databaseID string, databaseConnectionPoolReq *DatabaseConnectionPoolCreateReq) (*DatabaseConnectionPool, *http.Response, error) { //nolint:lll
+func (d *DatabaseServiceHandler) CreateConnectionPool(ctx context.Context, databaseID string, databaseConnectionPoolReq *DatabaseConnectionPoolCreateReq) (*DatabaseConnectionPool, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/connection-pools", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPost, uri, databaseConnectionPoolReq)
@@ -944,7 +1043,7 @@ func (d *DatabaseServiceHandler) CreateConnectionPool(ctx context.Context, datab
 	databaseConnectionPool := new(databaseConnectionPoolBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseConnectionPool)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return databaseConnectionPool.ConnectionPool, resp, nil
@@ -952,7 +1051,7 @@ func (d *DatabaseServiceHandler) CreateConnectionPool(ctx context.Context, datab
 
 // GetConnectionPool retrieves information on an individual connection pool
 // within a PostgreSQL Managed Database based on a poolName and databaseID
-func (d *DatabaseServiceHandler) GetConnectionPool(ctx context.Context, databaseID, poolName string) (*DatabaseConnectionPool, *http.Response, error) { //nolint:lll
+func (d *DatabaseServiceHandler) GetConnectionPool(ctx context.Context, databaseID, poolName string) (*DatabaseConnectionPool, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/connection-pools/%s", databasePath, databaseID, poolName)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -963,14 +1062,14 @@ func (d *DatabaseServiceHandler) GetConnectionPool(ctx context.Context, database
 	databaseConnectionPool := new(databaseConnectionPoolBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseConnectionPool)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return databaseConnectionPool.ConnectionPool, resp, nil
 }
 
 // UpdateConnectionPool will update a connection pool within the PostgreSQL Managed Database with the given parameters
-func (d *DatabaseServiceHandler) UpdateConnectionPool(ctx context.Context, databaseID, poolName string, databaseConnectionPoolReq *DatabaseConnectionPoolUpdateReq) (*DatabaseConnectionPool, *http.Response, error) { //nolint:lll,dupl
+func (d *DatabaseServiceHandler) UpdateConnectionPool(ctx context.Context, databaseID, poolName string, databaseConnectionPoolReq *DatabaseConnectionPoolUpdateReq) (*DatabaseConnectionPool, *Response, error) { //nolint:lll,dupl
 	uri := fmt.Sprintf("%s/%s/connection-pools/%s", databasePath, databaseID, poolName)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPut, uri, databaseConnectionPoolReq)
@@ -981,27 +1080,26 @@ func (d *DatabaseServiceHandler) UpdateConnectionPool(ctx context.Context, datab
 	databaseConnectionPool := new(databaseConnectionPoolBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseConnectionPool)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return databaseConnectionPool.ConnectionPool, resp, nil
 }
 
 // DeleteConnectionPool will delete a user within the Managed database. All data will be permanently lost.
-func (d *DatabaseServiceHandler) DeleteConnectionPool(ctx context.Context, databaseID, poolName string) error {
+func (d *DatabaseServiceHandler) DeleteConnectionPool(ctx context.Context, databaseID, poolName string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/connection-pools/%s", databasePath, databaseID, poolName)
 
 	req, err := d.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = d.client.DoWithContext(ctx, req, nil)
-	return err
+	return d.client.DoWithContext(ctx, req, nil)
 }
 
 // ListAdvancedOptions retrieves all connection pools within your PostgreSQL Managed Database.
-func (d *DatabaseServiceHandler) ListAdvancedOptions(ctx context.Context, databaseID string) (*DatabaseAdvancedOptions, []AvailableOption, *http.Response, error) { //nolint:lll
+func (d *DatabaseServiceHandler) ListAdvancedOptions(ctx context.Context, databaseID string) (*DatabaseAdvancedOptions, []AvailableOption, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/advanced-options", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -1012,14 +1110,14 @@ func (d *DatabaseServiceHandler) ListAdvancedOptions(ctx context.Context, databa
 	databaseAdvancedOptions := new(databaseAdvancedOptionsBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseAdvancedOptions)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, resp, err
 	}
 
 	return databaseAdvancedOptions.ConfiguredOptions, databaseAdvancedOptions.AvailableOptions, resp, nil
 }
 
 // UpdateAdvancedOptions will update a connection pool within the PostgreSQL Managed Database with the given parameters
-func (d *DatabaseServiceHandler) UpdateAdvancedOptions(ctx context.Context, databaseID string, databaseAdvancedOptionsReq *DatabaseAdvancedOptions) (*DatabaseAdvancedOptions, []AvailableOption, *http.Response, error) { //nolint:lll
+func (d *DatabaseServiceHandler) UpdateAdvancedOptions(ctx context.Context, databaseID string, databaseAdvancedOptionsReq *DatabaseAdvancedOptions) (*DatabaseAdvancedOptions, []AvailableOption, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/advanced-options", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPut, uri, databaseAdvancedOptionsReq)
@@ -1030,14 +1128,14 @@ func (d *DatabaseServiceHandler) UpdateAdvancedOptions(ctx context.Context, data
 	databaseAdvancedOptions := new(databaseAdvancedOptionsBase)
 	resp, err := d.client.DoWithContext(ctx, req, databaseAdvancedOptions)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, resp, err
 	}
 
 	return databaseAdvancedOptions.ConfiguredOptions, databaseAdvancedOptions.AvailableOptions, resp, nil
 }
 
 // ListAvailableVersions retrieves all available version upgrades for your Managed Database.
-func (d *DatabaseServiceHandler) ListAvailableVersions(ctx context.Context, databaseID string) ([]string, *http.Response, error) {
+func (d *DatabaseServiceHandler) ListAvailableVersions(ctx context.Context, databaseID string) ([]string, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/version-upgrade", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -1048,14 +1146,14 @@ func (d *DatabaseServiceHandler) ListAvailableVersions(ctx context.Context, data
 	databaseVersions := new(DatabaseAvailableVersions)
 	resp, err := d.client.DoWithContext(ctx, req, databaseVersions)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
 	return databaseVersions.AvailableVersions, resp, nil
 }
 
 // StartVersionUpgrade will start a migration for the Managed Database using the given credentials.
-func (d *DatabaseServiceHandler) StartVersionUpgrade(ctx context.Context, databaseID string, databaseVersionUpgradeReq *DatabaseVersionUpgradeReq) (string, *http.Response, error) { //nolint:lll
+func (d *DatabaseServiceHandler) StartVersionUpgrade(ctx context.Context, databaseID string, databaseVersionUpgradeReq *DatabaseVersionUpgradeReq) (string, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/version-upgrade", databasePath, databaseID)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPost, uri, databaseVersionUpgradeReq)
@@ -1066,7 +1164,7 @@ func (d *DatabaseServiceHandler) StartVersionUpgrade(ctx context.Context, databa
 	databaseVersionUpgrade := new(databaseMessage)
 	resp, err := d.client.DoWithContext(ctx, req, databaseVersionUpgrade)
 	if err != nil {
-		return "", nil, err
+		return "", resp, err
 	}
 
 	return databaseVersionUpgrade.Message, resp, nil
@@ -113,7 +113,7 @@ func TestDatabaseServiceHandler_List(t *testing.T) {
 	replicas := []Database{
 		{
 			ID:                     "daeb6d62-a6a2-458c-9f74-e053735d7f50",
-			DateCreated:            "2022-05-09 10:12:43",
+			DateCreated:            timestamp("2022-05-09 10:12:43"),
 			Plan:                   "vultr-dbaas-startup-cc-2-80-4",
 			PlanDisk:               80,
 			PlanRAM:                4096,
@@ -145,7 +145,7 @@ func TestDatabaseServiceHandler_List(t *testing.T) {
 	expected := []Database{
 		{
 			ID:                     "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5",
-			DateCreated:            "2022-05-09 10:13:31",
+			DateCreated:            timestamp("2022-05-09 10:13:31"),
 			Plan:                   "vultr-dbaas-business-cc-2-80-4",
 			PlanDisk:               80,
 			PlanRAM:                4096,
@@ -259,7 +259,7 @@ func TestDatabaseServiceHandler_Create(t *testing.T) {
 
 	expected := &Database{
 		ID:                     "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5",
-		DateCreated:            "2022-05-09 10:13:31",
+		DateCreated:            timestamp("2022-05-09 10:13:31"),
 		Plan:                   "vultr-dbaas-business-cc-2-80-4",
 		PlanDisk:               80,
 		PlanRAM:                4096,
@@ -394,7 +394,7 @@ func TestDatabaseServiceHandler_Get(t *testing.T) {
 	replicas := []Database{
 		{
 			ID:                     "daeb6d62-a6a2-458c-9f74-e053735d7f50",
-			DateCreated:            "2022-05-09 10:12:43",
+			DateCreated:            timestamp("2022-05-09 10:12:43"),
 			Plan:                   "vultr-dbaas-startup-cc-2-80-4",
 			PlanDisk:               80,
 			PlanRAM:                4096,
@@ -425,7 +425,7 @@ func TestDatabaseServiceHandler_Get(t *testing.T) {
 
 	expected := &Database{
 		ID:                     "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5",
-		DateCreated:            "2022-05-09 10:13:31",
+		DateCreated:            timestamp("2022-05-09 10:13:31"),
 		Plan:                   "vultr-dbaas-business-cc-2-80-4",
 		PlanDisk:               80,
 		PlanRAM:                4096,
@@ -546,10 +546,10 @@ func TestDatabaseServiceHandler_Update(t *testing.T) {
 	})
 
 	options := &DatabaseUpdateReq{
-		Label:              "testy-mc-testerton-the-8th-part-2",
-		Tag:                "bing bong updated",
-		MySQLSlowQueryLog:  BoolToBoolPtr(true),
-		MySQLLongQueryTime: 2,
+		Label:              NewOptional("testy-mc-testerton-the-8th-part-2"),
+		Tag:                NewOptional("bing bong updated"),
+		MySQLSlowQueryLog:  NewOptional(true),
+		MySQLLongQueryTime: NewOptional(2),
 	}
 
 	database, _, err := client.Database.Update(ctx, "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5", options)
@@ -569,7 +569,7 @@ func TestDatabaseServiceHandler_Update(t *testing.T) {
 	replicas := []Database{
 		{
 			ID:                     "daeb6d62-a6a2-458c-9f74-e053735d7f50",
-			DateCreated:            "2022-05-09 10:12:43",
+			DateCreated:            timestamp("2022-05-09 10:12:43"),
 			Plan:                   "vultr-dbaas-startup-cc-2-80-4",
 			PlanDisk:               80,
 			PlanRAM:                4096,
@@ -601,7 +601,7 @@ func TestDatabaseServiceHandler_Update(t *testing.T) {
 
 	expected := &Database{
 		ID:                     "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5",
-		DateCreated:            "2022-05-09 10:13:31",
+		DateCreated:            timestamp("2022-05-09 10:13:31"),
 		Plan:                   "vultr-dbaas-business-cc-2-80-4",
 		PlanDisk:               80,
 		PlanRAM:                4096,
@@ -644,9 +644,59 @@ func TestDatabaseServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.Database.Delete(ctx, "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5")
+	_, err := client.Database.Delete(ctx, "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5")
 
 	if err != nil {
 		t.Errorf("Database.Delete returned %+v", err)
 	}
 }
+
+func TestDatabaseServiceHandler_ListUsers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/databases/999c4ed0-f2e4-4f2a-a951-de358ceb9ab5/users", func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Query().Get("cursor") == "" {
+			fmt.Fprint(writer, `{"users":[{"username":"a"}],"meta":{"total":2,"links":{"next":"next","prev":""}}}`)
+			return
+		}
+		fmt.Fprint(writer, `{"users":[{"username":"b"}],"meta":{"total":2,"links":{"next":"","prev":""}}}`)
+	})
+
+	users, err := ListAll(ctx, ScopedList(client.Database.ListUsers, "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5"), nil)
+	if err != nil {
+		t.Fatalf("ListAll returned %+v", err)
+	}
+
+	expected := []DatabaseUser{{Username: "a"}, {Username: "b"}}
+	if !reflect.DeepEqual(users, expected) {
+		t.Errorf("ListAll returned %+v, expected %+v", users, expected)
+	}
+}
+
+func TestDatabaseServiceHandler_ListConnectionPools(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/databases/999c4ed0-f2e4-4f2a-a951-de358ceb9ab5/connection-pools", func(writer http.ResponseWriter, request *http.Request) {
+		if cursor := request.URL.Query().Get("cursor"); cursor != "next" {
+			t.Errorf("cursor = %q, expected next", cursor)
+		}
+		fmt.Fprint(writer, `{"connections":{"used":1,"available":96,"max":97},"connection_pools":[{"name":"b"}],"meta":{"total":2,"links":{"next":"","prev":""}}}`) //nolint:lll
+	})
+
+	connections, pools, _, _, err := client.Database.ListConnectionPools(ctx, "999c4ed0-f2e4-4f2a-a951-de358ceb9ab5", &ListOptions{Cursor: "next"})
+	if err != nil {
+		t.Fatalf("Database.ListConnectionPools returned %+v", err)
+	}
+
+	expectedConnections := &DatabaseConnections{Used: 1, Available: 96, Max: 97}
+	if !reflect.DeepEqual(connections, expectedConnections) {
+		t.Errorf("Database.ListConnectionPools returned %+v, expected %+v", connections, expectedConnections)
+	}
+
+	expected := []DatabaseConnectionPool{{Name: "b"}}
+	if !reflect.DeepEqual(pools, expected) {
+		t.Errorf("Database.ListConnectionPools returned %+v, expected %+v", pools, expected)
+	}
+}
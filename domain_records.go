@@ -11,11 +11,11 @@ import (
 // DomainRecordService is the interface to interact with the DNS Records endpoints on the Vultr API
 // Link: https://www.vultr.com/api/#tag/dns
 type DomainRecordService interface {
-	Create(ctx context.Context, domain string, domainRecordReq *DomainRecordReq) (*DomainRecord, *http.Response, error)
-	Get(ctx context.Context, domain, recordID string) (*DomainRecord, *http.Response, error)
-	Update(ctx context.Context, domain, recordID string, domainRecordReq *DomainRecordReq) error
-	Delete(ctx context.Context, domain, recordID string) error
-	List(ctx context.Context, domain string, options *ListOptions) ([]DomainRecord, *Meta, *http.Response, error)
+	Create(ctx context.Context, domain string, domainRecordReq *DomainRecordReq) (*DomainRecord, *Response, error)
+	Get(ctx context.Context, domain, recordID string) (*DomainRecord, *Response, error)
+	Update(ctx context.Context, domain, recordID string, domainRecordReq *DomainRecordReq) (*Response, error)
+	Delete(ctx context.Context, domain, recordID string) (*Response, error)
+	List(ctx context.Context, domain string, options *ListOptions) ([]DomainRecord, *Meta, *Response, error)
 }
 
 // DomainRecordsServiceHandler handles interaction with the DNS Records methods for the Vultr API
@@ -42,6 +42,31 @@ type DomainRecordReq struct {
 	Priority *int   `json:"priority,omitempty"`
 }
 
+// Validate checks that the type and data of a new record are given. The name can be left empty
+// for a record on the domain itself.
+func (r DomainRecordReq) Validate() error {
+	v := new(validation)
+	v.required("type", r.Type, "data", r.Data)
+	r.validate(v)
+	return v.err()
+}
+
+// validateUpdate checks the TTL and priority, the other fields can be left unchanged
+func (r DomainRecordReq) validateUpdate() error {
+	v := new(validation)
+	r.validate(v)
+	return v.err()
+}
+
+func (r DomainRecordReq) validate(v *validation) {
+	if r.TTL < 0 {
+		v.add("ttl", "%d is negative", r.TTL)
+	}
+	if r.Priority != nil && *r.Priority < 0 {
+		v.add("priority", "%d is negative", *r.Priority)
+	}
+}
+
 type domainRecordsBase struct {
 	Records []DomainRecord `json:"records,omitempty"`
 	Meta    *Meta          `json:"meta,omitempty"`
@@ -52,7 +77,7 @@ type domainRecordBase struct {
 }
 
 // Create will add a DNS record.
-func (d *DomainRecordsServiceHandler) Create(ctx context.Context, domain string, domainRecordReq *DomainRecordReq) (*DomainRecord, *http.Response, error) { //nolint:lll
+func (d *DomainRecordsServiceHandler) Create(ctx context.Context, domain string, domainRecordReq *DomainRecordReq) (*DomainRecord, *Response, error) { //nolint:lll
 	req, err := d.client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("%s/%s/records", domainPath, domain), domainRecordReq)
 	if err != nil {
 		return nil, nil, err
@@ -68,7 +93,7 @@ func (d *DomainRecordsServiceHandler) Create(ctx context.Context, domain string,
 }
 
 // Get record from a domain
-func (d *DomainRecordsServiceHandler) Get(ctx context.Context, domain, recordID string) (*DomainRecord, *http.Response, error) {
+func (d *DomainRecordsServiceHandler) Get(ctx context.Context, domain, recordID string) (*DomainRecord, *Response, error) {
 	req, err := d.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/records/%s", domainPath, domain, recordID), nil)
 	if err != nil {
 		return nil, nil, err
@@ -84,28 +109,26 @@ func (d *DomainRecordsServiceHandler) Get(ctx context.Context, domain, recordID
 }
 
 // Update will update a Domain record
-func (d *DomainRecordsServiceHandler) Update(ctx context.Context, domain, recordID string, domainRecordReq *DomainRecordReq) error {
+func (d *DomainRecordsServiceHandler) Update(ctx context.Context, domain, recordID string, domainRecordReq *DomainRecordReq) (*Response, error) {
 	req, err := d.client.NewRequest(ctx, http.MethodPatch, fmt.Sprintf("%s/%s/records/%s", domainPath, domain, recordID), domainRecordReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = d.client.DoWithContext(ctx, req, nil)
-	return err
+	return d.client.DoWithContext(ctx, req, nil)
 }
 
 // Delete will delete a domain name and all associated records.
-func (d *DomainRecordsServiceHandler) Delete(ctx context.Context, domain, recordID string) error {
+func (d *DomainRecordsServiceHandler) Delete(ctx context.Context, domain, recordID string) (*Response, error) {
 	req, err := d.client.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/%s/records/%s", domainPath, domain, recordID), nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = d.client.DoWithContext(ctx, req, nil)
-	return err
+	return d.client.DoWithContext(ctx, req, nil)
 }
 
 // List will list all the records associated with a particular domain on Vultr.
-func (d *DomainRecordsServiceHandler) List(ctx context.Context, domain string, options *ListOptions) ([]DomainRecord, *Meta, *http.Response, error) { //nolint:lll
+func (d *DomainRecordsServiceHandler) List(ctx context.Context, domain string, options *ListOptions) ([]DomainRecord, *Meta, *Response, error) { //nolint:lll
 	req, err := d.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/records", domainPath, domain), nil)
 	if err != nil {
 		return nil, nil, nil, err
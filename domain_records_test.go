@@ -84,7 +84,7 @@ func TestDomainRecordsServiceHandler_Update(t *testing.T) {
 		TTL:      1200,
 		Priority: &p,
 	}
-	err := client.DomainRecord.Update(ctx, "vultr.com", "abc123", r)
+	_, err := client.DomainRecord.Update(ctx, "vultr.com", "abc123", r)
 	if err != nil {
 		t.Errorf("DNSRecord.Update returned %+v, expected %+v", err, nil)
 	}
@@ -98,7 +98,7 @@ func TestDomainRecordsServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.DomainRecord.Delete(ctx, "vultr.com", "abc123")
+	_, err := client.DomainRecord.Delete(ctx, "vultr.com", "abc123")
 	if err != nil {
 		t.Errorf("DomainRecord.Delete returned %+v, expected %+v", err, nil)
 	}
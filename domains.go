@@ -3,6 +3,7 @@ package govultr
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 
 	"github.com/google/go-querystring/query"
@@ -13,16 +14,16 @@ const domainPath = "/v2/domains"
 // DomainService is the interface to interact with the DNS endpoints on the Vultr API
 // https://www.vultr.com/api/#tag/dns
 type DomainService interface {
-	Create(ctx context.Context, domainReq *DomainReq) (*Domain, *http.Response, error)
-	Get(ctx context.Context, domain string) (*Domain, *http.Response, error)
-	Update(ctx context.Context, domain, dnsSec string) error
-	Delete(ctx context.Context, domain string) error
-	List(ctx context.Context, options *ListOptions) ([]Domain, *Meta, *http.Response, error)
+	Create(ctx context.Context, domainReq *DomainReq) (*Domain, *Response, error)
+	Get(ctx context.Context, domain string) (*Domain, *Response, error)
+	Update(ctx context.Context, domain, dnsSec string) (*Response, error)
+	Delete(ctx context.Context, domain string) (*Response, error)
+	List(ctx context.Context, options *ListOptions) ([]Domain, *Meta, *Response, error)
 
-	GetSoa(ctx context.Context, domain string) (*Soa, *http.Response, error)
-	UpdateSoa(ctx context.Context, domain string, soaReq *Soa) error
+	GetSoa(ctx context.Context, domain string) (*Soa, *Response, error)
+	UpdateSoa(ctx context.Context, domain string, soaReq *Soa) (*Response, error)
 
-	GetDNSSec(ctx context.Context, domain string) ([]string, *http.Response, error)
+	GetDNSSec(ctx context.Context, domain string) ([]string, *Response, error)
 }
 
 // DomainServiceHandler handles interaction with the DNS methods for the Vultr API
@@ -32,9 +33,9 @@ type DomainServiceHandler struct {
 
 // Domain represents a Domain entry on Vultr
 type Domain struct {
-	Domain      string `json:"domain,omitempty"`
-	DateCreated string `json:"date_created,omitempty"`
-	DNSSec      string `json:"dns_sec,omitempty"`
+	Domain      string    `json:"domain,omitempty"`
+	DateCreated Timestamp `json:"date_created,omitzero"`
+	DNSSec      string    `json:"dns_sec,omitempty"`
 }
 
 // DomainReq is the struct to create a domain
@@ -45,6 +46,20 @@ type DomainReq struct {
 	DNSSec string `json:"dns_sec,omitempty"`
 }
 
+// Validate checks that the domain is given and that the IP and DNSSEC setting, when given, are valid
+func (r DomainReq) Validate() error {
+	v := new(validation)
+	v.required("domain", r.Domain)
+	v.hostname("domain", r.Domain)
+	if r.IP != "" && net.ParseIP(r.IP) == nil {
+		v.add("ip", "%q is not an IP address", r.IP)
+	}
+	if r.DNSSec != "" && r.DNSSec != "enabled" && r.DNSSec != "disabled" {
+		v.add("dns_sec", "%q is neither enabled nor disabled", r.DNSSec)
+	}
+	return v.err()
+}
+
 type domainsBase struct {
 	Domains []Domain `json:"domains"`
 	Meta    *Meta    `json:"meta"`
@@ -69,7 +84,7 @@ type dnsSecBase struct {
 }
 
 // Create a domain entry
-func (d *DomainServiceHandler) Create(ctx context.Context, domainReq *DomainReq) (*Domain, *http.Response, error) {
+func (d *DomainServiceHandler) Create(ctx context.Context, domainReq *DomainReq) (*Domain, *Response, error) {
 	req, err := d.client.NewRequest(ctx, http.MethodPost, domainPath, domainReq)
 	if err != nil {
 		return nil, nil, err
@@ -85,7 +100,7 @@ func (d *DomainServiceHandler) Create(ctx context.Context, domainReq *DomainReq)
 }
 
 // Get a domain from your Vultr account.
-func (d *DomainServiceHandler) Get(ctx context.Context, domain string) (*Domain, *http.Response, error) {
+func (d *DomainServiceHandler) Get(ctx context.Context, domain string) (*Domain, *Response, error) {
 	req, err := d.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s", domainPath, domain), nil)
 	if err != nil {
 		return nil, nil, err
@@ -102,29 +117,27 @@ func (d *DomainServiceHandler) Get(ctx context.Context, domain string) (*Domain,
 
 // Update allows you to enable or disable DNS Sec on the domain.
 // The two valid options for dnsSec are "enabled" or "disabled"
-func (d *DomainServiceHandler) Update(ctx context.Context, domain, dnsSec string) error {
+func (d *DomainServiceHandler) Update(ctx context.Context, domain, dnsSec string) (*Response, error) {
 	body := &RequestBody{"dns_sec": dnsSec}
 	req, err := d.client.NewRequest(ctx, http.MethodPut, fmt.Sprintf("%s/%s", domainPath, domain), body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = d.client.DoWithContext(ctx, req, nil)
-	return err
+	return d.client.DoWithContext(ctx, req, nil)
 }
 
 // Delete a domain with all associated records.
-func (d *DomainServiceHandler) Delete(ctx context.Context, domain string) error {
+func (d *DomainServiceHandler) Delete(ctx context.Context, domain string) (*Response, error) {
 	req, err := d.client.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", domainPath, domain), nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = d.client.DoWithContext(ctx, req, nil)
-	return err
+	return d.client.DoWithContext(ctx, req, nil)
 }
 
 // List gets all domains associated with the current Vultr account.
-func (d *DomainServiceHandler) List(ctx context.Context, options *ListOptions) ([]Domain, *Meta, *http.Response, error) { //nolint:dupl
+func (d *DomainServiceHandler) List(ctx context.Context, options *ListOptions) ([]Domain, *Meta, *Response, error) { //nolint:dupl
 	req, err := d.client.NewRequest(ctx, http.MethodGet, domainPath, nil)
 	if err != nil {
 		return nil, nil, nil, err
@@ -147,7 +160,7 @@ func (d *DomainServiceHandler) List(ctx context.Context, options *ListOptions) (
 }
 
 // GetSoa gets the SOA record information for a domain
-func (d *DomainServiceHandler) GetSoa(ctx context.Context, domain string) (*Soa, *http.Response, error) {
+func (d *DomainServiceHandler) GetSoa(ctx context.Context, domain string) (*Soa, *Response, error) {
 	req, err := d.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/soa", domainPath, domain), nil)
 	if err != nil {
 		return nil, nil, err
@@ -163,18 +176,17 @@ func (d *DomainServiceHandler) GetSoa(ctx context.Context, domain string) (*Soa,
 }
 
 // UpdateSoa will update the SOA record information for a domain.
-func (d *DomainServiceHandler) UpdateSoa(ctx context.Context, domain string, soaReq *Soa) error {
+func (d *DomainServiceHandler) UpdateSoa(ctx context.Context, domain string, soaReq *Soa) (*Response, error) {
 	req, err := d.client.NewRequest(ctx, http.MethodPatch, fmt.Sprintf("%s/%s/soa", domainPath, domain), soaReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = d.client.DoWithContext(ctx, req, nil)
-	return err
+	return d.client.DoWithContext(ctx, req, nil)
 }
 
 // GetDNSSec gets the DNSSec keys for a domain (if enabled)
-func (d *DomainServiceHandler) GetDNSSec(ctx context.Context, domain string) ([]string, *http.Response, error) {
+func (d *DomainServiceHandler) GetDNSSec(ctx context.Context, domain string) ([]string, *Response, error) {
 	req, err := d.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/dnssec", domainPath, domain), nil)
 	if err != nil {
 		return nil, nil, err
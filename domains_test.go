@@ -26,7 +26,7 @@ func TestDomainServiceHandler_Create(t *testing.T) {
 
 	expected := &Domain{
 		Domain:      "vultr.com",
-		DateCreated: "2020-05-08 19:09:07",
+		DateCreated: timestamp("2020-05-08 19:09:07"),
 	}
 
 	if !reflect.DeepEqual(domain, expected) {
@@ -50,7 +50,7 @@ func TestDomainServiceHandler_Get(t *testing.T) {
 
 	expected := &Domain{
 		Domain:      "vultr.com",
-		DateCreated: "2020-05-08 19:09:07",
+		DateCreated: timestamp("2020-05-08 19:09:07"),
 	}
 
 	if !reflect.DeepEqual(domain, expected) {
@@ -65,7 +65,7 @@ func TestDomainServiceHandler_Update(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.Domain.Update(ctx, "vultr.com", "enabled")
+	_, err := client.Domain.Update(ctx, "vultr.com", "enabled")
 	if err != nil {
 		t.Errorf("Domain.Update returned %+v, expected %+v", err, nil)
 	}
@@ -79,7 +79,7 @@ func TestDomainServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.Domain.Delete(ctx, "domain.com")
+	_, err := client.Domain.Delete(ctx, "domain.com")
 	if err != nil {
 		t.Errorf("Domain.Delete returned %+v, expected %+v", err, nil)
 	}
@@ -105,7 +105,7 @@ func TestDNSDomainServiceHandler_List(t *testing.T) {
 	expectedDomain := []Domain{
 		{
 			Domain:      "vultr.com",
-			DateCreated: "2020-05-0819:09:07",
+			DateCreated: timestamp("2020-05-0819:09:07"),
 		},
 	}
 
@@ -159,7 +159,7 @@ func TestDNSDomainServiceHandler_UpdateSoa(t *testing.T) {
 		NSPrimary: "ns4.vultr.com",
 		Email:     "vultr@vultr.com",
 	}
-	err := client.Domain.UpdateSoa(ctx, "vultr.com", r)
+	_, err := client.Domain.UpdateSoa(ctx, "vultr.com", r)
 
 	if err != nil {
 		t.Errorf("Domain.UpdateSoa returned %+v, expected %+v", err, nil)
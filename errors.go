@@ -0,0 +1,110 @@
+package govultr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned whenever the Vultr API responds with a non-2xx status code.
+// Use errors.As to retrieve it from an error returned by any of the services.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+
+	// Method and Path of the request that failed
+	Method string
+	Path   string
+
+	// Message and Status are parsed from the Vultr error payload: {"error": "...", "status": 400}
+	Message string
+	Status  int
+
+	// Retries is the number of times the request was retried before giving up
+	Retries int
+
+	// RequestID identifies the request for Vultr support, when the API returned one
+	RequestID string
+
+	// Body is the raw response body
+	Body []byte
+}
+
+type apiErrorBase struct {
+	Error  string `json:"error"`
+	Status int    `json:"status"`
+}
+
+// newAPIError builds an APIError from a response and its already read body.
+func newAPIError(res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		RequestID:  requestID(res.Header),
+		Body:       body,
+	}
+
+	if res.Request != nil {
+		apiErr.Method = res.Request.Method
+		apiErr.Path = res.Request.URL.Path
+	}
+
+	payload := new(apiErrorBase)
+	if err := json.Unmarshal(body, payload); err == nil {
+		apiErr.Message = payload.Error
+		apiErr.Status = payload.Status
+	}
+
+	return apiErr
+}
+
+// Error keeps the message format returned by previous versions of the client: the raw
+// response body, prefixed with the number of attempts when the request was retried.
+func (e *APIError) Error() string {
+	body := strings.TrimSpace(string(e.Body))
+
+	if e.Retries > 0 {
+		return fmt.Sprintf("gave up after %d attempts, last error: %#v", e.Retries+1, body)
+	}
+
+	if body == "" {
+		return fmt.Sprintf("%s %s: %d %s", e.Method, e.Path, e.StatusCode, http.StatusText(e.StatusCode))
+	}
+
+	return body
+}
+
+// IsNotFound reports whether err is an APIError with a 404 status code.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsRateLimited reports whether err is an APIError with a 429 status code.
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsUnauthorized reports whether err is an APIError with a 401 status code.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is an APIError with a 403 status code.
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsBadRequest reports whether err is an APIError with a 400 status code.
+// Vultr uses this status for validation failures.
+func IsBadRequest(err error) bool {
+	return hasStatusCode(err, http.StatusBadRequest)
+}
+
+func hasStatusCode(err error, code int) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == code
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+package govultr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError_NotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances/missing", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(writer, `{"error":"instance not found","status":404}`)
+	})
+
+	_, _, err := client.Instance.Get(ctx, "missing")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+
+	expected := &APIError{
+		StatusCode: http.StatusNotFound,
+		Method:     http.MethodGet,
+		Path:       "/v2/instances/missing",
+		Message:    "instance not found",
+		Status:     404,
+		Body:       []byte(`{"error":"instance not found","status":404}`),
+	}
+
+	if apiErr.StatusCode != expected.StatusCode || apiErr.Method != expected.Method || apiErr.Path != expected.Path ||
+		apiErr.Message != expected.Message || apiErr.Status != expected.Status || apiErr.Retries != 0 {
+		t.Errorf("APIError = %+v, expected %+v", apiErr, expected)
+	}
+
+	if err.Error() != string(expected.Body) {
+		t.Errorf("APIError.Error() = %v, expected %v", err.Error(), string(expected.Body))
+	}
+
+	if !IsNotFound(err) {
+		t.Error("IsNotFound returned false")
+	}
+
+	if IsRateLimited(err) || IsUnauthorized(err) || IsForbidden(err) || IsBadRequest(err) {
+		t.Error("expected only IsNotFound to match")
+	}
+}
+
+func TestAPIError_Retries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRateLimit(0)
+
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(writer, `{"error":"rate limit exceeded","status":429}`)
+	})
+
+	_, _, err := client.Account.Get(ctx)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+
+	if apiErr.Retries != retryLimit {
+		t.Errorf("APIError.Retries = %v, expected %v", apiErr.Retries, retryLimit)
+	}
+
+	if apiErr.Method != http.MethodGet || apiErr.Path != "/v2/account" {
+		t.Errorf("APIError request = %v %v, expected GET /v2/account", apiErr.Method, apiErr.Path)
+	}
+
+	if !IsRateLimited(err) {
+		t.Error("IsRateLimited returned false")
+	}
+}
+
+func TestAPIError_EmptyBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, _, err := client.Account.Get(ctx)
+	if !IsUnauthorized(err) {
+		t.Fatalf("IsUnauthorized returned false for %v", err)
+	}
+
+	expected := "GET /v2/account: 401 Unauthorized"
+	if err.Error() != expected {
+		t.Errorf("APIError.Error() = %v, expected %v", err.Error(), expected)
+	}
+}
+
+func TestAPIError_Predicates(t *testing.T) {
+	if IsNotFound(nil) || IsNotFound(errors.New("404")) {
+		t.Error("IsNotFound should only match an APIError")
+	}
+
+	wrapped := fmt.Errorf("wrapped: %w", &APIError{StatusCode: http.StatusForbidden})
+	if !IsForbidden(wrapped) {
+		t.Error("IsForbidden should match a wrapped APIError")
+	}
+
+	if !IsBadRequest(&APIError{StatusCode: http.StatusBadRequest}) {
+		t.Error("IsBadRequest returned false")
+	}
+}
@@ -11,11 +11,11 @@ import (
 // FirewallGroupService is the interface to interact with the firewall group endpoints on the Vultr API
 // Link : https://www.vultr.com/api/#tag/firewall
 type FirewallGroupService interface { //nolint:dupl
-	Create(ctx context.Context, fwGroupReq *FirewallGroupReq) (*FirewallGroup, *http.Response, error)
-	Get(ctx context.Context, groupID string) (*FirewallGroup, *http.Response, error)
-	Update(ctx context.Context, fwGroupID string, fwGroupReq *FirewallGroupReq) error
-	Delete(ctx context.Context, fwGroupID string) error
-	List(ctx context.Context, options *ListOptions) ([]FirewallGroup, *Meta, *http.Response, error)
+	Create(ctx context.Context, fwGroupReq *FirewallGroupReq) (*FirewallGroup, *Response, error)
+	Get(ctx context.Context, groupID string) (*FirewallGroup, *Response, error)
+	Update(ctx context.Context, fwGroupID string, fwGroupReq *FirewallGroupReq) (*Response, error)
+	Delete(ctx context.Context, fwGroupID string) (*Response, error)
+	List(ctx context.Context, options *ListOptions) ([]FirewallGroup, *Meta, *Response, error)
 }
 
 // FireWallGroupServiceHandler handles interaction with the firewall group methods for the Vultr API
@@ -25,13 +25,13 @@ type FireWallGroupServiceHandler struct {
 
 // FirewallGroup represents a Vultr firewall group
 type FirewallGroup struct {
-	ID            string `json:"id"`
-	Description   string `json:"description"`
-	DateCreated   string `json:"date_created"`
-	DateModified  string `json:"date_modified"`
-	InstanceCount int    `json:"instance_count"`
-	RuleCount     int    `json:"rule_count"`
-	MaxRuleCount  int    `json:"max_rule_count"`
+	ID            string    `json:"id"`
+	Description   string    `json:"description"`
+	DateCreated   Timestamp `json:"date_created"`
+	DateModified  Timestamp `json:"date_modified"`
+	InstanceCount int       `json:"instance_count"`
+	RuleCount     int       `json:"rule_count"`
+	MaxRuleCount  int       `json:"max_rule_count"`
 }
 
 // FirewallGroupReq struct is used to create and update a Firewall Group.
@@ -39,6 +39,18 @@ type FirewallGroupReq struct {
 	Description string `json:"description"`
 }
 
+// Validate accepts any new firewall group, the description is optional on create
+func (r FirewallGroupReq) Validate() error {
+	return nil
+}
+
+// validateUpdate checks that the description is given, as it replaces the current one
+func (r FirewallGroupReq) validateUpdate() error {
+	v := new(validation)
+	v.required("description", r.Description)
+	return v.err()
+}
+
 type firewallGroupsBase struct {
 	FirewallGroups []FirewallGroup `json:"firewall_groups"`
 	Meta           *Meta           `json:"meta"`
@@ -49,7 +61,7 @@ type firewallGroupBase struct {
 }
 
 // Create will create a new firewall group on your Vultr account
-func (f *FireWallGroupServiceHandler) Create(ctx context.Context, fwGroupReq *FirewallGroupReq) (*FirewallGroup, *http.Response, error) {
+func (f *FireWallGroupServiceHandler) Create(ctx context.Context, fwGroupReq *FirewallGroupReq) (*FirewallGroup, *Response, error) {
 	uri := "/v2/firewalls"
 
 	req, err := f.client.NewRequest(ctx, http.MethodPost, uri, fwGroupReq)
@@ -67,7 +79,7 @@ func (f *FireWallGroupServiceHandler) Create(ctx context.Context, fwGroupReq *Fi
 }
 
 // Get will return a firewall group based on provided groupID from your Vultr account
-func (f *FireWallGroupServiceHandler) Get(ctx context.Context, fwGroupID string) (*FirewallGroup, *http.Response, error) {
+func (f *FireWallGroupServiceHandler) Get(ctx context.Context, fwGroupID string) (*FirewallGroup, *Response, error) {
 	uri := fmt.Sprintf("/v2/firewalls/%s", fwGroupID)
 
 	req, err := f.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -85,32 +97,30 @@ func (f *FireWallGroupServiceHandler) Get(ctx context.Context, fwGroupID string)
 }
 
 // Update will change the description of a firewall group
-func (f *FireWallGroupServiceHandler) Update(ctx context.Context, fwGroupID string, fwGroupReq *FirewallGroupReq) error {
+func (f *FireWallGroupServiceHandler) Update(ctx context.Context, fwGroupID string, fwGroupReq *FirewallGroupReq) (*Response, error) {
 	uri := fmt.Sprintf("/v2/firewalls/%s", fwGroupID)
 
 	req, err := f.client.NewRequest(ctx, http.MethodPut, uri, fwGroupReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = f.client.DoWithContext(ctx, req, nil)
-	return err
+	return f.client.DoWithContext(ctx, req, nil)
 }
 
 // Delete will delete a firewall group from your Vultr account
-func (f *FireWallGroupServiceHandler) Delete(ctx context.Context, fwGroupID string) error {
+func (f *FireWallGroupServiceHandler) Delete(ctx context.Context, fwGroupID string) (*Response, error) {
 	uri := fmt.Sprintf("/v2/firewalls/%s", fwGroupID)
 
 	req, err := f.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = f.client.DoWithContext(ctx, req, nil)
-	return err
+	return f.client.DoWithContext(ctx, req, nil)
 }
 
 // List will return a list of  all firewall groups on your Vultr account
-func (f *FireWallGroupServiceHandler) List(ctx context.Context, options *ListOptions) ([]FirewallGroup, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (f *FireWallGroupServiceHandler) List(ctx context.Context, options *ListOptions) ([]FirewallGroup, *Meta, *Response, error) { //nolint:dupl,lll
 	uri := "/v2/firewalls"
 
 	req, err := f.client.NewRequest(ctx, http.MethodGet, uri, nil)
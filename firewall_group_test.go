@@ -25,8 +25,8 @@ func TestFireWallGroupServiceHandler_Create(t *testing.T) {
 	expected := &FirewallGroup{
 		ID:            "44d0f934",
 		Description:   "govultr test",
-		DateCreated:   "2020-07-0913:53:34",
-		DateModified:  "2020-07-0913:53:34",
+		DateCreated:   timestamp("2020-07-0913:53:34"),
+		DateModified:  timestamp("2020-07-0913:53:34"),
 		InstanceCount: 15,
 		RuleCount:     6,
 		MaxRuleCount:  999,
@@ -54,8 +54,8 @@ func TestFireWallGroupServiceHandler_Get(t *testing.T) {
 	expected := &FirewallGroup{
 		ID:            "44d0f934",
 		Description:   "govultr test",
-		DateCreated:   "2020-07-0913:53:34",
-		DateModified:  "2020-07-0913:53:34",
+		DateCreated:   timestamp("2020-07-0913:53:34"),
+		DateModified:  timestamp("2020-07-0913:53:34"),
 		InstanceCount: 15,
 		RuleCount:     6,
 		MaxRuleCount:  999,
@@ -75,7 +75,7 @@ func TestFireWallGroupServiceHandler_ChangeDescription(t *testing.T) {
 	})
 
 	put := &FirewallGroupReq{Description: "test"}
-	err := client.FirewallGroup.Update(ctx, "abc123", put)
+	_, err := client.FirewallGroup.Update(ctx, "abc123", put)
 	if err != nil {
 		t.Errorf("FirewallGroup.ChangeDescription returned error: %v", err)
 	}
@@ -89,7 +89,7 @@ func TestFireWallGroupServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.FirewallGroup.Delete(ctx, "abc123")
+	_, err := client.FirewallGroup.Delete(ctx, "abc123")
 
 	if err != nil {
 		t.Errorf("FirewallGroup.Delete returned error: %v", err)
@@ -114,8 +114,8 @@ func TestFireWallGroupServiceHandler_List(t *testing.T) {
 		{
 			ID:            "44d0f934",
 			Description:   "govultr test",
-			DateCreated:   "2020-07-0913:53:34",
-			DateModified:  "2020-07-0913:53:34",
+			DateCreated:   timestamp("2020-07-0913:53:34"),
+			DateModified:  timestamp("2020-07-0913:53:34"),
 			InstanceCount: 15,
 			RuleCount:     6,
 			MaxRuleCount:  999,
@@ -11,10 +11,10 @@ import (
 // FireWallRuleService is the interface to interact with the firewall rule endpoints on the Vultr API
 // Link : https://www.vultr.com/api/#tag/firewall
 type FireWallRuleService interface {
-	Create(ctx context.Context, fwGroupID string, fwRuleReq *FirewallRuleReq) (*FirewallRule, *http.Response, error)
-	Get(ctx context.Context, fwGroupID string, fwRuleID int) (*FirewallRule, *http.Response, error)
-	Delete(ctx context.Context, fwGroupID string, fwRuleID int) error
-	List(ctx context.Context, fwGroupID string, options *ListOptions) ([]FirewallRule, *Meta, *http.Response, error)
+	Create(ctx context.Context, fwGroupID string, fwRuleReq *FirewallRuleReq) (*FirewallRule, *Response, error)
+	Get(ctx context.Context, fwGroupID string, fwRuleID int) (*FirewallRule, *Response, error)
+	Delete(ctx context.Context, fwGroupID string, fwRuleID int) (*Response, error)
+	List(ctx context.Context, fwGroupID string, options *ListOptions) ([]FirewallRule, *Meta, *Response, error)
 }
 
 // FireWallRuleServiceHandler handles interaction with the firewall rule methods for the Vultr API
@@ -24,28 +24,89 @@ type FireWallRuleServiceHandler struct {
 
 // FirewallRule represents a Vultr firewall rule
 type FirewallRule struct {
-	ID     int    `json:"id"`
-	Action string `json:"action"`
+	ID     int            `json:"id"`
+	Action FirewallAction `json:"action"`
 	// Deprecated:  Type should no longer be used. Instead, use IPType.
-	Type       string `json:"type"`
-	IPType     string `json:"ip_type"`
-	Protocol   string `json:"protocol"`
-	Port       string `json:"port"`
-	Subnet     string `json:"subnet"`
-	SubnetSize int    `json:"subnet_size"`
-	Source     string `json:"source"`
-	Notes      string `json:"notes"`
+	Type       string           `json:"type"`
+	IPType     IPType           `json:"ip_type"`
+	Protocol   FirewallProtocol `json:"protocol"`
+	Port       string           `json:"port"`
+	Subnet     string           `json:"subnet"`
+	SubnetSize int              `json:"subnet_size"`
+	Source     string           `json:"source"`
+	Notes      string           `json:"notes"`
 }
 
 // FirewallRuleReq struct used to create a FirewallRule.
 type FirewallRuleReq struct {
-	IPType     string `json:"ip_type"`
-	Protocol   string `json:"protocol"`
-	Subnet     string `json:"subnet"`
-	SubnetSize int    `json:"subnet_size"`
-	Port       string `json:"port,omitempty"`
-	Source     string `json:"source,omitempty"`
-	Notes      string `json:"notes,omitempty"`
+	IPType     IPType           `json:"ip_type"`
+	Protocol   FirewallProtocol `json:"protocol"`
+	Subnet     string           `json:"subnet"`
+	SubnetSize int              `json:"subnet_size"`
+	Port       string           `json:"port,omitempty"`
+	Source     string           `json:"source,omitempty"`
+	Notes      string           `json:"notes,omitempty"`
+}
+
+// Validate checks the IP type and protocol, the port range, only allowed for TCP and UDP rules,
+// and that the rule applies to a network, or to a source
+func (r FirewallRuleReq) Validate() error {
+	v := new(validation)
+	if !r.IPType.IsValid() {
+		v.add("ip_type", "%q is not a known IP type", r.IPType)
+	}
+	if !r.Protocol.IsValid() {
+		v.add("protocol", "%q is not a known protocol", r.Protocol)
+	}
+
+	if r.Port != "" && r.Protocol != FirewallProtocolTCP && r.Protocol != FirewallProtocolUDP {
+		v.add("port", "can only be given for tcp and udp rules")
+	} else {
+		v.portRange("port", r.Port)
+	}
+
+	switch {
+	case r.Subnet != "" && r.IPType == IPTypeV4:
+		v.network("subnet", r.Subnet, "subnet_size", r.SubnetSize, 32)
+	case r.Subnet != "" && r.IPType == IPTypeV6:
+		v.network("subnet", r.Subnet, "subnet_size", r.SubnetSize, 128)
+	case r.Subnet == "" && r.Source == "":
+		v.add("subnet", "one of subnet, source is required")
+	}
+	return v.err()
+}
+
+// FirewallProtocol is the protocol a firewall rule applies to
+type FirewallProtocol string
+
+// Firewall protocols
+const (
+	FirewallProtocolICMP FirewallProtocol = "icmp"
+	FirewallProtocolTCP  FirewallProtocol = "tcp"
+	FirewallProtocolUDP  FirewallProtocol = "udp"
+	FirewallProtocolGRE  FirewallProtocol = "gre"
+	FirewallProtocolESP  FirewallProtocol = "esp"
+	FirewallProtocolAH   FirewallProtocol = "ah"
+)
+
+// IsValid reports whether p is a known firewall protocol
+func (p FirewallProtocol) IsValid() bool {
+	switch p {
+	case FirewallProtocolICMP, FirewallProtocolTCP, FirewallProtocolUDP, FirewallProtocolGRE, FirewallProtocolESP, FirewallProtocolAH:
+		return true
+	}
+	return false
+}
+
+// FirewallAction is what a firewall rule does with the traffic it matches
+type FirewallAction string
+
+// FirewallActionAccept lets the traffic through, it is the only action supported by the API
+const FirewallActionAccept FirewallAction = "accept"
+
+// IsValid reports whether a is a known firewall action
+func (a FirewallAction) IsValid() bool {
+	return a == FirewallActionAccept
 }
 
 type firewallRulesBase struct {
@@ -58,7 +119,7 @@ type firewallRuleBase struct {
 }
 
 // Create will create a rule in a firewall group.
-func (f *FireWallRuleServiceHandler) Create(ctx context.Context, fwGroupID string, fwRuleReq *FirewallRuleReq) (*FirewallRule, *http.Response, error) { //nolint:lll
+func (f *FireWallRuleServiceHandler) Create(ctx context.Context, fwGroupID string, fwRuleReq *FirewallRuleReq) (*FirewallRule, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("/v2/firewalls/%s/rules", fwGroupID)
 
 	req, err := f.client.NewRequest(ctx, http.MethodPost, uri, fwRuleReq)
@@ -76,7 +137,7 @@ func (f *FireWallRuleServiceHandler) Create(ctx context.Context, fwGroupID strin
 }
 
 // Get will get a rule in a firewall group.
-func (f *FireWallRuleServiceHandler) Get(ctx context.Context, fwGroupID string, fwRuleID int) (*FirewallRule, *http.Response, error) {
+func (f *FireWallRuleServiceHandler) Get(ctx context.Context, fwGroupID string, fwRuleID int) (*FirewallRule, *Response, error) {
 	uri := fmt.Sprintf("/v2/firewalls/%s/rules/%d", fwGroupID, fwRuleID)
 
 	req, err := f.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -94,19 +155,18 @@ func (f *FireWallRuleServiceHandler) Get(ctx context.Context, fwGroupID string,
 }
 
 // Delete will delete a firewall rule on your Vultr account
-func (f *FireWallRuleServiceHandler) Delete(ctx context.Context, fwGroupID string, fwRuleID int) error {
+func (f *FireWallRuleServiceHandler) Delete(ctx context.Context, fwGroupID string, fwRuleID int) (*Response, error) {
 	uri := fmt.Sprintf("/v2/firewalls/%s/rules/%d", fwGroupID, fwRuleID)
 
 	req, err := f.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = f.client.DoWithContext(ctx, req, nil)
-	return err
+	return f.client.DoWithContext(ctx, req, nil)
 }
 
 // List will return both ipv4 an ipv6 firewall rules that are defined within a firewall group
-func (f *FireWallRuleServiceHandler) List(ctx context.Context, fwGroupID string, options *ListOptions) ([]FirewallRule, *Meta, *http.Response, error) { //nolint:lll,dupl
+func (f *FireWallRuleServiceHandler) List(ctx context.Context, fwGroupID string, options *ListOptions) ([]FirewallRule, *Meta, *Response, error) { //nolint:lll,dupl
 	uri := fmt.Sprintf("/v2/firewalls/%s/rules", fwGroupID)
 
 	req, err := f.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -20,7 +20,7 @@ func TestFireWallRuleServiceHandler_Create(t *testing.T) {
 		IPType:     "v4",
 		Protocol:   "tcp",
 		Subnet:     "127.0.0.1",
-		SubnetSize: 30,
+		SubnetSize: 32,
 		Port:       "80",
 		Notes:      "thisisanote",
 	}
@@ -56,7 +56,7 @@ func TestFireWallRuleServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.FirewallRule.Delete(ctx, "abc123", 1)
+	_, err := client.FirewallRule.Delete(ctx, "abc123", 1)
 
 	if err != nil {
 		t.Errorf("FirewallRule.Delete returned error: %v", err)
@@ -137,3 +137,21 @@ func TestFireWallRuleServiceHandler_Get(t *testing.T) {
 		t.Errorf("FirewallRule.Get returned %+v, expected %+v", firewallRule, expectedRule)
 	}
 }
+
+func TestFirewallRuleEnums(t *testing.T) {
+	for _, p := range []FirewallProtocol{FirewallProtocolICMP, FirewallProtocolTCP, FirewallProtocolUDP, FirewallProtocolGRE, FirewallProtocolESP, FirewallProtocolAH} {
+		if !p.IsValid() {
+			t.Errorf("FirewallProtocol(%q).IsValid() = false", p)
+		}
+	}
+	if FirewallProtocol("TCP").IsValid() || FirewallProtocol("sctp").IsValid() {
+		t.Error("FirewallProtocol.IsValid accepted an unknown protocol")
+	}
+
+	if !IPTypeV6.IsValid() || IPType("v5").IsValid() {
+		t.Error("IPType.IsValid returned unexpected results")
+	}
+	if !FirewallActionAccept.IsValid() || FirewallAction("drop").IsValid() {
+		t.Error("FirewallAction.IsValid returned unexpected results")
+	}
+}
@@ -7,10 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net"
 	"net/http"
 	"net/url"
-	"strings"
+	"reflect"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -69,6 +70,23 @@ type Client struct {
 
 	// Optional function called after every successful request made to the Vultr API
 	onRequestCompleted RequestCompletionCallback
+
+	// API key sent as a bearer token with every request
+	credentials *Credentials
+
+	// Http Client given by the user, before it is wrapped by the rate limiter
+	httpClient *http.Client
+
+	rateLimiter       *RateLimiter
+	rateLimitObserver RateLimitObserver
+	retryPolicy       RetryPolicy
+	createSkew        time.Duration
+	// IDs returned by creates, shared with the clients derived by With
+	created *createdIDs
+
+	logger     *slog.Logger
+	middleware []Middleware
+	doer       Doer
 }
 
 // RequestCompletionCallback defines the type of the request callback function
@@ -76,38 +94,118 @@ type RequestCompletionCallback func(*http.Request, *http.Response)
 
 // NewClient returns a Vultr API Client
 func NewClient(httpClient *http.Client) *Client {
-	if httpClient == nil {
-		httpClient = &http.Client{
-			Transport: &http.Transport{
-				DialContext: (&net.Dialer{
-					Timeout:   30 * time.Second,
-					KeepAlive: 30 * time.Second,
-					DualStack: true,
-				}).DialContext,
-				MaxIdleConns:          100,
-				IdleConnTimeout:       90 * time.Second,
-				TLSHandshakeTimeout:   10 * time.Second,
-				ExpectContinueTimeout: 1 * time.Second,
-				MaxIdleConnsPerHost:   -1,
-				DisableKeepAlives:     true,
-			},
-			Timeout: 5 * time.Second,
+	o := defaultClientOptions()
+	o.httpClient = httpClient
+
+	return newClient(o)
+}
+
+// New returns a Vultr API Client configured with the given options. Unlike the Set* methods,
+// options are applied before the client is returned so it is safe to use concurrently.
+func New(opts ...Option) (*Client, error) {
+	o := defaultClientOptions()
+	if err := o.apply(opts); err != nil {
+		return nil, err
+	}
+
+	return newClient(o), nil
+}
+
+// With returns a copy of the client with the given options applied on top of its current
+// configuration. The copy shares the http.Client, and its connection pool, unless
+// WithHTTPClient is given. The receiver is left untouched.
+func (c *Client) With(opts ...Option) (*Client, error) {
+	o := c.options()
+	if err := o.apply(opts); err != nil {
+		return nil, err
+	}
+
+	return newClient(o), nil
+}
+
+func (o *clientOptions) apply(opts []Option) error {
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return err
 		}
 	}
+	return nil
+}
+
+// options returns a snapshot of the client configuration
+func (c *Client) options() *clientOptions {
+	baseURL := *c.BaseURL
+
+	return &clientOptions{
+		httpClient:         c.httpClient,
+		baseURL:            &baseURL,
+		userAgent:          c.UserAgent,
+		retryLimit:         c.client.RetryMax,
+		retryWaitMin:       c.client.RetryWaitMin,
+		retryWaitMax:       c.client.RetryWaitMax,
+		logger:             c.logger,
+		middleware:         append([]Middleware(nil), c.middleware...),
+		credentials:        c.credentials,
+		onRequestCompleted: c.onRequestCompleted,
+		rateLimiter:        c.rateLimiter,
+		rateLimitObserver:  c.rateLimitObserver,
+		retryPolicy:        c.retryPolicy,
+		createSkew:         c.createSkew,
+		created:            c.created,
+	}
+}
 
-	baseURL, _ := url.Parse(defaultBase)
+func newClient(o *clientOptions) *Client {
+	httpClient := o.httpClient
+	if httpClient == nil {
+		httpClient = defaultHTTPClient()
+	}
 
 	client := &Client{
-		client:    retryablehttp.NewClient(),
-		BaseURL:   baseURL,
-		UserAgent: userAgent,
+		client:             retryablehttp.NewClient(),
+		BaseURL:            o.baseURL,
+		UserAgent:          o.userAgent,
+		onRequestCompleted: o.onRequestCompleted,
+		credentials:        o.credentials,
+		logger:             o.logger,
+		middleware:         o.middleware,
+		httpClient:         httpClient,
+		rateLimiter:        o.rateLimiter,
+		rateLimitObserver:  o.rateLimitObserver,
+		retryPolicy:        o.retryPolicy,
+		createSkew:         o.createSkew,
+		created:            o.created,
+	}
+	if client.created == nil {
+		client.created = newCreatedIDs()
 	}
 
 	client.client.HTTPClient = httpClient
+	if o.rateLimiter != nil {
+		base := httpClient.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+
+		limited := *httpClient
+		limited.Transport = &rateLimitTransport{base: base, limiter: o.rateLimiter, observer: o.rateLimitObserver}
+		client.client.HTTPClient = &limited
+	}
 	client.client.Logger = nil
+	client.client.RequestLogHook = countAttempts
 	client.client.ErrorHandler = client.vultrErrorHandler
-	client.SetRetryLimit(retryLimit)
-	client.SetRateLimit(rateLimit)
+	client.client.CheckRetry = client.checkRetry
+	client.client.RetryMax = o.retryLimit
+	client.client.RetryWaitMin = o.retryWaitMin
+	client.client.RetryWaitMax = o.retryWaitMax
+
+	var middleware []Middleware
+	if o.logger != nil {
+		middleware = append(middleware, LogRequests(o.logger))
+	}
+	middleware = append(middleware, client.middleware...)
+	middleware = append(middleware, client.requestCompleted)
+	client.doer = chainMiddleware(DoerFunc(client.send), middleware)
 
 	client.Account = &AccountServiceHandler{client}
 	client.Application = &ApplicationServiceHandler{client}
@@ -139,13 +237,37 @@ func NewClient(httpClient *http.Client) *Client {
 	return client
 }
 
-// NewRequest creates an API Request
+func defaultHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+				DualStack: true,
+			}).DialContext,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+			MaxIdleConnsPerHost:   -1,
+			DisableKeepAlives:     true,
+		},
+		Timeout: 5 * time.Second,
+	}
+}
+
+// NewRequest creates an API Request. Bodies implementing Validator are validated first, so an invalid
+// request fails with a *ValidationError before anything is sent.
 func (c *Client) NewRequest(ctx context.Context, method, uri string, body interface{}) (*http.Request, error) {
 	resolvedURL, err := c.BaseURL.Parse(uri)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := validate(method, body); err != nil {
+		return nil, err
+	}
+
 	buf := new(bytes.Buffer)
 	if body != nil {
 		if err2 := json.NewEncoder(buf).Encode(body); err2 != nil {
@@ -162,30 +284,104 @@ func (c *Client) NewRequest(ctx context.Context, method, uri string, body interf
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
 
+	if c.credentials != nil {
+		req.Header.Set("Authorization", "Bearer "+c.credentials.APIKey)
+	}
+
 	return req, nil
 }
 
+// validate runs the Validate method of request bodies implementing Validator, or validateUpdate for
+// the PUT and PATCH bodies implementing updateValidator
+func validate(method string, body interface{}) error {
+	if v := reflect.ValueOf(body); !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return nil
+	}
+
+	if updater, ok := body.(updateValidator); ok && (method == http.MethodPut || method == http.MethodPatch) {
+		return updater.validateUpdate()
+	}
+
+	if validator, ok := body.(Validator); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
 // DoWithContext sends an API Request and returns back the response. The API response is checked  to see if it was
 // a successful call. A successful call is then checked to see if we need to unmarshal since some resources
-// have their own implements of unmarshal.
-func (c *Client) DoWithContext(ctx context.Context, r *http.Request, data interface{}) (*http.Response, error) {
-	rreq, err := retryablehttp.FromRequest(r)
+// have their own implements of unmarshal. Unsuccessful calls return an *APIError.
+func (c *Client) DoWithContext(ctx context.Context, r *http.Request, data interface{}) (*Response, error) {
+	ctx = withRequestStats(ctx)
+	start := time.Now()
+
+	res, err := c.doer.Do(r.WithContext(ctx))
 	if err != nil {
+		if res != nil {
+			return newResponse(res, nil, requestStatsFrom(ctx), time.Since(start)), err
+		}
 		return nil, err
 	}
 
-	rreq = rreq.WithContext(ctx)
+	if res == nil {
+		return nil, errors.New("middleware returned neither a response nor an error")
+	}
 
-	res, err := c.client.Do(rreq)
+	if data != nil && res.Body != nil {
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, err
+		}
+		res.Body = io.NopCloser(bytes.NewReader(body))
 
-	if c.onRequestCompleted != nil {
-		c.onRequestCompleted(r, res)
+		if err := json.Unmarshal(body, data); err != nil {
+			return nil, err
+		}
+	}
+
+	return newResponse(res, metaOf(data), requestStatsFrom(ctx), time.Since(start)), nil
+}
+
+// countAttempts is the retryablehttp.RequestLogHook of the client, it runs before every attempt
+func countAttempts(_ retryablehttp.Logger, req *http.Request, retry int) {
+	if stats := requestStatsFrom(req.Context()); stats != nil {
+		stats.attempts = retry + 1
 	}
+}
 
+// requestCompleted is the innermost middleware, calling the callback set with WithRequestCompleted
+func (c *Client) requestCompleted(next Doer) Doer {
+	return DoerFunc(func(req *http.Request) (*http.Response, error) {
+		res, err := next.Do(req)
+		if c.onRequestCompleted != nil {
+			c.onRequestCompleted(req, res)
+		}
+		return res, err
+	})
+}
+
+// send is the innermost Doer: it performs the request with retries and buffers the response body,
+// unless the request was made by StreamList and succeeded
+func (c *Client) send(r *http.Request) (*http.Response, error) {
+	rreq, err := retryablehttp.FromRequest(r.WithContext(context.WithValue(r.Context(), requestMethodKey{}, r.Method)))
 	if err != nil {
 		return nil, err
 	}
 
+	res, err := c.client.Do(rreq)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.Method == "" {
+			apiErr.Method = r.Method
+			apiErr.Path = r.URL.Path
+		}
+		return nil, err
+	}
+
+	if streaming(r.Context()) && res.StatusCode >= http.StatusOK && res.StatusCode <= http.StatusNoContent {
+		return res, nil
+	}
+
 	defer func() {
 		if rerr := res.Body.Close(); err == nil {
 			err = rerr
@@ -200,18 +396,15 @@ func (c *Client) DoWithContext(ctx context.Context, r *http.Request, data interf
 	res.Body = io.NopCloser(bytes.NewBuffer(body))
 
 	if res.StatusCode >= http.StatusOK && res.StatusCode <= http.StatusNoContent {
-		if data != nil {
-			if err := json.Unmarshal(body, data); err != nil {
-				return nil, err
-			}
-		}
 		return res, nil
 	}
 
-	return res, errors.New(string(body))
+	return res, newAPIError(res, body)
 }
 
 // SetBaseURL Overrides the default BaseUrl
+//
+// Deprecated: SetBaseURL is not safe to call while requests are in flight. Instead, use WithBaseURL.
 func (c *Client) SetBaseURL(baseURL string) error {
 	updatedURL, err := url.Parse(baseURL)
 
@@ -225,39 +418,98 @@ func (c *Client) SetBaseURL(baseURL string) error {
 
 // SetRateLimit Overrides the default rateLimit. For performance, exponential
 // backoff is used with the minimum wait being 2/3rds the time provided.
+// This only affects retries, outgoing requests are throttled with WithRequestRate.
+//
+// Deprecated: SetRateLimit is not safe to call while requests are in flight. Instead, use WithRateLimit.
 func (c *Client) SetRateLimit(t time.Duration) {
 	c.client.RetryWaitMin = t / 3 * 2
 	c.client.RetryWaitMax = t
 }
 
 // SetUserAgent Overrides the default UserAgent
+//
+// Deprecated: SetUserAgent is not safe to call while requests are in flight. Instead, use WithUserAgent.
 func (c *Client) SetUserAgent(ua string) {
 	c.UserAgent = ua
 }
 
 // OnRequestCompleted sets the API request completion callback
+//
+// Deprecated: OnRequestCompleted is not safe to call while requests are in flight. Instead, use WithRequestCompleted.
 func (c *Client) OnRequestCompleted(rc RequestCompletionCallback) {
 	c.onRequestCompleted = rc
 }
 
+// SetAPIKey sets the API key sent as a bearer token with every request. This removes the need
+// to build the http.Client passed to NewClient with an oauth2 transport.
+//
+// Deprecated: SetAPIKey is not safe to call while requests are in flight. Instead, use WithAPIKey.
+func (c *Client) SetAPIKey(apiKey string) {
+	if apiKey == "" {
+		c.credentials = nil
+		return
+	}
+	c.credentials = &Credentials{APIKey: apiKey, Source: CredentialSourceExplicit}
+}
+
+// SetCredentials resolves the API key from the given provider, for example DefaultCredentials
+//
+// Deprecated: SetCredentials is not safe to call while requests are in flight. Instead, use WithCredentials.
+func (c *Client) SetCredentials(provider CredentialsProvider) error {
+	creds, err := provider.Retrieve()
+	if err != nil {
+		return err
+	}
+	c.credentials = creds
+	return nil
+}
+
+// RateLimiter returns the limiter throttling the client, or nil if rate limiting is disabled
+func (c *Client) RateLimiter() *RateLimiter {
+	return c.rateLimiter
+}
+
+// CredentialSource returns where the API key used by the client was resolved from.
+// CredentialSourceNone is returned when authentication is left to the http.Client.
+func (c *Client) CredentialSource() CredentialSource {
+	if c.credentials == nil {
+		return CredentialSourceNone
+	}
+	return c.credentials.Source
+}
+
 // SetRetryLimit overrides the default RetryLimit
+//
+// Deprecated: SetRetryLimit is not safe to call while requests are in flight. Instead, use WithRetryLimit.
 func (c *Client) SetRetryLimit(n int) {
 	c.client.RetryMax = n
 }
 
 func (c *Client) vultrErrorHandler(resp *http.Response, err error, numTries int) (*http.Response, error) {
+	if errors.Is(err, errReconciled) {
+		if resp != nil {
+			resp.Body.Close() //nolint:errcheck
+		}
+		return nil, err
+	}
+
 	if resp == nil {
 		if err != nil {
-			return nil, fmt.Errorf("gave up after %d attempts, last error : %s", numTries, err.Error())
+			return nil, fmt.Errorf("gave up after %d attempts, last error : %w", numTries, err)
 		}
 		return nil, fmt.Errorf("gave up after %d attempts, last error unavailable (resp == nil)", numTries)
 	}
 
+	defer resp.Body.Close() //nolint:errcheck
+
 	buf, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("gave up after %d attempts, last error unavailable (error reading response body: %v)", numTries, err)
 	}
-	return nil, fmt.Errorf("gave up after %d attempts, last error: %#v", numTries, strings.TrimSpace(string(buf)))
+
+	apiErr := newAPIError(resp, buf)
+	apiErr.Retries = numTries - 1
+	return nil, apiErr
 }
 
 // BoolToBoolPtr helper function that returns a pointer from your bool value
@@ -0,0 +1,3212 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package govultrfake
+
+import (
+	"context"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Services holds a fake for every service of govultr.Client
+type Services struct {
+	Account         *AccountService
+	Application     *ApplicationService
+	Backup          *BackupService
+	BareMetalServer *BareMetalServerService
+	Billing         *BillingService
+	BlockStorage    *BlockStorageService
+	Database        *DatabaseService
+	Domain          *DomainService
+	DomainRecord    *DomainRecordService
+	FirewallGroup   *FirewallGroupService
+	FirewallRule    *FireWallRuleService
+	Instance        *InstanceService
+	ISO             *ISOService
+	Kubernetes      *KubernetesService
+	LoadBalancer    *LoadBalancerService
+	Network         *NetworkService
+	ObjectStorage   *ObjectStorageService
+	OS              *OSService
+	Plan            *PlanService
+	Region          *RegionService
+	ReservedIP      *ReservedIPService
+	Snapshot        *SnapshotService
+	SSHKey          *SSHKeyService
+	StartupScript   *StartupScriptService
+	User            *UserService
+	VPC             *VPCService
+}
+
+// New returns a fake for every service, none of them configured
+func New() *Services {
+	return &Services{
+		Account:         &AccountService{},
+		Application:     &ApplicationService{},
+		Backup:          &BackupService{},
+		BareMetalServer: &BareMetalServerService{},
+		Billing:         &BillingService{},
+		BlockStorage:    &BlockStorageService{},
+		Database:        &DatabaseService{},
+		Domain:          &DomainService{},
+		DomainRecord:    &DomainRecordService{},
+		FirewallGroup:   &FirewallGroupService{},
+		FirewallRule:    &FireWallRuleService{},
+		Instance:        &InstanceService{},
+		ISO:             &ISOService{},
+		Kubernetes:      &KubernetesService{},
+		LoadBalancer:    &LoadBalancerService{},
+		Network:         &NetworkService{},
+		ObjectStorage:   &ObjectStorageService{},
+		OS:              &OSService{},
+		Plan:            &PlanService{},
+		Region:          &RegionService{},
+		ReservedIP:      &ReservedIPService{},
+		Snapshot:        &SnapshotService{},
+		SSHKey:          &SSHKeyService{},
+		StartupScript:   &StartupScriptService{},
+		User:            &UserService{},
+		VPC:             &VPCService{},
+	}
+}
+
+// Client returns a client whose services are the fakes of s
+func (s *Services) Client() *govultr.Client {
+	c := govultr.NewClient(nil)
+	c.Account = s.Account
+	c.Application = s.Application
+	c.Backup = s.Backup
+	c.BareMetalServer = s.BareMetalServer
+	c.Billing = s.Billing
+	c.BlockStorage = s.BlockStorage
+	c.Database = s.Database
+	c.Domain = s.Domain
+	c.DomainRecord = s.DomainRecord
+	c.FirewallGroup = s.FirewallGroup
+	c.FirewallRule = s.FirewallRule
+	c.Instance = s.Instance
+	c.ISO = s.ISO
+	c.Kubernetes = s.Kubernetes
+	c.LoadBalancer = s.LoadBalancer
+	c.Network = s.Network
+	c.ObjectStorage = s.ObjectStorage
+	c.OS = s.OS
+	c.Plan = s.Plan
+	c.Region = s.Region
+	c.ReservedIP = s.ReservedIP
+	c.Snapshot = s.Snapshot
+	c.SSHKey = s.SSHKey
+	c.StartupScript = s.StartupScript
+	c.User = s.User
+	c.VPC = s.VPC
+	return c
+}
+
+var _ govultr.AccountService = (*AccountService)(nil)
+
+// AccountService is a configurable fake of govultr.AccountService
+type AccountService struct {
+	GetFunc func(ctx context.Context) (*govultr.Account, *govultr.Response, error)
+
+	Recorder
+}
+
+// Get records the call and calls GetFunc
+func (f *AccountService) Get(ctx context.Context) (*govultr.Account, *govultr.Response, error) {
+	f.record("Get")
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx)
+	}
+	var (
+		r0 *govultr.Account
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("AccountService.Get")
+}
+
+var _ govultr.ApplicationService = (*ApplicationService)(nil)
+
+// ApplicationService is a configurable fake of govultr.ApplicationService
+type ApplicationService struct {
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Application, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// List records the call and calls ListFunc
+func (f *ApplicationService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Application, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.Application
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("ApplicationService.List")
+}
+
+var _ govultr.BackupService = (*BackupService)(nil)
+
+// BackupService is a configurable fake of govultr.BackupService
+type BackupService struct {
+	GetFunc  func(ctx context.Context, backupID string) (*govultr.Backup, *govultr.Response, error)
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Backup, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// Get records the call and calls GetFunc
+func (f *BackupService) Get(ctx context.Context, backupID string) (*govultr.Backup, *govultr.Response, error) {
+	f.record("Get", backupID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, backupID)
+	}
+	var (
+		r0 *govultr.Backup
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("BackupService.Get")
+}
+
+// List records the call and calls ListFunc
+func (f *BackupService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Backup, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.Backup
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("BackupService.List")
+}
+
+var _ govultr.BareMetalServerService = (*BareMetalServerService)(nil)
+
+// BareMetalServerService is a configurable fake of govultr.BareMetalServerService
+type BareMetalServerService struct {
+	CreateFunc       func(ctx context.Context, bmCreate *govultr.BareMetalCreate) (*govultr.BareMetalServer, *govultr.Response, error)
+	GetFunc          func(ctx context.Context, serverID string) (*govultr.BareMetalServer, *govultr.Response, error)
+	UpdateFunc       func(ctx context.Context, serverID string, bmReq *govultr.BareMetalUpdate) (*govultr.BareMetalServer, *govultr.Response, error)
+	DeleteFunc       func(ctx context.Context, serverID string) (*govultr.Response, error)
+	ListFunc         func(ctx context.Context, options *govultr.ListOptions) ([]govultr.BareMetalServer, *govultr.Meta, *govultr.Response, error)
+	GetBandwidthFunc func(ctx context.Context, serverID string) (*govultr.Bandwidth, *govultr.Response, error)
+	GetUserDataFunc  func(ctx context.Context, serverID string) (*govultr.UserData, *govultr.Response, error)
+	GetVNCUrlFunc    func(ctx context.Context, serverID string) (*govultr.VNCUrl, *govultr.Response, error)
+	ListIPv4sFunc    func(ctx context.Context, serverID string, options *govultr.ListOptions) ([]govultr.IPv4, *govultr.Meta, *govultr.Response, error)
+	ListIPv6sFunc    func(ctx context.Context, serverID string, options *govultr.ListOptions) ([]govultr.IPv6, *govultr.Meta, *govultr.Response, error)
+	HaltFunc         func(ctx context.Context, serverID string) (*govultr.Response, error)
+	RebootFunc       func(ctx context.Context, serverID string) (*govultr.Response, error)
+	StartFunc        func(ctx context.Context, serverID string) (*govultr.Response, error)
+	ReinstallFunc    func(ctx context.Context, serverID string) (*govultr.BareMetalServer, *govultr.Response, error)
+	MassStartFunc    func(ctx context.Context, serverList []string) (*govultr.Response, error)
+	MassHaltFunc     func(ctx context.Context, serverList []string) (*govultr.Response, error)
+	MassRebootFunc   func(ctx context.Context, serverList []string) (*govultr.Response, error)
+	GetUpgradesFunc  func(ctx context.Context, serverID string) (*govultr.Upgrades, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *BareMetalServerService) Create(ctx context.Context, bmCreate *govultr.BareMetalCreate) (*govultr.BareMetalServer, *govultr.Response, error) {
+	f.record("Create", bmCreate)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, bmCreate)
+	}
+	var (
+		r0 *govultr.BareMetalServer
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("BareMetalServerService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *BareMetalServerService) Get(ctx context.Context, serverID string) (*govultr.BareMetalServer, *govultr.Response, error) {
+	f.record("Get", serverID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, serverID)
+	}
+	var (
+		r0 *govultr.BareMetalServer
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("BareMetalServerService.Get")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *BareMetalServerService) Update(ctx context.Context, serverID string, bmReq *govultr.BareMetalUpdate) (*govultr.BareMetalServer, *govultr.Response, error) {
+	f.record("Update", serverID, bmReq)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, serverID, bmReq)
+	}
+	var (
+		r0 *govultr.BareMetalServer
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("BareMetalServerService.Update")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *BareMetalServerService) Delete(ctx context.Context, serverID string) (*govultr.Response, error) {
+	f.record("Delete", serverID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, serverID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("BareMetalServerService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *BareMetalServerService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.BareMetalServer, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.BareMetalServer
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("BareMetalServerService.List")
+}
+
+// GetBandwidth records the call and calls GetBandwidthFunc
+func (f *BareMetalServerService) GetBandwidth(ctx context.Context, serverID string) (*govultr.Bandwidth, *govultr.Response, error) {
+	f.record("GetBandwidth", serverID)
+	if f.GetBandwidthFunc != nil {
+		return f.GetBandwidthFunc(ctx, serverID)
+	}
+	var (
+		r0 *govultr.Bandwidth
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("BareMetalServerService.GetBandwidth")
+}
+
+// GetUserData records the call and calls GetUserDataFunc
+func (f *BareMetalServerService) GetUserData(ctx context.Context, serverID string) (*govultr.UserData, *govultr.Response, error) {
+	f.record("GetUserData", serverID)
+	if f.GetUserDataFunc != nil {
+		return f.GetUserDataFunc(ctx, serverID)
+	}
+	var (
+		r0 *govultr.UserData
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("BareMetalServerService.GetUserData")
+}
+
+// GetVNCUrl records the call and calls GetVNCUrlFunc
+func (f *BareMetalServerService) GetVNCUrl(ctx context.Context, serverID string) (*govultr.VNCUrl, *govultr.Response, error) {
+	f.record("GetVNCUrl", serverID)
+	if f.GetVNCUrlFunc != nil {
+		return f.GetVNCUrlFunc(ctx, serverID)
+	}
+	var (
+		r0 *govultr.VNCUrl
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("BareMetalServerService.GetVNCUrl")
+}
+
+// ListIPv4s records the call and calls ListIPv4sFunc
+func (f *BareMetalServerService) ListIPv4s(ctx context.Context, serverID string, options *govultr.ListOptions) ([]govultr.IPv4, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListIPv4s", serverID, options)
+	if f.ListIPv4sFunc != nil {
+		return f.ListIPv4sFunc(ctx, serverID, options)
+	}
+	var (
+		r0 []govultr.IPv4
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("BareMetalServerService.ListIPv4s")
+}
+
+// ListIPv6s records the call and calls ListIPv6sFunc
+func (f *BareMetalServerService) ListIPv6s(ctx context.Context, serverID string, options *govultr.ListOptions) ([]govultr.IPv6, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListIPv6s", serverID, options)
+	if f.ListIPv6sFunc != nil {
+		return f.ListIPv6sFunc(ctx, serverID, options)
+	}
+	var (
+		r0 []govultr.IPv6
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("BareMetalServerService.ListIPv6s")
+}
+
+// Halt records the call and calls HaltFunc
+func (f *BareMetalServerService) Halt(ctx context.Context, serverID string) (*govultr.Response, error) {
+	f.record("Halt", serverID)
+	if f.HaltFunc != nil {
+		return f.HaltFunc(ctx, serverID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("BareMetalServerService.Halt")
+}
+
+// Reboot records the call and calls RebootFunc
+func (f *BareMetalServerService) Reboot(ctx context.Context, serverID string) (*govultr.Response, error) {
+	f.record("Reboot", serverID)
+	if f.RebootFunc != nil {
+		return f.RebootFunc(ctx, serverID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("BareMetalServerService.Reboot")
+}
+
+// Start records the call and calls StartFunc
+func (f *BareMetalServerService) Start(ctx context.Context, serverID string) (*govultr.Response, error) {
+	f.record("Start", serverID)
+	if f.StartFunc != nil {
+		return f.StartFunc(ctx, serverID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("BareMetalServerService.Start")
+}
+
+// Reinstall records the call and calls ReinstallFunc
+func (f *BareMetalServerService) Reinstall(ctx context.Context, serverID string) (*govultr.BareMetalServer, *govultr.Response, error) {
+	f.record("Reinstall", serverID)
+	if f.ReinstallFunc != nil {
+		return f.ReinstallFunc(ctx, serverID)
+	}
+	var (
+		r0 *govultr.BareMetalServer
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("BareMetalServerService.Reinstall")
+}
+
+// MassStart records the call and calls MassStartFunc
+func (f *BareMetalServerService) MassStart(ctx context.Context, serverList []string) (*govultr.Response, error) {
+	f.record("MassStart", serverList)
+	if f.MassStartFunc != nil {
+		return f.MassStartFunc(ctx, serverList)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("BareMetalServerService.MassStart")
+}
+
+// MassHalt records the call and calls MassHaltFunc
+func (f *BareMetalServerService) MassHalt(ctx context.Context, serverList []string) (*govultr.Response, error) {
+	f.record("MassHalt", serverList)
+	if f.MassHaltFunc != nil {
+		return f.MassHaltFunc(ctx, serverList)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("BareMetalServerService.MassHalt")
+}
+
+// MassReboot records the call and calls MassRebootFunc
+func (f *BareMetalServerService) MassReboot(ctx context.Context, serverList []string) (*govultr.Response, error) {
+	f.record("MassReboot", serverList)
+	if f.MassRebootFunc != nil {
+		return f.MassRebootFunc(ctx, serverList)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("BareMetalServerService.MassReboot")
+}
+
+// GetUpgrades records the call and calls GetUpgradesFunc
+func (f *BareMetalServerService) GetUpgrades(ctx context.Context, serverID string) (*govultr.Upgrades, *govultr.Response, error) {
+	f.record("GetUpgrades", serverID)
+	if f.GetUpgradesFunc != nil {
+		return f.GetUpgradesFunc(ctx, serverID)
+	}
+	var (
+		r0 *govultr.Upgrades
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("BareMetalServerService.GetUpgrades")
+}
+
+var _ govultr.BillingService = (*BillingService)(nil)
+
+// BillingService is a configurable fake of govultr.BillingService
+type BillingService struct {
+	ListHistoryFunc      func(ctx context.Context, options *govultr.ListOptions) ([]govultr.History, *govultr.Meta, *govultr.Response, error)
+	ListInvoicesFunc     func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Invoice, *govultr.Meta, *govultr.Response, error)
+	GetInvoiceFunc       func(ctx context.Context, invoiceID string) (*govultr.Invoice, *govultr.Response, error)
+	ListInvoiceItemsFunc func(ctx context.Context, invoiceID int, options *govultr.ListOptions) ([]govultr.InvoiceItem, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// ListHistory records the call and calls ListHistoryFunc
+func (f *BillingService) ListHistory(ctx context.Context, options *govultr.ListOptions) ([]govultr.History, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListHistory", options)
+	if f.ListHistoryFunc != nil {
+		return f.ListHistoryFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.History
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("BillingService.ListHistory")
+}
+
+// ListInvoices records the call and calls ListInvoicesFunc
+func (f *BillingService) ListInvoices(ctx context.Context, options *govultr.ListOptions) ([]govultr.Invoice, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListInvoices", options)
+	if f.ListInvoicesFunc != nil {
+		return f.ListInvoicesFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.Invoice
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("BillingService.ListInvoices")
+}
+
+// GetInvoice records the call and calls GetInvoiceFunc
+func (f *BillingService) GetInvoice(ctx context.Context, invoiceID string) (*govultr.Invoice, *govultr.Response, error) {
+	f.record("GetInvoice", invoiceID)
+	if f.GetInvoiceFunc != nil {
+		return f.GetInvoiceFunc(ctx, invoiceID)
+	}
+	var (
+		r0 *govultr.Invoice
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("BillingService.GetInvoice")
+}
+
+// ListInvoiceItems records the call and calls ListInvoiceItemsFunc
+func (f *BillingService) ListInvoiceItems(ctx context.Context, invoiceID int, options *govultr.ListOptions) ([]govultr.InvoiceItem, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListInvoiceItems", invoiceID, options)
+	if f.ListInvoiceItemsFunc != nil {
+		return f.ListInvoiceItemsFunc(ctx, invoiceID, options)
+	}
+	var (
+		r0 []govultr.InvoiceItem
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("BillingService.ListInvoiceItems")
+}
+
+var _ govultr.BlockStorageService = (*BlockStorageService)(nil)
+
+// BlockStorageService is a configurable fake of govultr.BlockStorageService
+type BlockStorageService struct {
+	CreateFunc func(ctx context.Context, blockReq *govultr.BlockStorageCreate) (*govultr.BlockStorage, *govultr.Response, error)
+	GetFunc    func(ctx context.Context, blockID string) (*govultr.BlockStorage, *govultr.Response, error)
+	UpdateFunc func(ctx context.Context, blockID string, blockReq *govultr.BlockStorageUpdate) (*govultr.Response, error)
+	DeleteFunc func(ctx context.Context, blockID string) (*govultr.Response, error)
+	ListFunc   func(ctx context.Context, options *govultr.ListOptions) ([]govultr.BlockStorage, *govultr.Meta, *govultr.Response, error)
+	AttachFunc func(ctx context.Context, blockID string, attach *govultr.BlockStorageAttach) (*govultr.Response, error)
+	DetachFunc func(ctx context.Context, blockID string, detach *govultr.BlockStorageDetach) (*govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *BlockStorageService) Create(ctx context.Context, blockReq *govultr.BlockStorageCreate) (*govultr.BlockStorage, *govultr.Response, error) {
+	f.record("Create", blockReq)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, blockReq)
+	}
+	var (
+		r0 *govultr.BlockStorage
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("BlockStorageService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *BlockStorageService) Get(ctx context.Context, blockID string) (*govultr.BlockStorage, *govultr.Response, error) {
+	f.record("Get", blockID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, blockID)
+	}
+	var (
+		r0 *govultr.BlockStorage
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("BlockStorageService.Get")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *BlockStorageService) Update(ctx context.Context, blockID string, blockReq *govultr.BlockStorageUpdate) (*govultr.Response, error) {
+	f.record("Update", blockID, blockReq)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, blockID, blockReq)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("BlockStorageService.Update")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *BlockStorageService) Delete(ctx context.Context, blockID string) (*govultr.Response, error) {
+	f.record("Delete", blockID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, blockID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("BlockStorageService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *BlockStorageService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.BlockStorage, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.BlockStorage
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("BlockStorageService.List")
+}
+
+// Attach records the call and calls AttachFunc
+func (f *BlockStorageService) Attach(ctx context.Context, blockID string, attach *govultr.BlockStorageAttach) (*govultr.Response, error) {
+	f.record("Attach", blockID, attach)
+	if f.AttachFunc != nil {
+		return f.AttachFunc(ctx, blockID, attach)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("BlockStorageService.Attach")
+}
+
+// Detach records the call and calls DetachFunc
+func (f *BlockStorageService) Detach(ctx context.Context, blockID string, detach *govultr.BlockStorageDetach) (*govultr.Response, error) {
+	f.record("Detach", blockID, detach)
+	if f.DetachFunc != nil {
+		return f.DetachFunc(ctx, blockID, detach)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("BlockStorageService.Detach")
+}
+
+var _ govultr.DatabaseService = (*DatabaseService)(nil)
+
+// DatabaseService is a configurable fake of govultr.DatabaseService
+type DatabaseService struct {
+	ListPlansFunc              func(ctx context.Context, options *govultr.DBPlanListOptions) ([]govultr.DatabasePlan, *govultr.Meta, *govultr.Response, error)
+	ListFunc                   func(ctx context.Context, options *govultr.DBListOptions) ([]govultr.Database, *govultr.Meta, *govultr.Response, error)
+	CreateFunc                 func(ctx context.Context, databaseReq *govultr.DatabaseCreateReq) (*govultr.Database, *govultr.Response, error)
+	GetFunc                    func(ctx context.Context, databaseID string) (*govultr.Database, *govultr.Response, error)
+	UpdateFunc                 func(ctx context.Context, databaseID string, databaseReq *govultr.DatabaseUpdateReq) (*govultr.Database, *govultr.Response, error)
+	DeleteFunc                 func(ctx context.Context, databaseID string) (*govultr.Response, error)
+	ListUsersFunc              func(ctx context.Context, databaseID string, options *govultr.ListOptions) ([]govultr.DatabaseUser, *govultr.Meta, *govultr.Response, error)
+	CreateUserFunc             func(ctx context.Context, databaseID string, databaseUserReq *govultr.DatabaseUserCreateReq) (*govultr.DatabaseUser, *govultr.Response, error)
+	GetUserFunc                func(ctx context.Context, databaseID string, username string) (*govultr.DatabaseUser, *govultr.Response, error)
+	UpdateUserFunc             func(ctx context.Context, databaseID string, username string, databaseUserReq *govultr.DatabaseUserUpdateReq) (*govultr.DatabaseUser, *govultr.Response, error)
+	DeleteUserFunc             func(ctx context.Context, databaseID string, username string) (*govultr.Response, error)
+	ListDBsFunc                func(ctx context.Context, databaseID string, options *govultr.ListOptions) ([]govultr.DatabaseDB, *govultr.Meta, *govultr.Response, error)
+	CreateDBFunc               func(ctx context.Context, databaseID string, databaseDBReq *govultr.DatabaseDBCreateReq) (*govultr.DatabaseDB, *govultr.Response, error)
+	GetDBFunc                  func(ctx context.Context, databaseID string, dbname string) (*govultr.DatabaseDB, *govultr.Response, error)
+	DeleteDBFunc               func(ctx context.Context, databaseID string, dbname string) (*govultr.Response, error)
+	ListMaintenanceUpdatesFunc func(ctx context.Context, databaseID string) ([]string, *govultr.Response, error)
+	StartMaintenanceFunc       func(ctx context.Context, databaseID string) (string, *govultr.Response, error)
+	ListServiceAlertsFunc      func(ctx context.Context, databaseID string, databaseAlertsReq *govultr.DatabaseListAlertsReq) ([]govultr.DatabaseAlert, *govultr.Response, error)
+	GetMigrationStatusFunc     func(ctx context.Context, databaseID string) (*govultr.DatabaseMigration, *govultr.Response, error)
+	StartMigrationFunc         func(ctx context.Context, databaseID string, databaseMigrationReq *govultr.DatabaseMigrationStartReq) (*govultr.DatabaseMigration, *govultr.Response, error)
+	DetachMigrationFunc        func(ctx context.Context, databaseID string) (*govultr.Response, error)
+	AddReadOnlyReplicaFunc     func(ctx context.Context, databaseID string, databaseReplicaReq *govultr.DatabaseAddReplicaReq) (*govultr.Database, *govultr.Response, error)
+	GetBackupInformationFunc   func(ctx context.Context, databaseID string) (*govultr.DatabaseBackups, *govultr.Response, error)
+	RestoreFromBackupFunc      func(ctx context.Context, databaseID string, databaseRestoreReq *govultr.DatabaseBackupRestoreReq) (*govultr.Database, *govultr.Response, error)
+	ForkFunc                   func(ctx context.Context, databaseID string, databaseForkReq *govultr.DatabaseForkReq) (*govultr.Database, *govultr.Response, error)
+	ListConnectionPoolsFunc    func(ctx context.Context, databaseID string, options *govultr.ListOptions) (*govultr.DatabaseConnections, []govultr.DatabaseConnectionPool, *govultr.Meta, *govultr.Response, error)
+	CreateConnectionPoolFunc   func(ctx context.Context, databaseID string, databaseConnectionPoolReq *govultr.DatabaseConnectionPoolCreateReq) (*govultr.DatabaseConnectionPool, *govultr.Response, error)
+	GetConnectionPoolFunc      func(ctx context.Context, databaseID string, poolName string) (*govultr.DatabaseConnectionPool, *govultr.Response, error)
+	UpdateConnectionPoolFunc   func(ctx context.Context, databaseID string, poolName string, databaseConnectionPoolReq *govultr.DatabaseConnectionPoolUpdateReq) (*govultr.DatabaseConnectionPool, *govultr.Response, error)
+	DeleteConnectionPoolFunc   func(ctx context.Context, databaseID string, poolName string) (*govultr.Response, error)
+	ListAdvancedOptionsFunc    func(ctx context.Context, databaseID string) (*govultr.DatabaseAdvancedOptions, []govultr.AvailableOption, *govultr.Response, error)
+	UpdateAdvancedOptionsFunc  func(ctx context.Context, databaseID string, databaseAdvancedOptionsReq *govultr.DatabaseAdvancedOptions) (*govultr.DatabaseAdvancedOptions, []govultr.AvailableOption, *govultr.Response, error)
+	ListAvailableVersionsFunc  func(ctx context.Context, databaseID string) ([]string, *govultr.Response, error)
+	StartVersionUpgradeFunc    func(ctx context.Context, databaseID string, databaseVersionUpgradeReq *govultr.DatabaseVersionUpgradeReq) (string, *govultr.Response, error)
+
+	Recorder
+}
+
+// ListPlans records the call and calls ListPlansFunc
+func (f *DatabaseService) ListPlans(ctx context.Context, options *govultr.DBPlanListOptions) ([]govultr.DatabasePlan, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListPlans", options)
+	if f.ListPlansFunc != nil {
+		return f.ListPlansFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.DatabasePlan
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("DatabaseService.ListPlans")
+}
+
+// List records the call and calls ListFunc
+func (f *DatabaseService) List(ctx context.Context, options *govultr.DBListOptions) ([]govultr.Database, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.Database
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("DatabaseService.List")
+}
+
+// Create records the call and calls CreateFunc
+func (f *DatabaseService) Create(ctx context.Context, databaseReq *govultr.DatabaseCreateReq) (*govultr.Database, *govultr.Response, error) {
+	f.record("Create", databaseReq)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, databaseReq)
+	}
+	var (
+		r0 *govultr.Database
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *DatabaseService) Get(ctx context.Context, databaseID string) (*govultr.Database, *govultr.Response, error) {
+	f.record("Get", databaseID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, databaseID)
+	}
+	var (
+		r0 *govultr.Database
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.Get")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *DatabaseService) Update(ctx context.Context, databaseID string, databaseReq *govultr.DatabaseUpdateReq) (*govultr.Database, *govultr.Response, error) {
+	f.record("Update", databaseID, databaseReq)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, databaseID, databaseReq)
+	}
+	var (
+		r0 *govultr.Database
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.Update")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *DatabaseService) Delete(ctx context.Context, databaseID string) (*govultr.Response, error) {
+	f.record("Delete", databaseID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, databaseID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("DatabaseService.Delete")
+}
+
+// ListUsers records the call and calls ListUsersFunc
+func (f *DatabaseService) ListUsers(ctx context.Context, databaseID string, options *govultr.ListOptions) ([]govultr.DatabaseUser, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListUsers", databaseID, options)
+	if f.ListUsersFunc != nil {
+		return f.ListUsersFunc(ctx, databaseID, options)
+	}
+	var (
+		r0 []govultr.DatabaseUser
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("DatabaseService.ListUsers")
+}
+
+// CreateUser records the call and calls CreateUserFunc
+func (f *DatabaseService) CreateUser(ctx context.Context, databaseID string, databaseUserReq *govultr.DatabaseUserCreateReq) (*govultr.DatabaseUser, *govultr.Response, error) {
+	f.record("CreateUser", databaseID, databaseUserReq)
+	if f.CreateUserFunc != nil {
+		return f.CreateUserFunc(ctx, databaseID, databaseUserReq)
+	}
+	var (
+		r0 *govultr.DatabaseUser
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.CreateUser")
+}
+
+// GetUser records the call and calls GetUserFunc
+func (f *DatabaseService) GetUser(ctx context.Context, databaseID string, username string) (*govultr.DatabaseUser, *govultr.Response, error) {
+	f.record("GetUser", databaseID, username)
+	if f.GetUserFunc != nil {
+		return f.GetUserFunc(ctx, databaseID, username)
+	}
+	var (
+		r0 *govultr.DatabaseUser
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.GetUser")
+}
+
+// UpdateUser records the call and calls UpdateUserFunc
+func (f *DatabaseService) UpdateUser(ctx context.Context, databaseID string, username string, databaseUserReq *govultr.DatabaseUserUpdateReq) (*govultr.DatabaseUser, *govultr.Response, error) {
+	f.record("UpdateUser", databaseID, username, databaseUserReq)
+	if f.UpdateUserFunc != nil {
+		return f.UpdateUserFunc(ctx, databaseID, username, databaseUserReq)
+	}
+	var (
+		r0 *govultr.DatabaseUser
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.UpdateUser")
+}
+
+// DeleteUser records the call and calls DeleteUserFunc
+func (f *DatabaseService) DeleteUser(ctx context.Context, databaseID string, username string) (*govultr.Response, error) {
+	f.record("DeleteUser", databaseID, username)
+	if f.DeleteUserFunc != nil {
+		return f.DeleteUserFunc(ctx, databaseID, username)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("DatabaseService.DeleteUser")
+}
+
+// ListDBs records the call and calls ListDBsFunc
+func (f *DatabaseService) ListDBs(ctx context.Context, databaseID string, options *govultr.ListOptions) ([]govultr.DatabaseDB, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListDBs", databaseID, options)
+	if f.ListDBsFunc != nil {
+		return f.ListDBsFunc(ctx, databaseID, options)
+	}
+	var (
+		r0 []govultr.DatabaseDB
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("DatabaseService.ListDBs")
+}
+
+// CreateDB records the call and calls CreateDBFunc
+func (f *DatabaseService) CreateDB(ctx context.Context, databaseID string, databaseDBReq *govultr.DatabaseDBCreateReq) (*govultr.DatabaseDB, *govultr.Response, error) {
+	f.record("CreateDB", databaseID, databaseDBReq)
+	if f.CreateDBFunc != nil {
+		return f.CreateDBFunc(ctx, databaseID, databaseDBReq)
+	}
+	var (
+		r0 *govultr.DatabaseDB
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.CreateDB")
+}
+
+// GetDB records the call and calls GetDBFunc
+func (f *DatabaseService) GetDB(ctx context.Context, databaseID string, dbname string) (*govultr.DatabaseDB, *govultr.Response, error) {
+	f.record("GetDB", databaseID, dbname)
+	if f.GetDBFunc != nil {
+		return f.GetDBFunc(ctx, databaseID, dbname)
+	}
+	var (
+		r0 *govultr.DatabaseDB
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.GetDB")
+}
+
+// DeleteDB records the call and calls DeleteDBFunc
+func (f *DatabaseService) DeleteDB(ctx context.Context, databaseID string, dbname string) (*govultr.Response, error) {
+	f.record("DeleteDB", databaseID, dbname)
+	if f.DeleteDBFunc != nil {
+		return f.DeleteDBFunc(ctx, databaseID, dbname)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("DatabaseService.DeleteDB")
+}
+
+// ListMaintenanceUpdates records the call and calls ListMaintenanceUpdatesFunc
+func (f *DatabaseService) ListMaintenanceUpdates(ctx context.Context, databaseID string) ([]string, *govultr.Response, error) {
+	f.record("ListMaintenanceUpdates", databaseID)
+	if f.ListMaintenanceUpdatesFunc != nil {
+		return f.ListMaintenanceUpdatesFunc(ctx, databaseID)
+	}
+	var (
+		r0 []string
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.ListMaintenanceUpdates")
+}
+
+// StartMaintenance records the call and calls StartMaintenanceFunc
+func (f *DatabaseService) StartMaintenance(ctx context.Context, databaseID string) (string, *govultr.Response, error) {
+	f.record("StartMaintenance", databaseID)
+	if f.StartMaintenanceFunc != nil {
+		return f.StartMaintenanceFunc(ctx, databaseID)
+	}
+	var (
+		r0 string
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.StartMaintenance")
+}
+
+// ListServiceAlerts records the call and calls ListServiceAlertsFunc
+func (f *DatabaseService) ListServiceAlerts(ctx context.Context, databaseID string, databaseAlertsReq *govultr.DatabaseListAlertsReq) ([]govultr.DatabaseAlert, *govultr.Response, error) {
+	f.record("ListServiceAlerts", databaseID, databaseAlertsReq)
+	if f.ListServiceAlertsFunc != nil {
+		return f.ListServiceAlertsFunc(ctx, databaseID, databaseAlertsReq)
+	}
+	var (
+		r0 []govultr.DatabaseAlert
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.ListServiceAlerts")
+}
+
+// GetMigrationStatus records the call and calls GetMigrationStatusFunc
+func (f *DatabaseService) GetMigrationStatus(ctx context.Context, databaseID string) (*govultr.DatabaseMigration, *govultr.Response, error) {
+	f.record("GetMigrationStatus", databaseID)
+	if f.GetMigrationStatusFunc != nil {
+		return f.GetMigrationStatusFunc(ctx, databaseID)
+	}
+	var (
+		r0 *govultr.DatabaseMigration
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.GetMigrationStatus")
+}
+
+// StartMigration records the call and calls StartMigrationFunc
+func (f *DatabaseService) StartMigration(ctx context.Context, databaseID string, databaseMigrationReq *govultr.DatabaseMigrationStartReq) (*govultr.DatabaseMigration, *govultr.Response, error) {
+	f.record("StartMigration", databaseID, databaseMigrationReq)
+	if f.StartMigrationFunc != nil {
+		return f.StartMigrationFunc(ctx, databaseID, databaseMigrationReq)
+	}
+	var (
+		r0 *govultr.DatabaseMigration
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.StartMigration")
+}
+
+// DetachMigration records the call and calls DetachMigrationFunc
+func (f *DatabaseService) DetachMigration(ctx context.Context, databaseID string) (*govultr.Response, error) {
+	f.record("DetachMigration", databaseID)
+	if f.DetachMigrationFunc != nil {
+		return f.DetachMigrationFunc(ctx, databaseID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("DatabaseService.DetachMigration")
+}
+
+// AddReadOnlyReplica records the call and calls AddReadOnlyReplicaFunc
+func (f *DatabaseService) AddReadOnlyReplica(ctx context.Context, databaseID string, databaseReplicaReq *govultr.DatabaseAddReplicaReq) (*govultr.Database, *govultr.Response, error) {
+	f.record("AddReadOnlyReplica", databaseID, databaseReplicaReq)
+	if f.AddReadOnlyReplicaFunc != nil {
+		return f.AddReadOnlyReplicaFunc(ctx, databaseID, databaseReplicaReq)
+	}
+	var (
+		r0 *govultr.Database
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.AddReadOnlyReplica")
+}
+
+// GetBackupInformation records the call and calls GetBackupInformationFunc
+func (f *DatabaseService) GetBackupInformation(ctx context.Context, databaseID string) (*govultr.DatabaseBackups, *govultr.Response, error) {
+	f.record("GetBackupInformation", databaseID)
+	if f.GetBackupInformationFunc != nil {
+		return f.GetBackupInformationFunc(ctx, databaseID)
+	}
+	var (
+		r0 *govultr.DatabaseBackups
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.GetBackupInformation")
+}
+
+// RestoreFromBackup records the call and calls RestoreFromBackupFunc
+func (f *DatabaseService) RestoreFromBackup(ctx context.Context, databaseID string, databaseRestoreReq *govultr.DatabaseBackupRestoreReq) (*govultr.Database, *govultr.Response, error) {
+	f.record("RestoreFromBackup", databaseID, databaseRestoreReq)
+	if f.RestoreFromBackupFunc != nil {
+		return f.RestoreFromBackupFunc(ctx, databaseID, databaseRestoreReq)
+	}
+	var (
+		r0 *govultr.Database
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.RestoreFromBackup")
+}
+
+// Fork records the call and calls ForkFunc
+func (f *DatabaseService) Fork(ctx context.Context, databaseID string, databaseForkReq *govultr.DatabaseForkReq) (*govultr.Database, *govultr.Response, error) {
+	f.record("Fork", databaseID, databaseForkReq)
+	if f.ForkFunc != nil {
+		return f.ForkFunc(ctx, databaseID, databaseForkReq)
+	}
+	var (
+		r0 *govultr.Database
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.Fork")
+}
+
+// ListConnectionPools records the call and calls ListConnectionPoolsFunc
+func (f *DatabaseService) ListConnectionPools(ctx context.Context, databaseID string, options *govultr.ListOptions) (*govultr.DatabaseConnections, []govultr.DatabaseConnectionPool, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListConnectionPools", databaseID, options)
+	if f.ListConnectionPoolsFunc != nil {
+		return f.ListConnectionPoolsFunc(ctx, databaseID, options)
+	}
+	var (
+		r0 *govultr.DatabaseConnections
+		r1 []govultr.DatabaseConnectionPool
+		r2 *govultr.Meta
+		r3 *govultr.Response
+	)
+	return r0, r1, r2, r3, notImplemented("DatabaseService.ListConnectionPools")
+}
+
+// CreateConnectionPool records the call and calls CreateConnectionPoolFunc
+func (f *DatabaseService) CreateConnectionPool(ctx context.Context, databaseID string, databaseConnectionPoolReq *govultr.DatabaseConnectionPoolCreateReq) (*govultr.DatabaseConnectionPool, *govultr.Response, error) {
+	f.record("CreateConnectionPool", databaseID, databaseConnectionPoolReq)
+	if f.CreateConnectionPoolFunc != nil {
+		return f.CreateConnectionPoolFunc(ctx, databaseID, databaseConnectionPoolReq)
+	}
+	var (
+		r0 *govultr.DatabaseConnectionPool
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.CreateConnectionPool")
+}
+
+// GetConnectionPool records the call and calls GetConnectionPoolFunc
+func (f *DatabaseService) GetConnectionPool(ctx context.Context, databaseID string, poolName string) (*govultr.DatabaseConnectionPool, *govultr.Response, error) {
+	f.record("GetConnectionPool", databaseID, poolName)
+	if f.GetConnectionPoolFunc != nil {
+		return f.GetConnectionPoolFunc(ctx, databaseID, poolName)
+	}
+	var (
+		r0 *govultr.DatabaseConnectionPool
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.GetConnectionPool")
+}
+
+// UpdateConnectionPool records the call and calls UpdateConnectionPoolFunc
+func (f *DatabaseService) UpdateConnectionPool(ctx context.Context, databaseID string, poolName string, databaseConnectionPoolReq *govultr.DatabaseConnectionPoolUpdateReq) (*govultr.DatabaseConnectionPool, *govultr.Response, error) {
+	f.record("UpdateConnectionPool", databaseID, poolName, databaseConnectionPoolReq)
+	if f.UpdateConnectionPoolFunc != nil {
+		return f.UpdateConnectionPoolFunc(ctx, databaseID, poolName, databaseConnectionPoolReq)
+	}
+	var (
+		r0 *govultr.DatabaseConnectionPool
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.UpdateConnectionPool")
+}
+
+// DeleteConnectionPool records the call and calls DeleteConnectionPoolFunc
+func (f *DatabaseService) DeleteConnectionPool(ctx context.Context, databaseID string, poolName string) (*govultr.Response, error) {
+	f.record("DeleteConnectionPool", databaseID, poolName)
+	if f.DeleteConnectionPoolFunc != nil {
+		return f.DeleteConnectionPoolFunc(ctx, databaseID, poolName)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("DatabaseService.DeleteConnectionPool")
+}
+
+// ListAdvancedOptions records the call and calls ListAdvancedOptionsFunc
+func (f *DatabaseService) ListAdvancedOptions(ctx context.Context, databaseID string) (*govultr.DatabaseAdvancedOptions, []govultr.AvailableOption, *govultr.Response, error) {
+	f.record("ListAdvancedOptions", databaseID)
+	if f.ListAdvancedOptionsFunc != nil {
+		return f.ListAdvancedOptionsFunc(ctx, databaseID)
+	}
+	var (
+		r0 *govultr.DatabaseAdvancedOptions
+		r1 []govultr.AvailableOption
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("DatabaseService.ListAdvancedOptions")
+}
+
+// UpdateAdvancedOptions records the call and calls UpdateAdvancedOptionsFunc
+func (f *DatabaseService) UpdateAdvancedOptions(ctx context.Context, databaseID string, databaseAdvancedOptionsReq *govultr.DatabaseAdvancedOptions) (*govultr.DatabaseAdvancedOptions, []govultr.AvailableOption, *govultr.Response, error) {
+	f.record("UpdateAdvancedOptions", databaseID, databaseAdvancedOptionsReq)
+	if f.UpdateAdvancedOptionsFunc != nil {
+		return f.UpdateAdvancedOptionsFunc(ctx, databaseID, databaseAdvancedOptionsReq)
+	}
+	var (
+		r0 *govultr.DatabaseAdvancedOptions
+		r1 []govultr.AvailableOption
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("DatabaseService.UpdateAdvancedOptions")
+}
+
+// ListAvailableVersions records the call and calls ListAvailableVersionsFunc
+func (f *DatabaseService) ListAvailableVersions(ctx context.Context, databaseID string) ([]string, *govultr.Response, error) {
+	f.record("ListAvailableVersions", databaseID)
+	if f.ListAvailableVersionsFunc != nil {
+		return f.ListAvailableVersionsFunc(ctx, databaseID)
+	}
+	var (
+		r0 []string
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.ListAvailableVersions")
+}
+
+// StartVersionUpgrade records the call and calls StartVersionUpgradeFunc
+func (f *DatabaseService) StartVersionUpgrade(ctx context.Context, databaseID string, databaseVersionUpgradeReq *govultr.DatabaseVersionUpgradeReq) (string, *govultr.Response, error) {
+	f.record("StartVersionUpgrade", databaseID, databaseVersionUpgradeReq)
+	if f.StartVersionUpgradeFunc != nil {
+		return f.StartVersionUpgradeFunc(ctx, databaseID, databaseVersionUpgradeReq)
+	}
+	var (
+		r0 string
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DatabaseService.StartVersionUpgrade")
+}
+
+var _ govultr.DomainService = (*DomainService)(nil)
+
+// DomainService is a configurable fake of govultr.DomainService
+type DomainService struct {
+	CreateFunc    func(ctx context.Context, domainReq *govultr.DomainReq) (*govultr.Domain, *govultr.Response, error)
+	GetFunc       func(ctx context.Context, domain string) (*govultr.Domain, *govultr.Response, error)
+	UpdateFunc    func(ctx context.Context, domain string, dnsSec string) (*govultr.Response, error)
+	DeleteFunc    func(ctx context.Context, domain string) (*govultr.Response, error)
+	ListFunc      func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Domain, *govultr.Meta, *govultr.Response, error)
+	GetSoaFunc    func(ctx context.Context, domain string) (*govultr.Soa, *govultr.Response, error)
+	UpdateSoaFunc func(ctx context.Context, domain string, soaReq *govultr.Soa) (*govultr.Response, error)
+	GetDNSSecFunc func(ctx context.Context, domain string) ([]string, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *DomainService) Create(ctx context.Context, domainReq *govultr.DomainReq) (*govultr.Domain, *govultr.Response, error) {
+	f.record("Create", domainReq)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, domainReq)
+	}
+	var (
+		r0 *govultr.Domain
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DomainService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *DomainService) Get(ctx context.Context, domain string) (*govultr.Domain, *govultr.Response, error) {
+	f.record("Get", domain)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, domain)
+	}
+	var (
+		r0 *govultr.Domain
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DomainService.Get")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *DomainService) Update(ctx context.Context, domain string, dnsSec string) (*govultr.Response, error) {
+	f.record("Update", domain, dnsSec)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, domain, dnsSec)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("DomainService.Update")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *DomainService) Delete(ctx context.Context, domain string) (*govultr.Response, error) {
+	f.record("Delete", domain)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, domain)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("DomainService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *DomainService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Domain, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.Domain
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("DomainService.List")
+}
+
+// GetSoa records the call and calls GetSoaFunc
+func (f *DomainService) GetSoa(ctx context.Context, domain string) (*govultr.Soa, *govultr.Response, error) {
+	f.record("GetSoa", domain)
+	if f.GetSoaFunc != nil {
+		return f.GetSoaFunc(ctx, domain)
+	}
+	var (
+		r0 *govultr.Soa
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DomainService.GetSoa")
+}
+
+// UpdateSoa records the call and calls UpdateSoaFunc
+func (f *DomainService) UpdateSoa(ctx context.Context, domain string, soaReq *govultr.Soa) (*govultr.Response, error) {
+	f.record("UpdateSoa", domain, soaReq)
+	if f.UpdateSoaFunc != nil {
+		return f.UpdateSoaFunc(ctx, domain, soaReq)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("DomainService.UpdateSoa")
+}
+
+// GetDNSSec records the call and calls GetDNSSecFunc
+func (f *DomainService) GetDNSSec(ctx context.Context, domain string) ([]string, *govultr.Response, error) {
+	f.record("GetDNSSec", domain)
+	if f.GetDNSSecFunc != nil {
+		return f.GetDNSSecFunc(ctx, domain)
+	}
+	var (
+		r0 []string
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DomainService.GetDNSSec")
+}
+
+var _ govultr.DomainRecordService = (*DomainRecordService)(nil)
+
+// DomainRecordService is a configurable fake of govultr.DomainRecordService
+type DomainRecordService struct {
+	CreateFunc func(ctx context.Context, domain string, domainRecordReq *govultr.DomainRecordReq) (*govultr.DomainRecord, *govultr.Response, error)
+	GetFunc    func(ctx context.Context, domain string, recordID string) (*govultr.DomainRecord, *govultr.Response, error)
+	UpdateFunc func(ctx context.Context, domain string, recordID string, domainRecordReq *govultr.DomainRecordReq) (*govultr.Response, error)
+	DeleteFunc func(ctx context.Context, domain string, recordID string) (*govultr.Response, error)
+	ListFunc   func(ctx context.Context, domain string, options *govultr.ListOptions) ([]govultr.DomainRecord, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *DomainRecordService) Create(ctx context.Context, domain string, domainRecordReq *govultr.DomainRecordReq) (*govultr.DomainRecord, *govultr.Response, error) {
+	f.record("Create", domain, domainRecordReq)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, domain, domainRecordReq)
+	}
+	var (
+		r0 *govultr.DomainRecord
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DomainRecordService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *DomainRecordService) Get(ctx context.Context, domain string, recordID string) (*govultr.DomainRecord, *govultr.Response, error) {
+	f.record("Get", domain, recordID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, domain, recordID)
+	}
+	var (
+		r0 *govultr.DomainRecord
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("DomainRecordService.Get")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *DomainRecordService) Update(ctx context.Context, domain string, recordID string, domainRecordReq *govultr.DomainRecordReq) (*govultr.Response, error) {
+	f.record("Update", domain, recordID, domainRecordReq)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, domain, recordID, domainRecordReq)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("DomainRecordService.Update")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *DomainRecordService) Delete(ctx context.Context, domain string, recordID string) (*govultr.Response, error) {
+	f.record("Delete", domain, recordID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, domain, recordID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("DomainRecordService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *DomainRecordService) List(ctx context.Context, domain string, options *govultr.ListOptions) ([]govultr.DomainRecord, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", domain, options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, domain, options)
+	}
+	var (
+		r0 []govultr.DomainRecord
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("DomainRecordService.List")
+}
+
+var _ govultr.FirewallGroupService = (*FirewallGroupService)(nil)
+
+// FirewallGroupService is a configurable fake of govultr.FirewallGroupService
+type FirewallGroupService struct {
+	CreateFunc func(ctx context.Context, fwGroupReq *govultr.FirewallGroupReq) (*govultr.FirewallGroup, *govultr.Response, error)
+	GetFunc    func(ctx context.Context, groupID string) (*govultr.FirewallGroup, *govultr.Response, error)
+	UpdateFunc func(ctx context.Context, fwGroupID string, fwGroupReq *govultr.FirewallGroupReq) (*govultr.Response, error)
+	DeleteFunc func(ctx context.Context, fwGroupID string) (*govultr.Response, error)
+	ListFunc   func(ctx context.Context, options *govultr.ListOptions) ([]govultr.FirewallGroup, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *FirewallGroupService) Create(ctx context.Context, fwGroupReq *govultr.FirewallGroupReq) (*govultr.FirewallGroup, *govultr.Response, error) {
+	f.record("Create", fwGroupReq)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, fwGroupReq)
+	}
+	var (
+		r0 *govultr.FirewallGroup
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("FirewallGroupService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *FirewallGroupService) Get(ctx context.Context, groupID string) (*govultr.FirewallGroup, *govultr.Response, error) {
+	f.record("Get", groupID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, groupID)
+	}
+	var (
+		r0 *govultr.FirewallGroup
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("FirewallGroupService.Get")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *FirewallGroupService) Update(ctx context.Context, fwGroupID string, fwGroupReq *govultr.FirewallGroupReq) (*govultr.Response, error) {
+	f.record("Update", fwGroupID, fwGroupReq)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, fwGroupID, fwGroupReq)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("FirewallGroupService.Update")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *FirewallGroupService) Delete(ctx context.Context, fwGroupID string) (*govultr.Response, error) {
+	f.record("Delete", fwGroupID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, fwGroupID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("FirewallGroupService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *FirewallGroupService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.FirewallGroup, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.FirewallGroup
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("FirewallGroupService.List")
+}
+
+var _ govultr.FireWallRuleService = (*FireWallRuleService)(nil)
+
+// FireWallRuleService is a configurable fake of govultr.FireWallRuleService
+type FireWallRuleService struct {
+	CreateFunc func(ctx context.Context, fwGroupID string, fwRuleReq *govultr.FirewallRuleReq) (*govultr.FirewallRule, *govultr.Response, error)
+	GetFunc    func(ctx context.Context, fwGroupID string, fwRuleID int) (*govultr.FirewallRule, *govultr.Response, error)
+	DeleteFunc func(ctx context.Context, fwGroupID string, fwRuleID int) (*govultr.Response, error)
+	ListFunc   func(ctx context.Context, fwGroupID string, options *govultr.ListOptions) ([]govultr.FirewallRule, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *FireWallRuleService) Create(ctx context.Context, fwGroupID string, fwRuleReq *govultr.FirewallRuleReq) (*govultr.FirewallRule, *govultr.Response, error) {
+	f.record("Create", fwGroupID, fwRuleReq)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, fwGroupID, fwRuleReq)
+	}
+	var (
+		r0 *govultr.FirewallRule
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("FireWallRuleService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *FireWallRuleService) Get(ctx context.Context, fwGroupID string, fwRuleID int) (*govultr.FirewallRule, *govultr.Response, error) {
+	f.record("Get", fwGroupID, fwRuleID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, fwGroupID, fwRuleID)
+	}
+	var (
+		r0 *govultr.FirewallRule
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("FireWallRuleService.Get")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *FireWallRuleService) Delete(ctx context.Context, fwGroupID string, fwRuleID int) (*govultr.Response, error) {
+	f.record("Delete", fwGroupID, fwRuleID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, fwGroupID, fwRuleID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("FireWallRuleService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *FireWallRuleService) List(ctx context.Context, fwGroupID string, options *govultr.ListOptions) ([]govultr.FirewallRule, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", fwGroupID, options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, fwGroupID, options)
+	}
+	var (
+		r0 []govultr.FirewallRule
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("FireWallRuleService.List")
+}
+
+var _ govultr.InstanceService = (*InstanceService)(nil)
+
+// InstanceService is a configurable fake of govultr.InstanceService
+type InstanceService struct {
+	CreateFunc               func(ctx context.Context, instanceReq *govultr.InstanceCreateReq) (*govultr.Instance, *govultr.Response, error)
+	GetFunc                  func(ctx context.Context, instanceID string) (*govultr.Instance, *govultr.Response, error)
+	UpdateFunc               func(ctx context.Context, instanceID string, instanceReq *govultr.InstanceUpdateReq) (*govultr.Instance, *govultr.Response, error)
+	DeleteFunc               func(ctx context.Context, instanceID string) (*govultr.Response, error)
+	ListFunc                 func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Instance, *govultr.Meta, *govultr.Response, error)
+	StartFunc                func(ctx context.Context, instanceID string) (*govultr.Response, error)
+	HaltFunc                 func(ctx context.Context, instanceID string) (*govultr.Response, error)
+	RebootFunc               func(ctx context.Context, instanceID string) (*govultr.Response, error)
+	ReinstallFunc            func(ctx context.Context, instanceID string, reinstallReq *govultr.ReinstallReq) (*govultr.Instance, *govultr.Response, error)
+	MassStartFunc            func(ctx context.Context, instanceList []string) (*govultr.Response, error)
+	MassHaltFunc             func(ctx context.Context, instanceList []string) (*govultr.Response, error)
+	MassRebootFunc           func(ctx context.Context, instanceList []string) (*govultr.Response, error)
+	RestoreFunc              func(ctx context.Context, instanceID string, restoreReq *govultr.RestoreReq) (*govultr.Response, error)
+	GetBandwidthFunc         func(ctx context.Context, instanceID string) (*govultr.Bandwidth, *govultr.Response, error)
+	GetNeighborsFunc         func(ctx context.Context, instanceID string) (*govultr.Neighbors, *govultr.Response, error)
+	ListPrivateNetworksFunc  func(ctx context.Context, instanceID string, options *govultr.ListOptions) ([]govultr.PrivateNetwork, *govultr.Meta, *govultr.Response, error)
+	AttachPrivateNetworkFunc func(ctx context.Context, instanceID string, networkID string) (*govultr.Response, error)
+	DetachPrivateNetworkFunc func(ctx context.Context, instanceID string, networkID string) (*govultr.Response, error)
+	ListVPCInfoFunc          func(ctx context.Context, instanceID string, options *govultr.ListOptions) ([]govultr.VPCInfo, *govultr.Meta, *govultr.Response, error)
+	AttachVPCFunc            func(ctx context.Context, instanceID string, vpcID string) (*govultr.Response, error)
+	DetachVPCFunc            func(ctx context.Context, instanceID string, vpcID string) (*govultr.Response, error)
+	ISOStatusFunc            func(ctx context.Context, instanceID string) (*govultr.Iso, *govultr.Response, error)
+	AttachISOFunc            func(ctx context.Context, instanceID string, isoID string) (*govultr.Response, error)
+	DetachISOFunc            func(ctx context.Context, instanceID string) (*govultr.Response, error)
+	GetBackupScheduleFunc    func(ctx context.Context, instanceID string) (*govultr.BackupSchedule, *govultr.Response, error)
+	SetBackupScheduleFunc    func(ctx context.Context, instanceID string, backup *govultr.BackupScheduleReq) (*govultr.Response, error)
+	CreateIPv4Func           func(ctx context.Context, instanceID string, reboot *bool) (*govultr.IPv4, *govultr.Response, error)
+	ListIPv4Func             func(ctx context.Context, instanceID string, option *govultr.ListOptions) ([]govultr.IPv4, *govultr.Meta, *govultr.Response, error)
+	DeleteIPv4Func           func(ctx context.Context, instanceID string, ip string) (*govultr.Response, error)
+	ListIPv6Func             func(ctx context.Context, instanceID string, option *govultr.ListOptions) ([]govultr.IPv6, *govultr.Meta, *govultr.Response, error)
+	CreateReverseIPv6Func    func(ctx context.Context, instanceID string, reverseReq *govultr.ReverseIP) (*govultr.Response, error)
+	ListReverseIPv6Func      func(ctx context.Context, instanceID string) ([]govultr.ReverseIP, *govultr.Response, error)
+	DeleteReverseIPv6Func    func(ctx context.Context, instanceID string, ip string) (*govultr.Response, error)
+	CreateReverseIPv4Func    func(ctx context.Context, instanceID string, reverseReq *govultr.ReverseIP) (*govultr.Response, error)
+	DefaultReverseIPv4Func   func(ctx context.Context, instanceID string, ip string) (*govultr.Response, error)
+	GetUserDataFunc          func(ctx context.Context, instanceID string) (*govultr.UserData, *govultr.Response, error)
+	GetUpgradesFunc          func(ctx context.Context, instanceID string) (*govultr.Upgrades, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *InstanceService) Create(ctx context.Context, instanceReq *govultr.InstanceCreateReq) (*govultr.Instance, *govultr.Response, error) {
+	f.record("Create", instanceReq)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, instanceReq)
+	}
+	var (
+		r0 *govultr.Instance
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("InstanceService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *InstanceService) Get(ctx context.Context, instanceID string) (*govultr.Instance, *govultr.Response, error) {
+	f.record("Get", instanceID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, instanceID)
+	}
+	var (
+		r0 *govultr.Instance
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("InstanceService.Get")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *InstanceService) Update(ctx context.Context, instanceID string, instanceReq *govultr.InstanceUpdateReq) (*govultr.Instance, *govultr.Response, error) {
+	f.record("Update", instanceID, instanceReq)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, instanceID, instanceReq)
+	}
+	var (
+		r0 *govultr.Instance
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("InstanceService.Update")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *InstanceService) Delete(ctx context.Context, instanceID string) (*govultr.Response, error) {
+	f.record("Delete", instanceID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, instanceID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *InstanceService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Instance, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.Instance
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("InstanceService.List")
+}
+
+// Start records the call and calls StartFunc
+func (f *InstanceService) Start(ctx context.Context, instanceID string) (*govultr.Response, error) {
+	f.record("Start", instanceID)
+	if f.StartFunc != nil {
+		return f.StartFunc(ctx, instanceID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.Start")
+}
+
+// Halt records the call and calls HaltFunc
+func (f *InstanceService) Halt(ctx context.Context, instanceID string) (*govultr.Response, error) {
+	f.record("Halt", instanceID)
+	if f.HaltFunc != nil {
+		return f.HaltFunc(ctx, instanceID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.Halt")
+}
+
+// Reboot records the call and calls RebootFunc
+func (f *InstanceService) Reboot(ctx context.Context, instanceID string) (*govultr.Response, error) {
+	f.record("Reboot", instanceID)
+	if f.RebootFunc != nil {
+		return f.RebootFunc(ctx, instanceID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.Reboot")
+}
+
+// Reinstall records the call and calls ReinstallFunc
+func (f *InstanceService) Reinstall(ctx context.Context, instanceID string, reinstallReq *govultr.ReinstallReq) (*govultr.Instance, *govultr.Response, error) {
+	f.record("Reinstall", instanceID, reinstallReq)
+	if f.ReinstallFunc != nil {
+		return f.ReinstallFunc(ctx, instanceID, reinstallReq)
+	}
+	var (
+		r0 *govultr.Instance
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("InstanceService.Reinstall")
+}
+
+// MassStart records the call and calls MassStartFunc
+func (f *InstanceService) MassStart(ctx context.Context, instanceList []string) (*govultr.Response, error) {
+	f.record("MassStart", instanceList)
+	if f.MassStartFunc != nil {
+		return f.MassStartFunc(ctx, instanceList)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.MassStart")
+}
+
+// MassHalt records the call and calls MassHaltFunc
+func (f *InstanceService) MassHalt(ctx context.Context, instanceList []string) (*govultr.Response, error) {
+	f.record("MassHalt", instanceList)
+	if f.MassHaltFunc != nil {
+		return f.MassHaltFunc(ctx, instanceList)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.MassHalt")
+}
+
+// MassReboot records the call and calls MassRebootFunc
+func (f *InstanceService) MassReboot(ctx context.Context, instanceList []string) (*govultr.Response, error) {
+	f.record("MassReboot", instanceList)
+	if f.MassRebootFunc != nil {
+		return f.MassRebootFunc(ctx, instanceList)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.MassReboot")
+}
+
+// Restore records the call and calls RestoreFunc
+func (f *InstanceService) Restore(ctx context.Context, instanceID string, restoreReq *govultr.RestoreReq) (*govultr.Response, error) {
+	f.record("Restore", instanceID, restoreReq)
+	if f.RestoreFunc != nil {
+		return f.RestoreFunc(ctx, instanceID, restoreReq)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.Restore")
+}
+
+// GetBandwidth records the call and calls GetBandwidthFunc
+func (f *InstanceService) GetBandwidth(ctx context.Context, instanceID string) (*govultr.Bandwidth, *govultr.Response, error) {
+	f.record("GetBandwidth", instanceID)
+	if f.GetBandwidthFunc != nil {
+		return f.GetBandwidthFunc(ctx, instanceID)
+	}
+	var (
+		r0 *govultr.Bandwidth
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("InstanceService.GetBandwidth")
+}
+
+// GetNeighbors records the call and calls GetNeighborsFunc
+func (f *InstanceService) GetNeighbors(ctx context.Context, instanceID string) (*govultr.Neighbors, *govultr.Response, error) {
+	f.record("GetNeighbors", instanceID)
+	if f.GetNeighborsFunc != nil {
+		return f.GetNeighborsFunc(ctx, instanceID)
+	}
+	var (
+		r0 *govultr.Neighbors
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("InstanceService.GetNeighbors")
+}
+
+// ListPrivateNetworks records the call and calls ListPrivateNetworksFunc
+func (f *InstanceService) ListPrivateNetworks(ctx context.Context, instanceID string, options *govultr.ListOptions) ([]govultr.PrivateNetwork, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListPrivateNetworks", instanceID, options)
+	if f.ListPrivateNetworksFunc != nil {
+		return f.ListPrivateNetworksFunc(ctx, instanceID, options)
+	}
+	var (
+		r0 []govultr.PrivateNetwork
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("InstanceService.ListPrivateNetworks")
+}
+
+// AttachPrivateNetwork records the call and calls AttachPrivateNetworkFunc
+func (f *InstanceService) AttachPrivateNetwork(ctx context.Context, instanceID string, networkID string) (*govultr.Response, error) {
+	f.record("AttachPrivateNetwork", instanceID, networkID)
+	if f.AttachPrivateNetworkFunc != nil {
+		return f.AttachPrivateNetworkFunc(ctx, instanceID, networkID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.AttachPrivateNetwork")
+}
+
+// DetachPrivateNetwork records the call and calls DetachPrivateNetworkFunc
+func (f *InstanceService) DetachPrivateNetwork(ctx context.Context, instanceID string, networkID string) (*govultr.Response, error) {
+	f.record("DetachPrivateNetwork", instanceID, networkID)
+	if f.DetachPrivateNetworkFunc != nil {
+		return f.DetachPrivateNetworkFunc(ctx, instanceID, networkID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.DetachPrivateNetwork")
+}
+
+// ListVPCInfo records the call and calls ListVPCInfoFunc
+func (f *InstanceService) ListVPCInfo(ctx context.Context, instanceID string, options *govultr.ListOptions) ([]govultr.VPCInfo, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListVPCInfo", instanceID, options)
+	if f.ListVPCInfoFunc != nil {
+		return f.ListVPCInfoFunc(ctx, instanceID, options)
+	}
+	var (
+		r0 []govultr.VPCInfo
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("InstanceService.ListVPCInfo")
+}
+
+// AttachVPC records the call and calls AttachVPCFunc
+func (f *InstanceService) AttachVPC(ctx context.Context, instanceID string, vpcID string) (*govultr.Response, error) {
+	f.record("AttachVPC", instanceID, vpcID)
+	if f.AttachVPCFunc != nil {
+		return f.AttachVPCFunc(ctx, instanceID, vpcID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.AttachVPC")
+}
+
+// DetachVPC records the call and calls DetachVPCFunc
+func (f *InstanceService) DetachVPC(ctx context.Context, instanceID string, vpcID string) (*govultr.Response, error) {
+	f.record("DetachVPC", instanceID, vpcID)
+	if f.DetachVPCFunc != nil {
+		return f.DetachVPCFunc(ctx, instanceID, vpcID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.DetachVPC")
+}
+
+// ISOStatus records the call and calls ISOStatusFunc
+func (f *InstanceService) ISOStatus(ctx context.Context, instanceID string) (*govultr.Iso, *govultr.Response, error) {
+	f.record("ISOStatus", instanceID)
+	if f.ISOStatusFunc != nil {
+		return f.ISOStatusFunc(ctx, instanceID)
+	}
+	var (
+		r0 *govultr.Iso
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("InstanceService.ISOStatus")
+}
+
+// AttachISO records the call and calls AttachISOFunc
+func (f *InstanceService) AttachISO(ctx context.Context, instanceID string, isoID string) (*govultr.Response, error) {
+	f.record("AttachISO", instanceID, isoID)
+	if f.AttachISOFunc != nil {
+		return f.AttachISOFunc(ctx, instanceID, isoID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.AttachISO")
+}
+
+// DetachISO records the call and calls DetachISOFunc
+func (f *InstanceService) DetachISO(ctx context.Context, instanceID string) (*govultr.Response, error) {
+	f.record("DetachISO", instanceID)
+	if f.DetachISOFunc != nil {
+		return f.DetachISOFunc(ctx, instanceID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.DetachISO")
+}
+
+// GetBackupSchedule records the call and calls GetBackupScheduleFunc
+func (f *InstanceService) GetBackupSchedule(ctx context.Context, instanceID string) (*govultr.BackupSchedule, *govultr.Response, error) {
+	f.record("GetBackupSchedule", instanceID)
+	if f.GetBackupScheduleFunc != nil {
+		return f.GetBackupScheduleFunc(ctx, instanceID)
+	}
+	var (
+		r0 *govultr.BackupSchedule
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("InstanceService.GetBackupSchedule")
+}
+
+// SetBackupSchedule records the call and calls SetBackupScheduleFunc
+func (f *InstanceService) SetBackupSchedule(ctx context.Context, instanceID string, backup *govultr.BackupScheduleReq) (*govultr.Response, error) {
+	f.record("SetBackupSchedule", instanceID, backup)
+	if f.SetBackupScheduleFunc != nil {
+		return f.SetBackupScheduleFunc(ctx, instanceID, backup)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.SetBackupSchedule")
+}
+
+// CreateIPv4 records the call and calls CreateIPv4Func
+func (f *InstanceService) CreateIPv4(ctx context.Context, instanceID string, reboot *bool) (*govultr.IPv4, *govultr.Response, error) {
+	f.record("CreateIPv4", instanceID, reboot)
+	if f.CreateIPv4Func != nil {
+		return f.CreateIPv4Func(ctx, instanceID, reboot)
+	}
+	var (
+		r0 *govultr.IPv4
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("InstanceService.CreateIPv4")
+}
+
+// ListIPv4 records the call and calls ListIPv4Func
+func (f *InstanceService) ListIPv4(ctx context.Context, instanceID string, option *govultr.ListOptions) ([]govultr.IPv4, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListIPv4", instanceID, option)
+	if f.ListIPv4Func != nil {
+		return f.ListIPv4Func(ctx, instanceID, option)
+	}
+	var (
+		r0 []govultr.IPv4
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("InstanceService.ListIPv4")
+}
+
+// DeleteIPv4 records the call and calls DeleteIPv4Func
+func (f *InstanceService) DeleteIPv4(ctx context.Context, instanceID string, ip string) (*govultr.Response, error) {
+	f.record("DeleteIPv4", instanceID, ip)
+	if f.DeleteIPv4Func != nil {
+		return f.DeleteIPv4Func(ctx, instanceID, ip)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.DeleteIPv4")
+}
+
+// ListIPv6 records the call and calls ListIPv6Func
+func (f *InstanceService) ListIPv6(ctx context.Context, instanceID string, option *govultr.ListOptions) ([]govultr.IPv6, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListIPv6", instanceID, option)
+	if f.ListIPv6Func != nil {
+		return f.ListIPv6Func(ctx, instanceID, option)
+	}
+	var (
+		r0 []govultr.IPv6
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("InstanceService.ListIPv6")
+}
+
+// CreateReverseIPv6 records the call and calls CreateReverseIPv6Func
+func (f *InstanceService) CreateReverseIPv6(ctx context.Context, instanceID string, reverseReq *govultr.ReverseIP) (*govultr.Response, error) {
+	f.record("CreateReverseIPv6", instanceID, reverseReq)
+	if f.CreateReverseIPv6Func != nil {
+		return f.CreateReverseIPv6Func(ctx, instanceID, reverseReq)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.CreateReverseIPv6")
+}
+
+// ListReverseIPv6 records the call and calls ListReverseIPv6Func
+func (f *InstanceService) ListReverseIPv6(ctx context.Context, instanceID string) ([]govultr.ReverseIP, *govultr.Response, error) {
+	f.record("ListReverseIPv6", instanceID)
+	if f.ListReverseIPv6Func != nil {
+		return f.ListReverseIPv6Func(ctx, instanceID)
+	}
+	var (
+		r0 []govultr.ReverseIP
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("InstanceService.ListReverseIPv6")
+}
+
+// DeleteReverseIPv6 records the call and calls DeleteReverseIPv6Func
+func (f *InstanceService) DeleteReverseIPv6(ctx context.Context, instanceID string, ip string) (*govultr.Response, error) {
+	f.record("DeleteReverseIPv6", instanceID, ip)
+	if f.DeleteReverseIPv6Func != nil {
+		return f.DeleteReverseIPv6Func(ctx, instanceID, ip)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.DeleteReverseIPv6")
+}
+
+// CreateReverseIPv4 records the call and calls CreateReverseIPv4Func
+func (f *InstanceService) CreateReverseIPv4(ctx context.Context, instanceID string, reverseReq *govultr.ReverseIP) (*govultr.Response, error) {
+	f.record("CreateReverseIPv4", instanceID, reverseReq)
+	if f.CreateReverseIPv4Func != nil {
+		return f.CreateReverseIPv4Func(ctx, instanceID, reverseReq)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.CreateReverseIPv4")
+}
+
+// DefaultReverseIPv4 records the call and calls DefaultReverseIPv4Func
+func (f *InstanceService) DefaultReverseIPv4(ctx context.Context, instanceID string, ip string) (*govultr.Response, error) {
+	f.record("DefaultReverseIPv4", instanceID, ip)
+	if f.DefaultReverseIPv4Func != nil {
+		return f.DefaultReverseIPv4Func(ctx, instanceID, ip)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("InstanceService.DefaultReverseIPv4")
+}
+
+// GetUserData records the call and calls GetUserDataFunc
+func (f *InstanceService) GetUserData(ctx context.Context, instanceID string) (*govultr.UserData, *govultr.Response, error) {
+	f.record("GetUserData", instanceID)
+	if f.GetUserDataFunc != nil {
+		return f.GetUserDataFunc(ctx, instanceID)
+	}
+	var (
+		r0 *govultr.UserData
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("InstanceService.GetUserData")
+}
+
+// GetUpgrades records the call and calls GetUpgradesFunc
+func (f *InstanceService) GetUpgrades(ctx context.Context, instanceID string) (*govultr.Upgrades, *govultr.Response, error) {
+	f.record("GetUpgrades", instanceID)
+	if f.GetUpgradesFunc != nil {
+		return f.GetUpgradesFunc(ctx, instanceID)
+	}
+	var (
+		r0 *govultr.Upgrades
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("InstanceService.GetUpgrades")
+}
+
+var _ govultr.ISOService = (*ISOService)(nil)
+
+// ISOService is a configurable fake of govultr.ISOService
+type ISOService struct {
+	CreateFunc     func(ctx context.Context, isoReq *govultr.ISOReq) (*govultr.ISO, *govultr.Response, error)
+	GetFunc        func(ctx context.Context, isoID string) (*govultr.ISO, *govultr.Response, error)
+	DeleteFunc     func(ctx context.Context, isoID string) (*govultr.Response, error)
+	ListFunc       func(ctx context.Context, options *govultr.ListOptions) ([]govultr.ISO, *govultr.Meta, *govultr.Response, error)
+	ListPublicFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.PublicISO, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *ISOService) Create(ctx context.Context, isoReq *govultr.ISOReq) (*govultr.ISO, *govultr.Response, error) {
+	f.record("Create", isoReq)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, isoReq)
+	}
+	var (
+		r0 *govultr.ISO
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("ISOService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *ISOService) Get(ctx context.Context, isoID string) (*govultr.ISO, *govultr.Response, error) {
+	f.record("Get", isoID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, isoID)
+	}
+	var (
+		r0 *govultr.ISO
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("ISOService.Get")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *ISOService) Delete(ctx context.Context, isoID string) (*govultr.Response, error) {
+	f.record("Delete", isoID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, isoID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("ISOService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *ISOService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.ISO, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.ISO
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("ISOService.List")
+}
+
+// ListPublic records the call and calls ListPublicFunc
+func (f *ISOService) ListPublic(ctx context.Context, options *govultr.ListOptions) ([]govultr.PublicISO, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListPublic", options)
+	if f.ListPublicFunc != nil {
+		return f.ListPublicFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.PublicISO
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("ISOService.ListPublic")
+}
+
+var _ govultr.KubernetesService = (*KubernetesService)(nil)
+
+// KubernetesService is a configurable fake of govultr.KubernetesService
+type KubernetesService struct {
+	CreateClusterFunc              func(ctx context.Context, createReq *govultr.ClusterReq) (*govultr.Cluster, *govultr.Response, error)
+	GetClusterFunc                 func(ctx context.Context, id string) (*govultr.Cluster, *govultr.Response, error)
+	ListClustersFunc               func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Cluster, *govultr.Meta, *govultr.Response, error)
+	UpdateClusterFunc              func(ctx context.Context, vkeID string, updateReq *govultr.ClusterReqUpdate) (*govultr.Response, error)
+	DeleteClusterFunc              func(ctx context.Context, id string) (*govultr.Response, error)
+	DeleteClusterWithResourcesFunc func(ctx context.Context, id string) (*govultr.Response, error)
+	CreateNodePoolFunc             func(ctx context.Context, vkeID string, nodePoolReq *govultr.NodePoolReq) (*govultr.NodePool, *govultr.Response, error)
+	ListNodePoolsFunc              func(ctx context.Context, vkeID string, options *govultr.ListOptions) ([]govultr.NodePool, *govultr.Meta, *govultr.Response, error)
+	GetNodePoolFunc                func(ctx context.Context, vkeID string, nodePoolID string) (*govultr.NodePool, *govultr.Response, error)
+	UpdateNodePoolFunc             func(ctx context.Context, vkeID string, nodePoolID string, updateReq *govultr.NodePoolReqUpdate) (*govultr.NodePool, *govultr.Response, error)
+	DeleteNodePoolFunc             func(ctx context.Context, vkeID string, nodePoolID string) (*govultr.Response, error)
+	DeleteNodePoolInstanceFunc     func(ctx context.Context, vkeID string, nodePoolID string, nodeID string) (*govultr.Response, error)
+	RecycleNodePoolInstanceFunc    func(ctx context.Context, vkeID string, nodePoolID string, nodeID string) (*govultr.Response, error)
+	GetKubeConfigFunc              func(ctx context.Context, vkeID string) (*govultr.KubeConfig, *govultr.Response, error)
+	GetVersionsFunc                func(ctx context.Context) (*govultr.Versions, *govultr.Response, error)
+	GetUpgradesFunc                func(ctx context.Context, vkeID string) ([]string, *govultr.Response, error)
+	UpgradeFunc                    func(ctx context.Context, vkeID string, body *govultr.ClusterUpgradeReq) (*govultr.Response, error)
+
+	Recorder
+}
+
+// CreateCluster records the call and calls CreateClusterFunc
+func (f *KubernetesService) CreateCluster(ctx context.Context, createReq *govultr.ClusterReq) (*govultr.Cluster, *govultr.Response, error) {
+	f.record("CreateCluster", createReq)
+	if f.CreateClusterFunc != nil {
+		return f.CreateClusterFunc(ctx, createReq)
+	}
+	var (
+		r0 *govultr.Cluster
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("KubernetesService.CreateCluster")
+}
+
+// GetCluster records the call and calls GetClusterFunc
+func (f *KubernetesService) GetCluster(ctx context.Context, id string) (*govultr.Cluster, *govultr.Response, error) {
+	f.record("GetCluster", id)
+	if f.GetClusterFunc != nil {
+		return f.GetClusterFunc(ctx, id)
+	}
+	var (
+		r0 *govultr.Cluster
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("KubernetesService.GetCluster")
+}
+
+// ListClusters records the call and calls ListClustersFunc
+func (f *KubernetesService) ListClusters(ctx context.Context, options *govultr.ListOptions) ([]govultr.Cluster, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListClusters", options)
+	if f.ListClustersFunc != nil {
+		return f.ListClustersFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.Cluster
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("KubernetesService.ListClusters")
+}
+
+// UpdateCluster records the call and calls UpdateClusterFunc
+func (f *KubernetesService) UpdateCluster(ctx context.Context, vkeID string, updateReq *govultr.ClusterReqUpdate) (*govultr.Response, error) {
+	f.record("UpdateCluster", vkeID, updateReq)
+	if f.UpdateClusterFunc != nil {
+		return f.UpdateClusterFunc(ctx, vkeID, updateReq)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("KubernetesService.UpdateCluster")
+}
+
+// DeleteCluster records the call and calls DeleteClusterFunc
+func (f *KubernetesService) DeleteCluster(ctx context.Context, id string) (*govultr.Response, error) {
+	f.record("DeleteCluster", id)
+	if f.DeleteClusterFunc != nil {
+		return f.DeleteClusterFunc(ctx, id)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("KubernetesService.DeleteCluster")
+}
+
+// DeleteClusterWithResources records the call and calls DeleteClusterWithResourcesFunc
+func (f *KubernetesService) DeleteClusterWithResources(ctx context.Context, id string) (*govultr.Response, error) {
+	f.record("DeleteClusterWithResources", id)
+	if f.DeleteClusterWithResourcesFunc != nil {
+		return f.DeleteClusterWithResourcesFunc(ctx, id)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("KubernetesService.DeleteClusterWithResources")
+}
+
+// CreateNodePool records the call and calls CreateNodePoolFunc
+func (f *KubernetesService) CreateNodePool(ctx context.Context, vkeID string, nodePoolReq *govultr.NodePoolReq) (*govultr.NodePool, *govultr.Response, error) {
+	f.record("CreateNodePool", vkeID, nodePoolReq)
+	if f.CreateNodePoolFunc != nil {
+		return f.CreateNodePoolFunc(ctx, vkeID, nodePoolReq)
+	}
+	var (
+		r0 *govultr.NodePool
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("KubernetesService.CreateNodePool")
+}
+
+// ListNodePools records the call and calls ListNodePoolsFunc
+func (f *KubernetesService) ListNodePools(ctx context.Context, vkeID string, options *govultr.ListOptions) ([]govultr.NodePool, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListNodePools", vkeID, options)
+	if f.ListNodePoolsFunc != nil {
+		return f.ListNodePoolsFunc(ctx, vkeID, options)
+	}
+	var (
+		r0 []govultr.NodePool
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("KubernetesService.ListNodePools")
+}
+
+// GetNodePool records the call and calls GetNodePoolFunc
+func (f *KubernetesService) GetNodePool(ctx context.Context, vkeID string, nodePoolID string) (*govultr.NodePool, *govultr.Response, error) {
+	f.record("GetNodePool", vkeID, nodePoolID)
+	if f.GetNodePoolFunc != nil {
+		return f.GetNodePoolFunc(ctx, vkeID, nodePoolID)
+	}
+	var (
+		r0 *govultr.NodePool
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("KubernetesService.GetNodePool")
+}
+
+// UpdateNodePool records the call and calls UpdateNodePoolFunc
+func (f *KubernetesService) UpdateNodePool(ctx context.Context, vkeID string, nodePoolID string, updateReq *govultr.NodePoolReqUpdate) (*govultr.NodePool, *govultr.Response, error) {
+	f.record("UpdateNodePool", vkeID, nodePoolID, updateReq)
+	if f.UpdateNodePoolFunc != nil {
+		return f.UpdateNodePoolFunc(ctx, vkeID, nodePoolID, updateReq)
+	}
+	var (
+		r0 *govultr.NodePool
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("KubernetesService.UpdateNodePool")
+}
+
+// DeleteNodePool records the call and calls DeleteNodePoolFunc
+func (f *KubernetesService) DeleteNodePool(ctx context.Context, vkeID string, nodePoolID string) (*govultr.Response, error) {
+	f.record("DeleteNodePool", vkeID, nodePoolID)
+	if f.DeleteNodePoolFunc != nil {
+		return f.DeleteNodePoolFunc(ctx, vkeID, nodePoolID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("KubernetesService.DeleteNodePool")
+}
+
+// DeleteNodePoolInstance records the call and calls DeleteNodePoolInstanceFunc
+func (f *KubernetesService) DeleteNodePoolInstance(ctx context.Context, vkeID string, nodePoolID string, nodeID string) (*govultr.Response, error) {
+	f.record("DeleteNodePoolInstance", vkeID, nodePoolID, nodeID)
+	if f.DeleteNodePoolInstanceFunc != nil {
+		return f.DeleteNodePoolInstanceFunc(ctx, vkeID, nodePoolID, nodeID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("KubernetesService.DeleteNodePoolInstance")
+}
+
+// RecycleNodePoolInstance records the call and calls RecycleNodePoolInstanceFunc
+func (f *KubernetesService) RecycleNodePoolInstance(ctx context.Context, vkeID string, nodePoolID string, nodeID string) (*govultr.Response, error) {
+	f.record("RecycleNodePoolInstance", vkeID, nodePoolID, nodeID)
+	if f.RecycleNodePoolInstanceFunc != nil {
+		return f.RecycleNodePoolInstanceFunc(ctx, vkeID, nodePoolID, nodeID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("KubernetesService.RecycleNodePoolInstance")
+}
+
+// GetKubeConfig records the call and calls GetKubeConfigFunc
+func (f *KubernetesService) GetKubeConfig(ctx context.Context, vkeID string) (*govultr.KubeConfig, *govultr.Response, error) {
+	f.record("GetKubeConfig", vkeID)
+	if f.GetKubeConfigFunc != nil {
+		return f.GetKubeConfigFunc(ctx, vkeID)
+	}
+	var (
+		r0 *govultr.KubeConfig
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("KubernetesService.GetKubeConfig")
+}
+
+// GetVersions records the call and calls GetVersionsFunc
+func (f *KubernetesService) GetVersions(ctx context.Context) (*govultr.Versions, *govultr.Response, error) {
+	f.record("GetVersions")
+	if f.GetVersionsFunc != nil {
+		return f.GetVersionsFunc(ctx)
+	}
+	var (
+		r0 *govultr.Versions
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("KubernetesService.GetVersions")
+}
+
+// GetUpgrades records the call and calls GetUpgradesFunc
+func (f *KubernetesService) GetUpgrades(ctx context.Context, vkeID string) ([]string, *govultr.Response, error) {
+	f.record("GetUpgrades", vkeID)
+	if f.GetUpgradesFunc != nil {
+		return f.GetUpgradesFunc(ctx, vkeID)
+	}
+	var (
+		r0 []string
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("KubernetesService.GetUpgrades")
+}
+
+// Upgrade records the call and calls UpgradeFunc
+func (f *KubernetesService) Upgrade(ctx context.Context, vkeID string, body *govultr.ClusterUpgradeReq) (*govultr.Response, error) {
+	f.record("Upgrade", vkeID, body)
+	if f.UpgradeFunc != nil {
+		return f.UpgradeFunc(ctx, vkeID, body)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("KubernetesService.Upgrade")
+}
+
+var _ govultr.LoadBalancerService = (*LoadBalancerService)(nil)
+
+// LoadBalancerService is a configurable fake of govultr.LoadBalancerService
+type LoadBalancerService struct {
+	CreateFunc               func(ctx context.Context, createReq *govultr.LoadBalancerReq) (*govultr.LoadBalancer, *govultr.Response, error)
+	GetFunc                  func(ctx context.Context, lbID string) (*govultr.LoadBalancer, *govultr.Response, error)
+	UpdateFunc               func(ctx context.Context, lbID string, updateReq *govultr.LoadBalancerReq) (*govultr.Response, error)
+	DeleteFunc               func(ctx context.Context, lbID string) (*govultr.Response, error)
+	ListFunc                 func(ctx context.Context, options *govultr.ListOptions) ([]govultr.LoadBalancer, *govultr.Meta, *govultr.Response, error)
+	CreateForwardingRuleFunc func(ctx context.Context, lbID string, rule *govultr.ForwardingRule) (*govultr.ForwardingRule, *govultr.Response, error)
+	GetForwardingRuleFunc    func(ctx context.Context, lbID string, ruleID string) (*govultr.ForwardingRule, *govultr.Response, error)
+	DeleteForwardingRuleFunc func(ctx context.Context, lbID string, RuleID string) (*govultr.Response, error)
+	ListForwardingRulesFunc  func(ctx context.Context, lbID string, options *govultr.ListOptions) ([]govultr.ForwardingRule, *govultr.Meta, *govultr.Response, error)
+	ListFirewallRulesFunc    func(ctx context.Context, lbID string, options *govultr.ListOptions) ([]govultr.LBFirewallRule, *govultr.Meta, *govultr.Response, error)
+	GetFirewallRuleFunc      func(ctx context.Context, lbID string, ruleID string) (*govultr.LBFirewallRule, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *LoadBalancerService) Create(ctx context.Context, createReq *govultr.LoadBalancerReq) (*govultr.LoadBalancer, *govultr.Response, error) {
+	f.record("Create", createReq)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, createReq)
+	}
+	var (
+		r0 *govultr.LoadBalancer
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("LoadBalancerService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *LoadBalancerService) Get(ctx context.Context, lbID string) (*govultr.LoadBalancer, *govultr.Response, error) {
+	f.record("Get", lbID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, lbID)
+	}
+	var (
+		r0 *govultr.LoadBalancer
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("LoadBalancerService.Get")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *LoadBalancerService) Update(ctx context.Context, lbID string, updateReq *govultr.LoadBalancerReq) (*govultr.Response, error) {
+	f.record("Update", lbID, updateReq)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, lbID, updateReq)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("LoadBalancerService.Update")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *LoadBalancerService) Delete(ctx context.Context, lbID string) (*govultr.Response, error) {
+	f.record("Delete", lbID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, lbID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("LoadBalancerService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *LoadBalancerService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.LoadBalancer, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.LoadBalancer
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("LoadBalancerService.List")
+}
+
+// CreateForwardingRule records the call and calls CreateForwardingRuleFunc
+func (f *LoadBalancerService) CreateForwardingRule(ctx context.Context, lbID string, rule *govultr.ForwardingRule) (*govultr.ForwardingRule, *govultr.Response, error) {
+	f.record("CreateForwardingRule", lbID, rule)
+	if f.CreateForwardingRuleFunc != nil {
+		return f.CreateForwardingRuleFunc(ctx, lbID, rule)
+	}
+	var (
+		r0 *govultr.ForwardingRule
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("LoadBalancerService.CreateForwardingRule")
+}
+
+// GetForwardingRule records the call and calls GetForwardingRuleFunc
+func (f *LoadBalancerService) GetForwardingRule(ctx context.Context, lbID string, ruleID string) (*govultr.ForwardingRule, *govultr.Response, error) {
+	f.record("GetForwardingRule", lbID, ruleID)
+	if f.GetForwardingRuleFunc != nil {
+		return f.GetForwardingRuleFunc(ctx, lbID, ruleID)
+	}
+	var (
+		r0 *govultr.ForwardingRule
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("LoadBalancerService.GetForwardingRule")
+}
+
+// DeleteForwardingRule records the call and calls DeleteForwardingRuleFunc
+func (f *LoadBalancerService) DeleteForwardingRule(ctx context.Context, lbID string, RuleID string) (*govultr.Response, error) {
+	f.record("DeleteForwardingRule", lbID, RuleID)
+	if f.DeleteForwardingRuleFunc != nil {
+		return f.DeleteForwardingRuleFunc(ctx, lbID, RuleID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("LoadBalancerService.DeleteForwardingRule")
+}
+
+// ListForwardingRules records the call and calls ListForwardingRulesFunc
+func (f *LoadBalancerService) ListForwardingRules(ctx context.Context, lbID string, options *govultr.ListOptions) ([]govultr.ForwardingRule, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListForwardingRules", lbID, options)
+	if f.ListForwardingRulesFunc != nil {
+		return f.ListForwardingRulesFunc(ctx, lbID, options)
+	}
+	var (
+		r0 []govultr.ForwardingRule
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("LoadBalancerService.ListForwardingRules")
+}
+
+// ListFirewallRules records the call and calls ListFirewallRulesFunc
+func (f *LoadBalancerService) ListFirewallRules(ctx context.Context, lbID string, options *govultr.ListOptions) ([]govultr.LBFirewallRule, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListFirewallRules", lbID, options)
+	if f.ListFirewallRulesFunc != nil {
+		return f.ListFirewallRulesFunc(ctx, lbID, options)
+	}
+	var (
+		r0 []govultr.LBFirewallRule
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("LoadBalancerService.ListFirewallRules")
+}
+
+// GetFirewallRule records the call and calls GetFirewallRuleFunc
+func (f *LoadBalancerService) GetFirewallRule(ctx context.Context, lbID string, ruleID string) (*govultr.LBFirewallRule, *govultr.Response, error) {
+	f.record("GetFirewallRule", lbID, ruleID)
+	if f.GetFirewallRuleFunc != nil {
+		return f.GetFirewallRuleFunc(ctx, lbID, ruleID)
+	}
+	var (
+		r0 *govultr.LBFirewallRule
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("LoadBalancerService.GetFirewallRule")
+}
+
+var _ govultr.NetworkService = (*NetworkService)(nil)
+
+// NetworkService is a configurable fake of govultr.NetworkService
+type NetworkService struct {
+	CreateFunc func(ctx context.Context, createReq *govultr.NetworkReq) (*govultr.Network, *govultr.Response, error)
+	GetFunc    func(ctx context.Context, networkID string) (*govultr.Network, *govultr.Response, error)
+	UpdateFunc func(ctx context.Context, networkID string, description string) (*govultr.Response, error)
+	DeleteFunc func(ctx context.Context, networkID string) (*govultr.Response, error)
+	ListFunc   func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Network, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *NetworkService) Create(ctx context.Context, createReq *govultr.NetworkReq) (*govultr.Network, *govultr.Response, error) {
+	f.record("Create", createReq)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, createReq)
+	}
+	var (
+		r0 *govultr.Network
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("NetworkService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *NetworkService) Get(ctx context.Context, networkID string) (*govultr.Network, *govultr.Response, error) {
+	f.record("Get", networkID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, networkID)
+	}
+	var (
+		r0 *govultr.Network
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("NetworkService.Get")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *NetworkService) Update(ctx context.Context, networkID string, description string) (*govultr.Response, error) {
+	f.record("Update", networkID, description)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, networkID, description)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("NetworkService.Update")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *NetworkService) Delete(ctx context.Context, networkID string) (*govultr.Response, error) {
+	f.record("Delete", networkID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, networkID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("NetworkService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *NetworkService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Network, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.Network
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("NetworkService.List")
+}
+
+var _ govultr.ObjectStorageService = (*ObjectStorageService)(nil)
+
+// ObjectStorageService is a configurable fake of govultr.ObjectStorageService
+type ObjectStorageService struct {
+	CreateFunc         func(ctx context.Context, clusterID int, label string) (*govultr.ObjectStorage, *govultr.Response, error)
+	GetFunc            func(ctx context.Context, id string) (*govultr.ObjectStorage, *govultr.Response, error)
+	UpdateFunc         func(ctx context.Context, id string, label string) (*govultr.Response, error)
+	DeleteFunc         func(ctx context.Context, id string) (*govultr.Response, error)
+	ListFunc           func(ctx context.Context, options *govultr.ListOptions) ([]govultr.ObjectStorage, *govultr.Meta, *govultr.Response, error)
+	ListClusterFunc    func(ctx context.Context, options *govultr.ListOptions) ([]govultr.ObjectStorageCluster, *govultr.Meta, *govultr.Response, error)
+	RegenerateKeysFunc func(ctx context.Context, id string) (*govultr.S3Keys, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *ObjectStorageService) Create(ctx context.Context, clusterID int, label string) (*govultr.ObjectStorage, *govultr.Response, error) {
+	f.record("Create", clusterID, label)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, clusterID, label)
+	}
+	var (
+		r0 *govultr.ObjectStorage
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("ObjectStorageService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *ObjectStorageService) Get(ctx context.Context, id string) (*govultr.ObjectStorage, *govultr.Response, error) {
+	f.record("Get", id)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, id)
+	}
+	var (
+		r0 *govultr.ObjectStorage
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("ObjectStorageService.Get")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *ObjectStorageService) Update(ctx context.Context, id string, label string) (*govultr.Response, error) {
+	f.record("Update", id, label)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, id, label)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("ObjectStorageService.Update")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *ObjectStorageService) Delete(ctx context.Context, id string) (*govultr.Response, error) {
+	f.record("Delete", id)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, id)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("ObjectStorageService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *ObjectStorageService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.ObjectStorage, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.ObjectStorage
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("ObjectStorageService.List")
+}
+
+// ListCluster records the call and calls ListClusterFunc
+func (f *ObjectStorageService) ListCluster(ctx context.Context, options *govultr.ListOptions) ([]govultr.ObjectStorageCluster, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListCluster", options)
+	if f.ListClusterFunc != nil {
+		return f.ListClusterFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.ObjectStorageCluster
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("ObjectStorageService.ListCluster")
+}
+
+// RegenerateKeys records the call and calls RegenerateKeysFunc
+func (f *ObjectStorageService) RegenerateKeys(ctx context.Context, id string) (*govultr.S3Keys, *govultr.Response, error) {
+	f.record("RegenerateKeys", id)
+	if f.RegenerateKeysFunc != nil {
+		return f.RegenerateKeysFunc(ctx, id)
+	}
+	var (
+		r0 *govultr.S3Keys
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("ObjectStorageService.RegenerateKeys")
+}
+
+var _ govultr.OSService = (*OSService)(nil)
+
+// OSService is a configurable fake of govultr.OSService
+type OSService struct {
+	ListFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.OS, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// List records the call and calls ListFunc
+func (f *OSService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.OS, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.OS
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("OSService.List")
+}
+
+var _ govultr.PlanService = (*PlanService)(nil)
+
+// PlanService is a configurable fake of govultr.PlanService
+type PlanService struct {
+	ListFunc          func(ctx context.Context, planType string, options *govultr.ListOptions) ([]govultr.Plan, *govultr.Meta, *govultr.Response, error)
+	ListBareMetalFunc func(ctx context.Context, options *govultr.ListOptions) ([]govultr.BareMetalPlan, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// List records the call and calls ListFunc
+func (f *PlanService) List(ctx context.Context, planType string, options *govultr.ListOptions) ([]govultr.Plan, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", planType, options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, planType, options)
+	}
+	var (
+		r0 []govultr.Plan
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("PlanService.List")
+}
+
+// ListBareMetal records the call and calls ListBareMetalFunc
+func (f *PlanService) ListBareMetal(ctx context.Context, options *govultr.ListOptions) ([]govultr.BareMetalPlan, *govultr.Meta, *govultr.Response, error) {
+	f.record("ListBareMetal", options)
+	if f.ListBareMetalFunc != nil {
+		return f.ListBareMetalFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.BareMetalPlan
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("PlanService.ListBareMetal")
+}
+
+var _ govultr.RegionService = (*RegionService)(nil)
+
+// RegionService is a configurable fake of govultr.RegionService
+type RegionService struct {
+	AvailabilityFunc func(ctx context.Context, regionID string, planType string) (*govultr.PlanAvailability, *govultr.Response, error)
+	ListFunc         func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Region, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// Availability records the call and calls AvailabilityFunc
+func (f *RegionService) Availability(ctx context.Context, regionID string, planType string) (*govultr.PlanAvailability, *govultr.Response, error) {
+	f.record("Availability", regionID, planType)
+	if f.AvailabilityFunc != nil {
+		return f.AvailabilityFunc(ctx, regionID, planType)
+	}
+	var (
+		r0 *govultr.PlanAvailability
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("RegionService.Availability")
+}
+
+// List records the call and calls ListFunc
+func (f *RegionService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Region, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.Region
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("RegionService.List")
+}
+
+var _ govultr.ReservedIPService = (*ReservedIPService)(nil)
+
+// ReservedIPService is a configurable fake of govultr.ReservedIPService
+type ReservedIPService struct {
+	CreateFunc  func(ctx context.Context, ripCreate *govultr.ReservedIPReq) (*govultr.ReservedIP, *govultr.Response, error)
+	UpdateFunc  func(ctx context.Context, id string, ripUpdate *govultr.ReservedIPUpdateReq) (*govultr.ReservedIP, *govultr.Response, error)
+	GetFunc     func(ctx context.Context, id string) (*govultr.ReservedIP, *govultr.Response, error)
+	DeleteFunc  func(ctx context.Context, id string) (*govultr.Response, error)
+	ListFunc    func(ctx context.Context, options *govultr.ListOptions) ([]govultr.ReservedIP, *govultr.Meta, *govultr.Response, error)
+	ConvertFunc func(ctx context.Context, ripConvert *govultr.ReservedIPConvertReq) (*govultr.ReservedIP, *govultr.Response, error)
+	AttachFunc  func(ctx context.Context, id string, instance string) (*govultr.Response, error)
+	DetachFunc  func(ctx context.Context, id string) (*govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *ReservedIPService) Create(ctx context.Context, ripCreate *govultr.ReservedIPReq) (*govultr.ReservedIP, *govultr.Response, error) {
+	f.record("Create", ripCreate)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, ripCreate)
+	}
+	var (
+		r0 *govultr.ReservedIP
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("ReservedIPService.Create")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *ReservedIPService) Update(ctx context.Context, id string, ripUpdate *govultr.ReservedIPUpdateReq) (*govultr.ReservedIP, *govultr.Response, error) {
+	f.record("Update", id, ripUpdate)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, id, ripUpdate)
+	}
+	var (
+		r0 *govultr.ReservedIP
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("ReservedIPService.Update")
+}
+
+// Get records the call and calls GetFunc
+func (f *ReservedIPService) Get(ctx context.Context, id string) (*govultr.ReservedIP, *govultr.Response, error) {
+	f.record("Get", id)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, id)
+	}
+	var (
+		r0 *govultr.ReservedIP
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("ReservedIPService.Get")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *ReservedIPService) Delete(ctx context.Context, id string) (*govultr.Response, error) {
+	f.record("Delete", id)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, id)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("ReservedIPService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *ReservedIPService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.ReservedIP, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.ReservedIP
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("ReservedIPService.List")
+}
+
+// Convert records the call and calls ConvertFunc
+func (f *ReservedIPService) Convert(ctx context.Context, ripConvert *govultr.ReservedIPConvertReq) (*govultr.ReservedIP, *govultr.Response, error) {
+	f.record("Convert", ripConvert)
+	if f.ConvertFunc != nil {
+		return f.ConvertFunc(ctx, ripConvert)
+	}
+	var (
+		r0 *govultr.ReservedIP
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("ReservedIPService.Convert")
+}
+
+// Attach records the call and calls AttachFunc
+func (f *ReservedIPService) Attach(ctx context.Context, id string, instance string) (*govultr.Response, error) {
+	f.record("Attach", id, instance)
+	if f.AttachFunc != nil {
+		return f.AttachFunc(ctx, id, instance)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("ReservedIPService.Attach")
+}
+
+// Detach records the call and calls DetachFunc
+func (f *ReservedIPService) Detach(ctx context.Context, id string) (*govultr.Response, error) {
+	f.record("Detach", id)
+	if f.DetachFunc != nil {
+		return f.DetachFunc(ctx, id)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("ReservedIPService.Detach")
+}
+
+var _ govultr.SnapshotService = (*SnapshotService)(nil)
+
+// SnapshotService is a configurable fake of govultr.SnapshotService
+type SnapshotService struct {
+	CreateFunc        func(ctx context.Context, snapshotReq *govultr.SnapshotReq) (*govultr.Snapshot, *govultr.Response, error)
+	CreateFromURLFunc func(ctx context.Context, snapshotURLReq *govultr.SnapshotURLReq) (*govultr.Snapshot, *govultr.Response, error)
+	GetFunc           func(ctx context.Context, snapshotID string) (*govultr.Snapshot, *govultr.Response, error)
+	DeleteFunc        func(ctx context.Context, snapshotID string) (*govultr.Response, error)
+	ListFunc          func(ctx context.Context, options *govultr.ListOptions) ([]govultr.Snapshot, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *SnapshotService) Create(ctx context.Context, snapshotReq *govultr.SnapshotReq) (*govultr.Snapshot, *govultr.Response, error) {
+	f.record("Create", snapshotReq)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, snapshotReq)
+	}
+	var (
+		r0 *govultr.Snapshot
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("SnapshotService.Create")
+}
+
+// CreateFromURL records the call and calls CreateFromURLFunc
+func (f *SnapshotService) CreateFromURL(ctx context.Context, snapshotURLReq *govultr.SnapshotURLReq) (*govultr.Snapshot, *govultr.Response, error) {
+	f.record("CreateFromURL", snapshotURLReq)
+	if f.CreateFromURLFunc != nil {
+		return f.CreateFromURLFunc(ctx, snapshotURLReq)
+	}
+	var (
+		r0 *govultr.Snapshot
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("SnapshotService.CreateFromURL")
+}
+
+// Get records the call and calls GetFunc
+func (f *SnapshotService) Get(ctx context.Context, snapshotID string) (*govultr.Snapshot, *govultr.Response, error) {
+	f.record("Get", snapshotID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, snapshotID)
+	}
+	var (
+		r0 *govultr.Snapshot
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("SnapshotService.Get")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *SnapshotService) Delete(ctx context.Context, snapshotID string) (*govultr.Response, error) {
+	f.record("Delete", snapshotID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, snapshotID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("SnapshotService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *SnapshotService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Snapshot, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.Snapshot
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("SnapshotService.List")
+}
+
+var _ govultr.SSHKeyService = (*SSHKeyService)(nil)
+
+// SSHKeyService is a configurable fake of govultr.SSHKeyService
+type SSHKeyService struct {
+	CreateFunc func(ctx context.Context, sshKeyReq *govultr.SSHKeyReq) (*govultr.SSHKey, *govultr.Response, error)
+	GetFunc    func(ctx context.Context, sshKeyID string) (*govultr.SSHKey, *govultr.Response, error)
+	UpdateFunc func(ctx context.Context, sshKeyID string, sshKeyReq *govultr.SSHKeyReq) (*govultr.Response, error)
+	DeleteFunc func(ctx context.Context, sshKeyID string) (*govultr.Response, error)
+	ListFunc   func(ctx context.Context, options *govultr.ListOptions) ([]govultr.SSHKey, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *SSHKeyService) Create(ctx context.Context, sshKeyReq *govultr.SSHKeyReq) (*govultr.SSHKey, *govultr.Response, error) {
+	f.record("Create", sshKeyReq)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, sshKeyReq)
+	}
+	var (
+		r0 *govultr.SSHKey
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("SSHKeyService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *SSHKeyService) Get(ctx context.Context, sshKeyID string) (*govultr.SSHKey, *govultr.Response, error) {
+	f.record("Get", sshKeyID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, sshKeyID)
+	}
+	var (
+		r0 *govultr.SSHKey
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("SSHKeyService.Get")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *SSHKeyService) Update(ctx context.Context, sshKeyID string, sshKeyReq *govultr.SSHKeyReq) (*govultr.Response, error) {
+	f.record("Update", sshKeyID, sshKeyReq)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, sshKeyID, sshKeyReq)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("SSHKeyService.Update")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *SSHKeyService) Delete(ctx context.Context, sshKeyID string) (*govultr.Response, error) {
+	f.record("Delete", sshKeyID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, sshKeyID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("SSHKeyService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *SSHKeyService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.SSHKey, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.SSHKey
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("SSHKeyService.List")
+}
+
+var _ govultr.StartupScriptService = (*StartupScriptService)(nil)
+
+// StartupScriptService is a configurable fake of govultr.StartupScriptService
+type StartupScriptService struct {
+	CreateFunc func(ctx context.Context, req *govultr.StartupScriptReq) (*govultr.StartupScript, *govultr.Response, error)
+	GetFunc    func(ctx context.Context, scriptID string) (*govultr.StartupScript, *govultr.Response, error)
+	UpdateFunc func(ctx context.Context, scriptID string, scriptReq *govultr.StartupScriptReq) (*govultr.Response, error)
+	DeleteFunc func(ctx context.Context, scriptID string) (*govultr.Response, error)
+	ListFunc   func(ctx context.Context, options *govultr.ListOptions) ([]govultr.StartupScript, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *StartupScriptService) Create(ctx context.Context, req *govultr.StartupScriptReq) (*govultr.StartupScript, *govultr.Response, error) {
+	f.record("Create", req)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, req)
+	}
+	var (
+		r0 *govultr.StartupScript
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("StartupScriptService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *StartupScriptService) Get(ctx context.Context, scriptID string) (*govultr.StartupScript, *govultr.Response, error) {
+	f.record("Get", scriptID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, scriptID)
+	}
+	var (
+		r0 *govultr.StartupScript
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("StartupScriptService.Get")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *StartupScriptService) Update(ctx context.Context, scriptID string, scriptReq *govultr.StartupScriptReq) (*govultr.Response, error) {
+	f.record("Update", scriptID, scriptReq)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, scriptID, scriptReq)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("StartupScriptService.Update")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *StartupScriptService) Delete(ctx context.Context, scriptID string) (*govultr.Response, error) {
+	f.record("Delete", scriptID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, scriptID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("StartupScriptService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *StartupScriptService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.StartupScript, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.StartupScript
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("StartupScriptService.List")
+}
+
+var _ govultr.UserService = (*UserService)(nil)
+
+// UserService is a configurable fake of govultr.UserService
+type UserService struct {
+	CreateFunc func(ctx context.Context, userCreate *govultr.UserReq) (*govultr.User, *govultr.Response, error)
+	GetFunc    func(ctx context.Context, userID string) (*govultr.User, *govultr.Response, error)
+	UpdateFunc func(ctx context.Context, userID string, userReq *govultr.UserReq) (*govultr.Response, error)
+	DeleteFunc func(ctx context.Context, userID string) (*govultr.Response, error)
+	ListFunc   func(ctx context.Context, options *govultr.ListOptions) ([]govultr.User, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *UserService) Create(ctx context.Context, userCreate *govultr.UserReq) (*govultr.User, *govultr.Response, error) {
+	f.record("Create", userCreate)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, userCreate)
+	}
+	var (
+		r0 *govultr.User
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("UserService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *UserService) Get(ctx context.Context, userID string) (*govultr.User, *govultr.Response, error) {
+	f.record("Get", userID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, userID)
+	}
+	var (
+		r0 *govultr.User
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("UserService.Get")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *UserService) Update(ctx context.Context, userID string, userReq *govultr.UserReq) (*govultr.Response, error) {
+	f.record("Update", userID, userReq)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, userID, userReq)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("UserService.Update")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *UserService) Delete(ctx context.Context, userID string) (*govultr.Response, error) {
+	f.record("Delete", userID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, userID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("UserService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *UserService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.User, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.User
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("UserService.List")
+}
+
+var _ govultr.VPCService = (*VPCService)(nil)
+
+// VPCService is a configurable fake of govultr.VPCService
+type VPCService struct {
+	CreateFunc func(ctx context.Context, createReq *govultr.VPCReq) (*govultr.VPC, *govultr.Response, error)
+	GetFunc    func(ctx context.Context, vpcID string) (*govultr.VPC, *govultr.Response, error)
+	UpdateFunc func(ctx context.Context, vpcID string, description string) (*govultr.Response, error)
+	DeleteFunc func(ctx context.Context, vpcID string) (*govultr.Response, error)
+	ListFunc   func(ctx context.Context, options *govultr.ListOptions) ([]govultr.VPC, *govultr.Meta, *govultr.Response, error)
+
+	Recorder
+}
+
+// Create records the call and calls CreateFunc
+func (f *VPCService) Create(ctx context.Context, createReq *govultr.VPCReq) (*govultr.VPC, *govultr.Response, error) {
+	f.record("Create", createReq)
+	if f.CreateFunc != nil {
+		return f.CreateFunc(ctx, createReq)
+	}
+	var (
+		r0 *govultr.VPC
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("VPCService.Create")
+}
+
+// Get records the call and calls GetFunc
+func (f *VPCService) Get(ctx context.Context, vpcID string) (*govultr.VPC, *govultr.Response, error) {
+	f.record("Get", vpcID)
+	if f.GetFunc != nil {
+		return f.GetFunc(ctx, vpcID)
+	}
+	var (
+		r0 *govultr.VPC
+		r1 *govultr.Response
+	)
+	return r0, r1, notImplemented("VPCService.Get")
+}
+
+// Update records the call and calls UpdateFunc
+func (f *VPCService) Update(ctx context.Context, vpcID string, description string) (*govultr.Response, error) {
+	f.record("Update", vpcID, description)
+	if f.UpdateFunc != nil {
+		return f.UpdateFunc(ctx, vpcID, description)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("VPCService.Update")
+}
+
+// Delete records the call and calls DeleteFunc
+func (f *VPCService) Delete(ctx context.Context, vpcID string) (*govultr.Response, error) {
+	f.record("Delete", vpcID)
+	if f.DeleteFunc != nil {
+		return f.DeleteFunc(ctx, vpcID)
+	}
+	var (
+		r0 *govultr.Response
+	)
+	return r0, notImplemented("VPCService.Delete")
+}
+
+// List records the call and calls ListFunc
+func (f *VPCService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.VPC, *govultr.Meta, *govultr.Response, error) {
+	f.record("List", options)
+	if f.ListFunc != nil {
+		return f.ListFunc(ctx, options)
+	}
+	var (
+		r0 []govultr.VPC
+		r1 *govultr.Meta
+		r2 *govultr.Response
+	)
+	return r0, r1, r2, notImplemented("VPCService.List")
+}
@@ -0,0 +1,116 @@
+package govultrfake
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/vultr/govultr/v3"
+)
+
+var ctx = context.Background()
+
+func TestFake_Func(t *testing.T) {
+	fake := &InstanceService{
+		GetFunc: func(ctx context.Context, instanceID string) (*govultr.Instance, *govultr.Response, error) {
+			return &govultr.Instance{ID: instanceID}, nil, nil
+		},
+	}
+
+	instance, _, err := fake.Get(ctx, "abc")
+	if err != nil || instance.ID != "abc" {
+		t.Errorf("Get returned %+v, %+v", instance, err)
+	}
+}
+
+func TestFake_NotImplemented(t *testing.T) {
+	fake := &InstanceService{}
+
+	if _, _, err := fake.Create(ctx, &govultr.InstanceCreateReq{}); !errors.Is(err, ErrNotImplemented) {
+		t.Errorf("Create returned %+v, expected %v", err, ErrNotImplemented)
+	}
+
+	if _, err := fake.Start(ctx, "abc"); !errors.Is(err, ErrNotImplemented) {
+		t.Errorf("Start returned %+v, expected %v", err, ErrNotImplemented)
+	}
+}
+
+func TestFake_Recorder(t *testing.T) {
+	fake := &DatabaseService{}
+
+	fake.Get(ctx, "db-1")
+	fake.Get(ctx, "db-2")
+	fake.Delete(ctx, "db-1")
+
+	if count := fake.CallCount("Get"); count != 2 {
+		t.Errorf("CallCount(Get) = %d, expected 2", count)
+	}
+
+	if !fake.Called("Delete", "db-1") || fake.Called("Delete", "db-2") {
+		t.Errorf("Called(Delete) did not match the recorded arguments: %+v", fake.Calls())
+	}
+
+	calls := fake.Calls()
+	if len(calls) != 3 || calls[2].Method != "Delete" || calls[2].Args[0] != "db-1" {
+		t.Errorf("Calls returned %+v", calls)
+	}
+
+	fake.AssertCalled(t, "Get", "db-2")
+	fake.AssertCallCount(t, "Delete", 1)
+	fake.AssertNotCalled(t, "Update")
+
+	fake.Reset()
+	if len(fake.Calls()) != 0 {
+		t.Errorf("Reset left %d calls", len(fake.Calls()))
+	}
+}
+
+// failingT records failures instead of failing the test
+type failingT struct {
+	testing.TB
+	failures int
+}
+
+func (f *failingT) Helper() {}
+
+func (f *failingT) Errorf(format string, args ...interface{}) {
+	f.failures++
+}
+
+func TestFake_Assertions(t *testing.T) {
+	fake := &KubernetesService{}
+	fake.GetCluster(ctx, "vke-1")
+
+	mock := &failingT{TB: t}
+	if fake.AssertCalled(mock, "GetCluster", "vke-2") {
+		t.Error("AssertCalled passed with mismatched arguments")
+	}
+	if fake.AssertNotCalled(mock, "GetCluster") {
+		t.Error("AssertNotCalled passed for a called method")
+	}
+	if fake.AssertCallCount(mock, "GetCluster", 2) {
+		t.Error("AssertCallCount passed with the wrong count")
+	}
+	if mock.failures != 3 {
+		t.Errorf("assertions reported %d failures, expected 3", mock.failures)
+	}
+}
+
+func TestServices_Client(t *testing.T) {
+	fakes := New()
+	fakes.Instance.GetFunc = func(ctx context.Context, instanceID string) (*govultr.Instance, *govultr.Response, error) {
+		return &govultr.Instance{ID: instanceID, Status: "active"}, nil, nil
+	}
+
+	client := fakes.Client()
+	instance, _, err := client.Instance.Get(ctx, "abc")
+	if err != nil || instance.Status != "active" {
+		t.Errorf("Instance.Get returned %+v, %+v", instance, err)
+	}
+
+	fakes.Instance.AssertCalled(t, "Get", "abc")
+
+	if _, _, err := client.VPC.Get(ctx, "vpc"); !errors.Is(err, ErrNotImplemented) {
+		t.Errorf("VPC.Get returned %+v, expected %v", err, ErrNotImplemented)
+	}
+}
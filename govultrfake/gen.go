@@ -0,0 +1,137 @@
+//go:build ignore
+
+// gen writes fakes.go, a configurable fake for every service of govultr.Client.
+package main
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/vultr/govultr/v3/internal/servicegen"
+)
+
+var tmpl = template.Must(template.New("fakes").Funcs(template.FuncMap{
+	"recordArgs":  recordArgs,
+	"funcType":    funcType,
+	"zeroResults": zeroResults,
+}).Parse(`// Code generated by gen.go; DO NOT EDIT.
+
+package govultrfake
+
+import (
+	"context"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Services holds a fake for every service of govultr.Client
+type Services struct {
+{{- range .}}
+	{{.Field}} *{{.Interface}}
+{{- end}}
+}
+
+// New returns a fake for every service, none of them configured
+func New() *Services {
+	return &Services{
+{{- range .}}
+		{{.Field}}: &{{.Interface}}{},
+{{- end}}
+	}
+}
+
+// Client returns a client whose services are the fakes of s
+func (s *Services) Client() *govultr.Client {
+	c := govultr.NewClient(nil)
+{{- range .}}
+	c.{{.Field}} = s.{{.Field}}
+{{- end}}
+	return c
+}
+{{range $s := .}}
+var _ govultr.{{$s.Interface}} = (*{{$s.Interface}})(nil)
+
+// {{$s.Interface}} is a configurable fake of govultr.{{$s.Interface}}
+type {{$s.Interface}} struct {
+{{- range $s.Methods}}
+	{{.Name}}Func {{funcType .}}
+{{- end}}
+
+	Recorder
+}
+{{range $s.Methods}}
+// {{.Name}} records the call and calls {{.Name}}Func
+func (f *{{$s.Interface}}) {{.Name}}{{.Signature}} {
+	f.record("{{.Name}}"{{recordArgs .}})
+	if f.{{.Name}}Func != nil {
+		return f.{{.Name}}Func({{.Args}})
+	}
+	{{zeroResults $s .}}
+}
+{{end}}{{end}}`))
+
+// recordArgs passes every parameter but the context to record
+func recordArgs(m servicegen.Method) string {
+	var b strings.Builder
+	for _, p := range m.Params[1:] {
+		b.WriteString(", " + p.Name)
+	}
+	return b.String()
+}
+
+func funcType(m servicegen.Method) string {
+	return "func" + m.Signature()
+}
+
+// zeroResults returns zero values along with ErrNotImplemented
+func zeroResults(s servicegen.Service, m servicegen.Method) string {
+	if len(m.Results) == 1 {
+		return `return notImplemented("` + s.Interface + "." + m.Name + `")`
+	}
+
+	var b strings.Builder
+	b.WriteString("var (\n")
+	names := make([]string, 0, len(m.Results))
+	for i, r := range m.Results[:len(m.Results)-1] {
+		name := "r" + string(rune('0'+i))
+		names = append(names, name)
+		b.WriteString(name + " " + r + "\n")
+	}
+	b.WriteString(")\n")
+	names = append(names, `notImplemented("`+s.Interface+"."+m.Name+`")`)
+	b.WriteString("return " + strings.Join(names, ", "))
+	return b.String()
+}
+
+func main() {
+	services, err := servicegen.Load("..")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, s := range services {
+		for _, m := range s.Methods {
+			if !m.HasContext() || !m.ReturnsError() {
+				log.Fatalf("%s.%s must take a context and return an error to be faked", s.Field, m.Name)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, services); err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := servicegen.Format(buf.Bytes())
+	if err != nil {
+		os.WriteFile("fakes.go", src, 0o644)
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("fakes.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,150 @@
+// Package govultrfake provides configurable fakes of the govultr service interfaces.
+//
+// Every fake has a function field per method, named after the method with a Func suffix.
+// Methods whose function is not set return ErrNotImplemented. Calls are recorded, with their
+// arguments, and can be asserted on:
+//
+//	instances := &govultrfake.InstanceService{
+//		GetFunc: func(ctx context.Context, instanceID string) (*govultr.Instance, *govultr.Response, error) {
+//			return &govultr.Instance{ID: instanceID, Status: "active"}, nil, nil
+//		},
+//	}
+//
+//	client := govultr.NewClient(nil)
+//	client.Instance = instances
+//	...
+//	instances.AssertCalled(t, "Get", "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
+package govultrfake
+
+//go:generate go run gen.go
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// ErrNotImplemented is returned by the methods of a fake whose function field is not set
+var ErrNotImplemented = errors.New("govultrfake: method not implemented")
+
+func notImplemented(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotImplemented, method)
+}
+
+// Call is a method call recorded by a fake. Args holds every argument but the context.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Recorder records the calls made to a fake. It is embedded by every fake and safe for
+// concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns every recorded call, in order
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls to method, in order
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// CallCount returns the number of calls to method
+func (r *Recorder) CallCount(method string) int {
+	return len(r.CallsTo(method))
+}
+
+// Called reports whether method was called with args. With no args, any call matches.
+func (r *Recorder) Called(method string, args ...interface{}) bool {
+	for _, c := range r.CallsTo(method) {
+		if len(args) == 0 || reflect.DeepEqual(c.Args, args) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reset forgets the recorded calls
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+// AssertCalled fails the test unless method was called with args. With no args, any call matches.
+func (r *Recorder) AssertCalled(t testing.TB, method string, args ...interface{}) bool {
+	t.Helper()
+
+	if r.Called(method, args...) {
+		return true
+	}
+
+	if len(args) == 0 {
+		t.Errorf("expected a call to %s, got calls %s", method, r.describe())
+	} else {
+		t.Errorf("expected a call to %s with %#v, got calls %s", method, args, r.describe())
+	}
+	return false
+}
+
+// AssertNotCalled fails the test if method was called
+func (r *Recorder) AssertNotCalled(t testing.TB, method string) bool {
+	t.Helper()
+
+	if calls := r.CallsTo(method); len(calls) > 0 {
+		t.Errorf("expected no call to %s, got %d", method, len(calls))
+		return false
+	}
+	return true
+}
+
+// AssertCallCount fails the test unless method was called exactly n times
+func (r *Recorder) AssertCallCount(t testing.TB, method string, n int) bool {
+	t.Helper()
+
+	if count := r.CallCount(method); count != n {
+		t.Errorf("expected %d calls to %s, got %d", n, method, count)
+		return false
+	}
+	return true
+}
+
+func (r *Recorder) describe() string {
+	calls := r.Calls()
+	if len(calls) == 0 {
+		return "none"
+	}
+
+	s := ""
+	for i, c := range calls {
+		if i > 0 {
+			s += ", "
+		}
+		s += fmt.Sprintf("%s%#v", c.Method, c.Args)
+	}
+	return s
+}
@@ -0,0 +1,121 @@
+package govultrtest
+
+import (
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+const blockCostPerGB = 0.1
+
+func (s *Server) registerBlocks(mux *http.ServeMux) {
+	s.handle(mux, "POST /v2/blocks", func(w http.ResponseWriter, r *http.Request) {
+		req := new(govultr.BlockStorageCreate)
+		if !decode(w, r, req) || !require(w, "region", req.Region) {
+			return
+		}
+
+		if req.SizeGB <= 0 {
+			writeError(w, http.StatusBadRequest, "Missing required field: size_gb")
+			return
+		}
+
+		block := &govultr.BlockStorage{
+			ID:          s.newID(),
+			Cost:        float32(req.SizeGB) * blockCostPerGB,
+			Status:      "active",
+			SizeGB:      req.SizeGB,
+			Region:      req.Region,
+			DateCreated: now(),
+			Label:       req.Label,
+			BlockType:   req.BlockType,
+		}
+		if block.BlockType == "" {
+			block.BlockType = "high_perf"
+		}
+		block.MountID = req.Region + "-" + block.ID[len(block.ID)-12:]
+		s.blocks.add(block.ID, block)
+		s.blocks.writeItem(w, http.StatusAccepted, block)
+	})
+	s.handle(mux, "GET /v2/blocks", func(w http.ResponseWriter, r *http.Request) {
+		s.blocks.writeList(w, r, s.blocks.all(nil))
+	})
+	s.handle(mux, "GET /v2/blocks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if block, ok := s.block(w, r); ok {
+			s.blocks.writeItem(w, http.StatusOK, block)
+		}
+	})
+	s.handle(mux, "PATCH /v2/blocks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		block, ok := s.block(w, r)
+		if !ok {
+			return
+		}
+
+		req := new(govultr.BlockStorageUpdate)
+		if !decode(w, r, req) {
+			return
+		}
+
+		if size, ok := req.SizeGB.Get(); ok {
+			if size < block.SizeGB {
+				writeError(w, http.StatusBadRequest, "Block storage can not be shrunk.")
+				return
+			}
+			block.SizeGB = size
+			block.Cost = float32(size) * blockCostPerGB
+		}
+		update(&block.Label, req.Label)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle(mux, "DELETE /v2/blocks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.blocks.remove(r.PathValue("id")) {
+			writeNotFound(w, "block-id")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle(mux, "POST /v2/blocks/{id}/attach", func(w http.ResponseWriter, r *http.Request) {
+		block, ok := s.block(w, r)
+		if !ok {
+			return
+		}
+
+		req := new(govultr.BlockStorageAttach)
+		if !decode(w, r, req) || !require(w, "instance_id", req.InstanceID) {
+			return
+		}
+
+		if block.AttachedToInstance != "" {
+			writeError(w, http.StatusBadRequest, "Block storage is already attached.")
+			return
+		}
+		if _, ok := s.instances.get(req.InstanceID); !ok {
+			writeNotFound(w, "instance_id")
+			return
+		}
+
+		block.AttachedToInstance = req.InstanceID
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle(mux, "POST /v2/blocks/{id}/detach", func(w http.ResponseWriter, r *http.Request) {
+		block, ok := s.block(w, r)
+		if !ok {
+			return
+		}
+
+		if block.AttachedToInstance == "" {
+			writeError(w, http.StatusBadRequest, "Block storage is not attached.")
+			return
+		}
+		block.AttachedToInstance = ""
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) block(w http.ResponseWriter, r *http.Request) (*govultr.BlockStorage, bool) {
+	block, ok := s.blocks.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "block-id")
+	}
+	return block, ok
+}
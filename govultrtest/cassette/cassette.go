@@ -0,0 +1,152 @@
+// Package cassette records the requests made to the Vultr API into cassette files, and replays
+// them offline.
+//
+// A Recorder is an http.RoundTripper, given to the client through its http.Client:
+//
+//	rec, err := cassette.New("testdata/instances.yaml", cassette.ModeReplay)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer rec.Stop()
+//
+//	client, err := govultr.New(govultr.WithHTTPClient(rec.Client()), govultr.WithAPIKey(os.Getenv("VULTR_API_KEY")))
+//
+// In record mode the requests reach the API and Stop writes the cassette. In replay mode they are
+// answered from the cassette: requests are matched on their method, path, query and body, each
+// recorded interaction is replayed once and in order, and a request matching none of them is
+// answered with a 501 error which the client does not retry. Stop then reports it.
+//
+// Cassettes never hold the API key: the Authorization header is scrubbed, and so are the secret
+// fields of request and response bodies listed by govultr.IsSecretField. Cassettes whose file name
+// ends in .json are written as JSON, other ones as YAML.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/vultr/govultr/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// Cassette holds the interactions recorded during a session, in order
+type Cassette struct {
+	Interactions []Interaction `json:"interactions" yaml:"interactions"`
+}
+
+// Interaction is a request sent to the API and the response it got
+type Interaction struct {
+	Request  Request  `json:"request" yaml:"request"`
+	Response Response `json:"response" yaml:"response"`
+}
+
+// Request is a recorded request. Query is encoded with sorted keys and Body is normalized JSON.
+type Request struct {
+	Method  string      `json:"method" yaml:"method"`
+	Path    string      `json:"path" yaml:"path"`
+	Query   string      `json:"query,omitempty" yaml:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body    string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// Response is a recorded response
+type Response struct {
+	StatusCode int         `json:"status_code" yaml:"status_code"`
+	Headers    http.Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body       string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// Load reads a cassette file
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	c := new(Cassette)
+	if isJSON(path) {
+		err = json.Unmarshal(data, c)
+	} else {
+		err = yaml.Unmarshal(data, c)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse cassette %s: %w", path, err)
+	}
+
+	return c, nil
+}
+
+// Save writes the cassette to path, creating its directory if needed
+func (c *Cassette) Save(path string) error {
+	var (
+		data []byte
+		err  error
+	)
+	if isJSON(path) {
+		data, err = json.MarshalIndent(c, "", "  ")
+	} else {
+		data, err = yaml.Marshal(c)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+func isJSON(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".json")
+}
+
+// scrubbedHeaders are never recorded as they are
+var scrubbedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+func scrubHeaders(header http.Header) http.Header {
+	scrubbed := header.Clone()
+	for _, name := range scrubbedHeaders {
+		if scrubbed.Get(name) != "" {
+			scrubbed.Set(name, govultr.RedactedValue)
+		}
+	}
+	return scrubbed
+}
+
+// normalizeQuery encodes the query with sorted keys
+func normalizeQuery(rawQuery string) string {
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return rawQuery
+	}
+	return values.Encode()
+}
+
+// normalizeBody scrubs secrets and compacts JSON bodies with sorted keys, so that bodies that
+// only differ by formatting or field order match
+func normalizeBody(body []byte) string {
+	body = bytes.TrimSpace(govultr.RedactJSON(body))
+	if len(body) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return string(body)
+	}
+
+	normalized, err := json.Marshal(v)
+	if err != nil {
+		return string(body)
+	}
+	return string(normalized)
+}
@@ -0,0 +1,145 @@
+package cassette
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/govultr/v3/govultrtest"
+)
+
+var ctx = context.Background()
+
+// session creates two instances and lists them a page at a time
+func session(t *testing.T, client *govultr.Client) []govultr.Instance {
+	t.Helper()
+
+	for _, label := range []string{"web", "api"} {
+		if _, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 387, Label: label}); err != nil {
+			t.Fatalf("Instance.Create returned %+v", err)
+		}
+	}
+
+	instances, err := govultr.ListAll(ctx, client.Instance.List, &govultr.ListOptions{PerPage: 1})
+	if err != nil {
+		t.Fatalf("ListAll returned %+v", err)
+	}
+	return instances
+}
+
+func TestRecorder_RecordReplay(t *testing.T) {
+	for _, name := range []string{"instances.yaml", "instances.json"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "testdata", name)
+
+			server := govultrtest.NewServer()
+			rec, err := New(path, ModeRecord)
+			if err != nil {
+				t.Fatalf("New returned %+v", err)
+			}
+			recorded := session(t, server.Client(govultr.WithHTTPClient(rec.Client())))
+			if err := rec.Stop(); err != nil {
+				t.Fatalf("Stop returned %+v", err)
+			}
+			server.Close()
+
+			if len(recorded) != 2 {
+				t.Fatalf("recorded %d instances, expected 2", len(recorded))
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(string(data), "Bearer") || strings.Contains(string(data), "govultrtest-") {
+				t.Errorf("cassette holds secrets:\n%s", data)
+			}
+			if !strings.Contains(string(data), govultr.RedactedValue) {
+				t.Errorf("cassette does not hold %s:\n%s", govultr.RedactedValue, data)
+			}
+
+			// the server is closed, every request is answered by the cassette
+			rec, err = New(path, ModeReplay, WithT(t))
+			if err != nil {
+				t.Fatalf("New returned %+v", err)
+			}
+			if interactions := len(rec.Cassette().Interactions); interactions != 4 {
+				t.Errorf("cassette has %d interactions, expected 4", interactions)
+			}
+
+			replayed := session(t, server.Client(govultr.WithHTTPClient(rec.Client())))
+			if err := rec.Stop(); err != nil {
+				t.Errorf("Stop returned %+v", err)
+			}
+			if !reflect.DeepEqual(replayed, recorded) {
+				t.Errorf("replayed %+v, expected %+v", replayed, recorded)
+			}
+		})
+	}
+}
+
+func TestRecorder_Unmatched(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "instances.yaml")
+
+	server := govultrtest.NewServer()
+	defer server.Close()
+
+	rec, err := New(path, ModeRecord)
+	if err != nil {
+		t.Fatalf("New returned %+v", err)
+	}
+	session(t, server.Client(govultr.WithHTTPClient(rec.Client())))
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Stop returned %+v", err)
+	}
+
+	rec, err = New(path, ModeReplay)
+	if err != nil {
+		t.Fatalf("New returned %+v", err)
+	}
+	attempts := 0
+	client := server.Client(
+		govultr.WithHTTPClient(rec.Client()),
+		govultr.WithMiddleware(govultr.Observe(func(o govultr.Observation) { attempts = o.Attempts })),
+	)
+
+	var apiErr *govultr.APIError
+	_, _, err = client.Instance.Create(ctx, &govultr.InstanceCreateReq{Region: "ams", Plan: "vc2-1c-1gb", OsID: 387})
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 501 {
+		t.Fatalf("unmatched Instance.Create returned %+v, expected a 501 error", err)
+	}
+	if attempts != 1 {
+		t.Errorf("unmatched Instance.Create was attempted %d times, expected 1", attempts)
+	}
+
+	if _, _, _, err := client.Instance.List(ctx, &govultr.ListOptions{Cursor: "bogus"}); err == nil {
+		t.Fatal("Instance.List with an unknown cursor returned no error")
+	}
+
+	err = rec.Stop()
+	if !errors.Is(err, ErrUnmatched) {
+		t.Fatalf("Stop returned %+v, expected %v", err, ErrUnmatched)
+	}
+	for _, expected := range []string{"POST /v2/instances", `cursor "bogus" was not handed out`, "was never replayed"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Stop returned %q, expected it to mention %q", err, expected)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	if a, b := normalizeBody([]byte(`{"plan": "vc2", "region": "ewr"}`)), normalizeBody([]byte(`{"region":"ewr","plan":"vc2"}`)); a != b {
+		t.Errorf("normalizeBody returned %q and %q, expected them to match", a, b)
+	}
+	if body := normalizeBody([]byte(`{"root_pass":"x","password":"hunter2"}`)); strings.Contains(body, "hunter2") {
+		t.Errorf("normalizeBody returned %q, expected the password to be redacted", body)
+	}
+	if q := normalizeQuery("per_page=1&cursor=abc"); q != "cursor=abc&per_page=1" {
+		t.Errorf("normalizeQuery returned %q", q)
+	}
+}
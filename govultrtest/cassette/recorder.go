@@ -0,0 +1,282 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Mode selects whether a Recorder talks to the API or replays a cassette
+type Mode int
+
+const (
+	// ModeReplay answers requests from the cassette, never reaching the API
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the API and records them, the cassette is written by Stop
+	ModeRecord
+)
+
+// ErrUnmatched reports a request that has no recorded interaction in replay mode
+var ErrUnmatched = errors.New("cassette: no recorded interaction matches the request")
+
+// unmatchedStatus answers unmatched requests: it is not retried by the client, unlike errors and
+// other 5xx statuses, so the test fails right away
+const unmatchedStatus = http.StatusNotImplemented
+
+// Option configures a Recorder
+type Option func(*Recorder)
+
+// WithTransport sets the transport requests are sent with in record mode. It defaults to
+// http.DefaultTransport.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// WithT reports unmatched requests as errors of t, in addition to the errors returned by Stop
+func WithT(t testing.TB) Option {
+	return func(r *Recorder) {
+		r.t = t
+	}
+}
+
+// Recorder is an http.RoundTripper recording or replaying a cassette
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	t         testing.TB
+
+	mu        sync.Mutex
+	cassette  *Cassette
+	used      []bool
+	unmatched []error
+}
+
+// New returns a Recorder for the cassette at path. In replay mode the cassette must exist.
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		cassette:  new(Cassette),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if mode == ModeReplay {
+		c, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = c
+		r.used = make([]bool, len(c.Interactions))
+	}
+
+	return r, nil
+}
+
+// Client returns an http.Client sending its requests through the recorder
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Cassette returns the recorded or replayed cassette
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cassette
+}
+
+// Stop writes the cassette in record mode. In replay mode it returns an error listing the
+// unmatched requests and the interactions that were never replayed.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode == ModeRecord {
+		return r.cassette.Save(r.path)
+	}
+
+	errs := append([]error(nil), r.unmatched...)
+	for i, used := range r.used {
+		if !used {
+			req := r.cassette.Interactions[i].Request
+			errs = append(errs, fmt.Errorf("cassette: interaction %d, %s, was never replayed", i, describe(req)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// RoundTrip records or replays req
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := Request{
+		Method:  req.Method,
+		Path:    req.URL.Path,
+		Query:   normalizeQuery(req.URL.RawQuery),
+		Headers: scrubHeaders(req.Header),
+		Body:    normalizeBody(body),
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, recorded)
+	}
+	return r.replay(req, recorded)
+}
+
+func (r *Recorder) record(req *http.Request, recorded Request) (*http.Response, error) {
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: res.StatusCode,
+			Headers:    scrubHeaders(res.Header),
+			Body:       string(govultr.RedactJSON(body)),
+		},
+	})
+
+	return res, nil
+}
+
+// replay answers req with the first unused interaction matching it. List pages are matched on
+// their cursor, so a paginated list replays its pages in the recorded order.
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+
+		r.used[i] = true
+		return interaction.Response.toHTTP(req), nil
+	}
+
+	err := fmt.Errorf("%w: %s", ErrUnmatched, describe(recorded))
+	if cursor := cursorOf(recorded); cursor != "" && !r.issuedCursor(cursor) {
+		err = fmt.Errorf("%w, its cursor %q was not handed out by any recorded response", err, cursor)
+	}
+
+	r.unmatched = append(r.unmatched, err)
+	if r.t != nil {
+		r.t.Errorf("%v", err)
+	}
+
+	body, _ := json.Marshal(map[string]interface{}{"error": err.Error(), "status": unmatchedStatus})
+	res := Response{StatusCode: unmatchedStatus, Headers: http.Header{"Content-Type": {"application/json"}}, Body: string(body)}
+	return res.toHTTP(req), nil
+}
+
+// issuedCursor reports whether a recorded response listed cursor in its meta links
+func (r *Recorder) issuedCursor(cursor string) bool {
+	for _, interaction := range r.cassette.Interactions {
+		meta := struct {
+			Meta *struct {
+				Links *struct {
+					Next string `json:"next"`
+					Prev string `json:"prev"`
+				} `json:"links"`
+			} `json:"meta"`
+		}{}
+
+		if err := json.Unmarshal([]byte(interaction.Response.Body), &meta); err != nil || meta.Meta == nil || meta.Meta.Links == nil {
+			continue
+		}
+		if meta.Meta.Links.Next == cursor || meta.Meta.Links.Prev == cursor {
+			return true
+		}
+	}
+	return false
+}
+
+func matches(recorded, req Request) bool {
+	return recorded.Method == req.Method &&
+		recorded.Path == req.Path &&
+		recorded.Query == req.Query &&
+		recorded.Body == req.Body
+}
+
+func cursorOf(req Request) string {
+	for _, param := range strings.Split(req.Query, "&") {
+		if value, ok := strings.CutPrefix(param, "cursor="); ok {
+			return value
+		}
+	}
+	return ""
+}
+
+func describe(req Request) string {
+	s := req.Method + " " + req.Path
+	if req.Query != "" {
+		s += "?" + req.Query
+	}
+	if req.Body != "" {
+		s += " " + req.Body
+	}
+	return s
+}
+
+func (res Response) toHTTP(req *http.Request) *http.Response {
+	header := res.Headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)),
+		StatusCode:    res.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(res.Body)),
+		ContentLength: int64(len(res.Body)),
+		Request:       req,
+	}
+}
+
+// readBody returns the body of req, leaving it readable by the transport
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
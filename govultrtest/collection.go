@@ -0,0 +1,148 @@
+package govultrtest
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/vultr/govultr/v3"
+)
+
+const (
+	defaultPerPage = 100
+	maxPerPage     = 500
+)
+
+// collection stores resources of one kind in creation order
+type collection[T any] struct {
+	// key and listKey wrap single resources and lists in responses, e.g. instance and instances
+	key     string
+	listKey string
+
+	ids   []string
+	items map[string]*T
+}
+
+func newCollection[T any](key, listKey string) *collection[T] {
+	return &collection[T]{key: key, listKey: listKey, items: map[string]*T{}}
+}
+
+func (c *collection[T]) add(id string, item *T) {
+	if _, ok := c.items[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+	c.items[id] = item
+}
+
+func (c *collection[T]) get(id string) (*T, bool) {
+	item, ok := c.items[id]
+	return item, ok
+}
+
+func (c *collection[T]) remove(id string) bool {
+	if _, ok := c.items[id]; !ok {
+		return false
+	}
+
+	delete(c.items, id)
+	for i, v := range c.ids {
+		if v == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// all returns copies of the stored resources matching keep, which may be nil
+func (c *collection[T]) all(keep func(*T) bool) []T {
+	items := make([]T, 0, len(c.ids))
+	for _, id := range c.ids {
+		if keep == nil || keep(c.items[id]) {
+			items = append(items, *c.items[id])
+		}
+	}
+	return items
+}
+
+// writeItem answers a single resource with the given status
+func (c *collection[T]) writeItem(w http.ResponseWriter, status int, item *T) {
+	writeJSON(w, status, map[string]interface{}{c.key: item})
+}
+
+// writeList answers the page of items selected by the per_page and cursor query parameters
+func (c *collection[T]) writeList(w http.ResponseWriter, r *http.Request, items []T) {
+	page, meta, ok := paginate(w, r, items)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{c.listKey: page, "meta": meta})
+}
+
+// paginate slices items with cursors encoding the offset of the page, as next__<offset> and
+// prev__<offset> in base64 like the Vultr API does.
+func paginate[T any](w http.ResponseWriter, r *http.Request, items []T) ([]T, *govultr.Meta, bool) {
+	query := r.URL.Query()
+
+	perPage := defaultPerPage
+	if v := query.Get("per_page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPerPage {
+			writeError(w, http.StatusBadRequest, "Invalid per_page value.")
+			return nil, nil, false
+		}
+		perPage = n
+	}
+
+	offset := 0
+	if cursor := query.Get("cursor"); cursor != "" {
+		n, ok := decodeCursor(cursor)
+		if !ok || n > len(items) {
+			writeError(w, http.StatusBadRequest, "Invalid cursor.")
+			return nil, nil, false
+		}
+		offset = n
+	}
+
+	end := offset + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+
+	meta := &govultr.Meta{Total: len(items), Links: &govultr.Links{}}
+	if end < len(items) {
+		meta.Links.Next = encodeCursor("next", end)
+	}
+	if offset > 0 {
+		prev := offset - perPage
+		if prev < 0 {
+			prev = 0
+		}
+		meta.Links.Prev = encodeCursor("prev", prev)
+	}
+
+	return items[offset:end], meta, true
+}
+
+func encodeCursor(direction string, offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(direction + "__" + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, bool) {
+	raw, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, false
+	}
+
+	_, offset, ok := strings.Cut(string(raw), "__")
+	if !ok {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(offset)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,98 @@
+package govultrtest
+
+import (
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (s *Server) registerDatabases(mux *http.ServeMux) {
+	s.handle(mux, "POST /v2/databases", func(w http.ResponseWriter, r *http.Request) {
+		req := new(govultr.DatabaseCreateReq)
+		if !decode(w, r, req) || !require(w, "database_engine", string(req.DatabaseEngine), "region", req.Region, "plan", req.Plan, "label", req.Label) {
+			return
+		}
+
+		database := &govultr.Database{
+			ID:                    s.newID(),
+			DateCreated:           now(),
+			Plan:                  req.Plan,
+			Region:                req.Region,
+			DatabaseEngine:        req.DatabaseEngine,
+			DatabaseEngineVersion: req.DatabaseEngineVersion,
+			VPCID:                 req.VPCID,
+			Status:                "Running",
+			Label:                 req.Label,
+			Tag:                   req.Tag,
+			User:                  "vultradmin",
+			Port:                  "16751",
+			MaintenanceDOW:        req.MaintenanceDOW,
+			MaintenanceTime:       req.MaintenanceTime,
+			TrustedIPs:            req.TrustedIPs,
+			RedisEvictionPolicy:   req.RedisEvictionPolicy,
+		}
+		database.Host = "vultr-prod-" + database.ID + ".vultrdb.com"
+		database.Password = "govultrtest-" + database.ID[len(database.ID)-6:]
+		if database.TrustedIPs == nil {
+			database.TrustedIPs = []string{}
+		}
+
+		s.databases.add(database.ID, database)
+		s.databases.writeItem(w, http.StatusAccepted, database)
+	})
+	s.handle(mux, "GET /v2/databases", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		label, tag, region := query.Get("label"), query.Get("tag"), query.Get("region")
+
+		s.databases.writeList(w, r, s.databases.all(func(d *govultr.Database) bool {
+			return (label == "" || d.Label == label) && (tag == "" || d.Tag == tag) && (region == "" || d.Region == region)
+		}))
+	})
+	s.handle(mux, "GET /v2/databases/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if database, ok := s.database(w, r); ok {
+			s.databases.writeItem(w, http.StatusOK, database)
+		}
+	})
+	s.handle(mux, "PUT /v2/databases/{id}", func(w http.ResponseWriter, r *http.Request) {
+		database, ok := s.database(w, r)
+		if !ok {
+			return
+		}
+
+		req := new(govultr.DatabaseUpdateReq)
+		if !decode(w, r, req) {
+			return
+		}
+
+		for field, value := range map[*string]govultr.Optional[string]{
+			&database.Region:          req.Region,
+			&database.Plan:            req.Plan,
+			&database.Label:           req.Label,
+			&database.Tag:             req.Tag,
+			&database.VPCID:           req.VPCID,
+			&database.MaintenanceDOW:  req.MaintenanceDOW,
+			&database.MaintenanceTime: req.MaintenanceTime,
+			&database.ClusterTimeZone: req.ClusterTimeZone,
+		} {
+			update(field, value)
+		}
+		update(&database.TrustedIPs, req.TrustedIPs)
+
+		s.databases.writeItem(w, http.StatusAccepted, database)
+	})
+	s.handle(mux, "DELETE /v2/databases/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.databases.remove(r.PathValue("id")) {
+			writeNotFound(w, "database-id")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) database(w http.ResponseWriter, r *http.Request) (*govultr.Database, bool) {
+	database, ok := s.databases.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "database-id")
+	}
+	return database, ok
+}
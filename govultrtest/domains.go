@@ -0,0 +1,156 @@
+package govultrtest
+
+import (
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+const defaultTTL = 300
+
+func (s *Server) registerDomains(mux *http.ServeMux) {
+	s.handle(mux, "POST /v2/domains", func(w http.ResponseWriter, r *http.Request) {
+		req := new(govultr.DomainReq)
+		if !decode(w, r, req) || !require(w, "domain", req.Domain) {
+			return
+		}
+
+		if _, ok := s.domains.get(req.Domain); ok {
+			writeError(w, http.StatusBadRequest, "Domain already exists.")
+			return
+		}
+
+		domain := &govultr.Domain{Domain: req.Domain, DateCreated: now(), DNSSec: "disabled"}
+		if req.DNSSec != "" {
+			domain.DNSSec = req.DNSSec
+		}
+		s.domains.add(domain.Domain, domain)
+
+		records := newCollection[govultr.DomainRecord]("record", "records")
+		s.domainRecords[domain.Domain] = records
+		if req.IP != "" {
+			record := &govultr.DomainRecord{ID: s.newID(), Type: "A", Data: req.IP, TTL: defaultTTL}
+			records.add(record.ID, record)
+		}
+
+		s.domains.writeItem(w, http.StatusCreated, domain)
+	})
+	s.handle(mux, "GET /v2/domains", func(w http.ResponseWriter, r *http.Request) {
+		s.domains.writeList(w, r, s.domains.all(nil))
+	})
+	s.handle(mux, "GET /v2/domains/{domain}", func(w http.ResponseWriter, r *http.Request) {
+		if domain, ok := s.domain(w, r); ok {
+			s.domains.writeItem(w, http.StatusOK, domain)
+		}
+	})
+	s.handle(mux, "PUT /v2/domains/{domain}", func(w http.ResponseWriter, r *http.Request) {
+		domain, ok := s.domain(w, r)
+		if !ok {
+			return
+		}
+
+		req := new(govultr.DomainReq)
+		if !decode(w, r, req) || !require(w, "dns_sec", req.DNSSec) {
+			return
+		}
+		domain.DNSSec = req.DNSSec
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle(mux, "DELETE /v2/domains/{domain}", func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue("domain")
+		if !s.domains.remove(name) {
+			writeNotFound(w, "domain")
+			return
+		}
+		delete(s.domainRecords, name)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	s.handle(mux, "POST /v2/domains/{domain}/records", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.domain(w, r); !ok {
+			return
+		}
+
+		req := new(govultr.DomainRecordReq)
+		if !decode(w, r, req) || !require(w, "type", req.Type, "data", req.Data) {
+			return
+		}
+
+		record := &govultr.DomainRecord{ID: s.newID(), Type: req.Type, Name: req.Name, Data: req.Data, TTL: req.TTL}
+		if record.TTL == 0 {
+			record.TTL = defaultTTL
+		}
+		if req.Priority != nil {
+			record.Priority = *req.Priority
+		}
+
+		records := s.domainRecords[r.PathValue("domain")]
+		records.add(record.ID, record)
+		records.writeItem(w, http.StatusCreated, record)
+	})
+	s.handle(mux, "GET /v2/domains/{domain}/records", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.domain(w, r); ok {
+			records := s.domainRecords[r.PathValue("domain")]
+			records.writeList(w, r, records.all(nil))
+		}
+	})
+	s.handle(mux, "GET /v2/domains/{domain}/records/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if record, ok := s.domainRecord(w, r); ok {
+			s.domainRecords[r.PathValue("domain")].writeItem(w, http.StatusOK, record)
+		}
+	})
+	s.handle(mux, "PATCH /v2/domains/{domain}/records/{id}", func(w http.ResponseWriter, r *http.Request) {
+		record, ok := s.domainRecord(w, r)
+		if !ok {
+			return
+		}
+
+		req := new(govultr.DomainRecordReq)
+		if !decode(w, r, req) {
+			return
+		}
+
+		record.Name = req.Name
+		if req.Data != "" {
+			record.Data = req.Data
+		}
+		if req.TTL != 0 {
+			record.TTL = req.TTL
+		}
+		if req.Priority != nil {
+			record.Priority = *req.Priority
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle(mux, "DELETE /v2/domains/{domain}/records/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.domain(w, r); !ok {
+			return
+		}
+
+		if !s.domainRecords[r.PathValue("domain")].remove(r.PathValue("id")) {
+			writeNotFound(w, "record")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) domain(w http.ResponseWriter, r *http.Request) (*govultr.Domain, bool) {
+	domain, ok := s.domains.get(r.PathValue("domain"))
+	if !ok {
+		writeNotFound(w, "domain")
+	}
+	return domain, ok
+}
+
+func (s *Server) domainRecord(w http.ResponseWriter, r *http.Request) (*govultr.DomainRecord, bool) {
+	if _, ok := s.domain(w, r); !ok {
+		return nil, false
+	}
+
+	record, ok := s.domainRecords[r.PathValue("domain")].get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "record")
+	}
+	return record, ok
+}
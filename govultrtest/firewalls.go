@@ -0,0 +1,150 @@
+package govultrtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/vultr/govultr/v3"
+)
+
+const maxFirewallRules = 50
+
+func (s *Server) registerFirewalls(mux *http.ServeMux) {
+	s.handle(mux, "POST /v2/firewalls", func(w http.ResponseWriter, r *http.Request) {
+		req := new(govultr.FirewallGroupReq)
+		if !decode(w, r, req) {
+			return
+		}
+
+		group := &govultr.FirewallGroup{
+			ID:           s.newID(),
+			Description:  req.Description,
+			DateCreated:  now(),
+			DateModified: now(),
+			MaxRuleCount: maxFirewallRules,
+		}
+		s.firewallGroups.add(group.ID, group)
+		s.firewallRules[group.ID] = newCollection[govultr.FirewallRule]("firewall_rule", "firewall_rules")
+		s.firewallGroups.writeItem(w, http.StatusCreated, group)
+	})
+	s.handle(mux, "GET /v2/firewalls", func(w http.ResponseWriter, r *http.Request) {
+		s.firewallGroups.writeList(w, r, s.firewallGroups.all(nil))
+	})
+	s.handle(mux, "GET /v2/firewalls/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if group, ok := s.firewallGroup(w, r); ok {
+			s.firewallGroups.writeItem(w, http.StatusOK, group)
+		}
+	})
+	s.handle(mux, "PUT /v2/firewalls/{id}", func(w http.ResponseWriter, r *http.Request) {
+		group, ok := s.firewallGroup(w, r)
+		if !ok {
+			return
+		}
+
+		req := new(govultr.FirewallGroupReq)
+		if !decode(w, r, req) {
+			return
+		}
+		group.Description = req.Description
+		group.DateModified = now()
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle(mux, "DELETE /v2/firewalls/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if !s.firewallGroups.remove(id) {
+			writeNotFound(w, "firewall-group-id")
+			return
+		}
+		delete(s.firewallRules, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	s.handle(mux, "POST /v2/firewalls/{id}/rules", s.createFirewallRule)
+	s.handle(mux, "GET /v2/firewalls/{id}/rules", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.firewallGroup(w, r); ok {
+			rules := s.firewallRules[r.PathValue("id")]
+			rules.writeList(w, r, rules.all(nil))
+		}
+	})
+	s.handle(mux, "GET /v2/firewalls/{id}/rules/{rule}", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.firewallGroup(w, r); !ok {
+			return
+		}
+
+		rules := s.firewallRules[r.PathValue("id")]
+		rule, ok := rules.get(r.PathValue("rule"))
+		if !ok {
+			writeNotFound(w, "firewall-rule-id")
+			return
+		}
+		rules.writeItem(w, http.StatusOK, rule)
+	})
+	s.handle(mux, "DELETE /v2/firewalls/{id}/rules/{rule}", func(w http.ResponseWriter, r *http.Request) {
+		group, ok := s.firewallGroup(w, r)
+		if !ok {
+			return
+		}
+
+		if !s.firewallRules[group.ID].remove(r.PathValue("rule")) {
+			writeNotFound(w, "firewall-rule-id")
+			return
+		}
+		group.RuleCount--
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) firewallGroup(w http.ResponseWriter, r *http.Request) (*govultr.FirewallGroup, bool) {
+	group, ok := s.firewallGroups.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "firewall-group-id")
+	}
+	return group, ok
+}
+
+func (s *Server) createFirewallRule(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.firewallGroup(w, r)
+	if !ok {
+		return
+	}
+
+	req := new(govultr.FirewallRuleReq)
+	if !decode(w, r, req) || !require(w, "ip_type", string(req.IPType), "protocol", string(req.Protocol)) {
+		return
+	}
+
+	if !req.IPType.IsValid() || !req.Protocol.IsValid() {
+		writeError(w, http.StatusBadRequest, "Invalid ip_type or protocol")
+		return
+	}
+
+	if req.Source == "" && req.Subnet == "" {
+		writeError(w, http.StatusBadRequest, "Missing required field: subnet or source")
+		return
+	}
+
+	if group.RuleCount >= group.MaxRuleCount {
+		writeError(w, http.StatusBadRequest, "Firewall group has reached its maximum number of rules.")
+		return
+	}
+
+	s.lastID++
+	rule := &govultr.FirewallRule{
+		ID:         s.lastID,
+		Action:     govultr.FirewallActionAccept,
+		Type:       string(req.IPType),
+		IPType:     req.IPType,
+		Protocol:   req.Protocol,
+		Port:       req.Port,
+		Subnet:     req.Subnet,
+		SubnetSize: req.SubnetSize,
+		Source:     req.Source,
+		Notes:      req.Notes,
+	}
+
+	rules := s.firewallRules[group.ID]
+	rules.add(strconv.Itoa(rule.ID), rule)
+	group.RuleCount++
+	group.DateModified = now()
+	rules.writeItem(w, http.StatusCreated, rule)
+}
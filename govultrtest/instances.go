@@ -0,0 +1,148 @@
+package govultrtest
+
+import (
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (s *Server) registerInstances(mux *http.ServeMux) {
+	s.handle(mux, "POST /v2/instances", s.createInstance)
+	s.handle(mux, "GET /v2/instances", func(w http.ResponseWriter, r *http.Request) {
+		s.instances.writeList(w, r, s.instances.all(nil))
+	})
+	s.handle(mux, "GET /v2/instances/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if instance, ok := s.instance(w, r); ok {
+			s.instances.writeItem(w, http.StatusOK, instance)
+		}
+	})
+	s.handle(mux, "PATCH /v2/instances/{id}", s.updateInstance)
+	s.handle(mux, "DELETE /v2/instances/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if !s.instances.remove(id) {
+			writeNotFound(w, "instance-id")
+			return
+		}
+
+		for _, block := range s.blocks.items {
+			if block.AttachedToInstance == id {
+				block.AttachedToInstance = ""
+			}
+		}
+		for _, rip := range s.reservedIPs.items {
+			if rip.InstanceID == id {
+				rip.InstanceID = ""
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	for action, power := range map[string]govultr.PowerStatus{
+		"start":  govultr.PowerStatusRunning,
+		"halt":   govultr.PowerStatusStopped,
+		"reboot": govultr.PowerStatusRunning,
+	} {
+		s.handle(mux, "POST /v2/instances/{id}/"+action, func(w http.ResponseWriter, r *http.Request) {
+			if instance, ok := s.instance(w, r); ok {
+				instance.PowerStatus = power
+				w.WriteHeader(http.StatusNoContent)
+			}
+		})
+
+		s.handle(mux, "POST /v2/instances/"+action, func(w http.ResponseWriter, r *http.Request) {
+			body := struct {
+				InstanceIDs []string `json:"instance_ids"`
+			}{}
+			if !decode(w, r, &body) {
+				return
+			}
+			for _, id := range body.InstanceIDs {
+				if instance, ok := s.instances.get(id); ok {
+					instance.PowerStatus = power
+				}
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+}
+
+// instance returns the instance of the {id} path parameter, answering 404 when it does not exist
+func (s *Server) instance(w http.ResponseWriter, r *http.Request) (*govultr.Instance, bool) {
+	instance, ok := s.instances.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "instance-id")
+	}
+	return instance, ok
+}
+
+func (s *Server) createInstance(w http.ResponseWriter, r *http.Request) {
+	req := new(govultr.InstanceCreateReq)
+	if !decode(w, r, req) || !require(w, "region", req.Region, "plan", req.Plan) {
+		return
+	}
+
+	if req.OsID == 0 && req.AppID == 0 && req.ImageID == "" && req.ISOID == "" && req.SnapshotID == "" {
+		writeError(w, http.StatusBadRequest, "Missing required field: os_id, app_id, image_id, iso_id or snapshot_id")
+		return
+	}
+
+	if req.SnapshotID != "" {
+		if _, ok := s.snapshots.get(req.SnapshotID); !ok {
+			writeNotFound(w, "snapshot_id")
+			return
+		}
+	}
+
+	instance := &govultr.Instance{
+		ID:              s.newID(),
+		Region:          req.Region,
+		Plan:            req.Plan,
+		Label:           req.Label,
+		Hostname:        req.Hostname,
+		Tags:            req.Tags,
+		OsID:            req.OsID,
+		AppID:           req.AppID,
+		ImageID:         req.ImageID,
+		FirewallGroupID: req.FirewallGroupID,
+		MainIP:          s.newIP("192.0.2"),
+		DateCreated:     now(),
+		Status:          "active",
+		PowerStatus:     "running",
+		ServerStatus:    "ok",
+		Features:        []string{},
+	}
+	if instance.Hostname == "" {
+		instance.Hostname = instance.Label
+	}
+	if instance.Tags == nil {
+		instance.Tags = []string{}
+	}
+	s.instances.add(instance.ID, instance)
+
+	// the password is only ever returned by the create call
+	created := *instance
+	created.DefaultPassword = "govultrtest-" + instance.ID[len(instance.ID)-6:]
+	s.instances.writeItem(w, http.StatusAccepted, &created)
+}
+
+func (s *Server) updateInstance(w http.ResponseWriter, r *http.Request) {
+	instance, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+
+	req := new(govultr.InstanceUpdateReq)
+	if !decode(w, r, req) {
+		return
+	}
+
+	update(&instance.Plan, req.Plan)
+	update(&instance.Label, req.Label)
+	update(&instance.Tags, req.Tags)
+	update(&instance.FirewallGroupID, req.FirewallGroupID)
+	if instance.Tags == nil {
+		instance.Tags = []string{}
+	}
+
+	s.instances.writeItem(w, http.StatusAccepted, instance)
+}
@@ -0,0 +1,231 @@
+package govultrtest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+const defaultKubernetesVersion = "v1.30.0+1"
+
+func (s *Server) registerKubernetes(mux *http.ServeMux) {
+	s.handle(mux, "POST /v2/kubernetes/clusters", func(w http.ResponseWriter, r *http.Request) {
+		req := new(govultr.ClusterReq)
+		if !decode(w, r, req) || !require(w, "region", req.Region) {
+			return
+		}
+
+		if len(req.NodePools) == 0 {
+			writeError(w, http.StatusBadRequest, "Missing required field: node_pools")
+			return
+		}
+
+		cluster := &govultr.Cluster{
+			ID:            s.newID(),
+			Label:         req.Label,
+			DateCreated:   now(),
+			ClusterSubnet: "10.244.0.0/16",
+			ServiceSubnet: "10.96.0.0/12",
+			IP:            s.newIP("198.51.100"),
+			Version:       req.Version,
+			Region:        req.Region,
+			Status:        "active",
+		}
+		cluster.Endpoint = cluster.ID + ".vultr-k8s.com"
+		if cluster.Version == "" {
+			cluster.Version = defaultKubernetesVersion
+		}
+
+		for i := range req.NodePools {
+			pool, ok := s.newNodePool(w, &req.NodePools[i])
+			if !ok {
+				return
+			}
+			cluster.NodePools = append(cluster.NodePools, *pool)
+		}
+
+		s.clusters.add(cluster.ID, cluster)
+		s.clusters.writeItem(w, http.StatusCreated, cluster)
+	})
+	s.handle(mux, "GET /v2/kubernetes/clusters", func(w http.ResponseWriter, r *http.Request) {
+		s.clusters.writeList(w, r, s.clusters.all(nil))
+	})
+	s.handle(mux, "GET /v2/kubernetes/clusters/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if cluster, ok := s.cluster(w, r); ok {
+			s.clusters.writeItem(w, http.StatusOK, cluster)
+		}
+	})
+	s.handle(mux, "PUT /v2/kubernetes/clusters/{id}", func(w http.ResponseWriter, r *http.Request) {
+		cluster, ok := s.cluster(w, r)
+		if !ok {
+			return
+		}
+
+		req := new(govultr.ClusterReqUpdate)
+		if !decode(w, r, req) {
+			return
+		}
+		update(&cluster.Label, req.Label)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	deleteCluster := func(w http.ResponseWriter, r *http.Request) {
+		if !s.clusters.remove(r.PathValue("id")) {
+			writeNotFound(w, "vke-id")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+	s.handle(mux, "DELETE /v2/kubernetes/clusters/{id}", deleteCluster)
+	s.handle(mux, "DELETE /v2/kubernetes/clusters/{id}/delete-with-linked-resources", deleteCluster)
+
+	s.handle(mux, "GET /v2/kubernetes/clusters/{id}/config", func(w http.ResponseWriter, r *http.Request) {
+		cluster, ok := s.cluster(w, r)
+		if !ok {
+			return
+		}
+
+		config := fmt.Sprintf("apiVersion: v1\nkind: Config\nclusters:\n- cluster:\n    server: https://%s:6443\n  name: vke-%s\n", cluster.Endpoint, cluster.ID)
+		writeJSON(w, http.StatusOK, govultr.KubeConfig{KubeConfig: base64.StdEncoding.EncodeToString([]byte(config))})
+	})
+
+	nodePools := newCollection[govultr.NodePool]("node_pool", "node_pools")
+	s.handle(mux, "POST /v2/kubernetes/clusters/{id}/node-pools", func(w http.ResponseWriter, r *http.Request) {
+		cluster, ok := s.cluster(w, r)
+		if !ok {
+			return
+		}
+
+		req := new(govultr.NodePoolReq)
+		if !decode(w, r, req) {
+			return
+		}
+
+		pool, ok := s.newNodePool(w, req)
+		if !ok {
+			return
+		}
+		cluster.NodePools = append(cluster.NodePools, *pool)
+		nodePools.writeItem(w, http.StatusCreated, pool)
+	})
+	s.handle(mux, "GET /v2/kubernetes/clusters/{id}/node-pools", func(w http.ResponseWriter, r *http.Request) {
+		if cluster, ok := s.cluster(w, r); ok {
+			nodePools.writeList(w, r, cluster.NodePools)
+		}
+	})
+	s.handle(mux, "GET /v2/kubernetes/clusters/{id}/node-pools/{pool}", func(w http.ResponseWriter, r *http.Request) {
+		if _, pool, ok := s.nodePool(w, r); ok {
+			nodePools.writeItem(w, http.StatusOK, pool)
+		}
+	})
+	s.handle(mux, "PATCH /v2/kubernetes/clusters/{id}/node-pools/{pool}", func(w http.ResponseWriter, r *http.Request) {
+		_, pool, ok := s.nodePool(w, r)
+		if !ok {
+			return
+		}
+
+		req := new(govultr.NodePoolReqUpdate)
+		if !decode(w, r, req) {
+			return
+		}
+
+		if quantity, ok := req.NodeQuantity.Get(); ok {
+			pool.NodeQuantity = quantity
+			s.scaleNodePool(pool)
+		}
+		update(&pool.Tag, req.Tag)
+		update(&pool.MinNodes, req.MinNodes)
+		update(&pool.MaxNodes, req.MaxNodes)
+		update(&pool.AutoScaler, req.AutoScaler)
+		pool.DateUpdated = now()
+		nodePools.writeItem(w, http.StatusAccepted, pool)
+	})
+	s.handle(mux, "DELETE /v2/kubernetes/clusters/{id}/node-pools/{pool}", func(w http.ResponseWriter, r *http.Request) {
+		cluster, pool, ok := s.nodePool(w, r)
+		if !ok {
+			return
+		}
+
+		for i := range cluster.NodePools {
+			if &cluster.NodePools[i] == pool {
+				cluster.NodePools = append(cluster.NodePools[:i], cluster.NodePools[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) cluster(w http.ResponseWriter, r *http.Request) (*govultr.Cluster, bool) {
+	cluster, ok := s.clusters.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "vke-id")
+	}
+	return cluster, ok
+}
+
+func (s *Server) nodePool(w http.ResponseWriter, r *http.Request) (*govultr.Cluster, *govultr.NodePool, bool) {
+	cluster, ok := s.cluster(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+
+	for i := range cluster.NodePools {
+		if cluster.NodePools[i].ID == r.PathValue("pool") {
+			return cluster, &cluster.NodePools[i], true
+		}
+	}
+
+	writeNotFound(w, "nodepool-id")
+	return nil, nil, false
+}
+
+// newNodePool builds a node pool and its nodes, answering 400 when req is invalid. s.mu must be held.
+func (s *Server) newNodePool(w http.ResponseWriter, req *govultr.NodePoolReq) (*govultr.NodePool, bool) {
+	if !require(w, "label", req.Label, "plan", req.Plan) {
+		return nil, false
+	}
+
+	if req.NodeQuantity < 1 {
+		writeError(w, http.StatusBadRequest, "Missing required field: node_quantity")
+		return nil, false
+	}
+
+	pool := &govultr.NodePool{
+		ID:           s.newID(),
+		DateCreated:  now(),
+		DateUpdated:  now(),
+		Label:        req.Label,
+		Plan:         req.Plan,
+		Status:       "active",
+		NodeQuantity: req.NodeQuantity,
+		MinNodes:     req.MinNodes,
+		MaxNodes:     req.MaxNodes,
+		Tag:          req.Tag,
+	}
+	if req.AutoScaler != nil {
+		pool.AutoScaler = *req.AutoScaler
+	}
+
+	s.scaleNodePool(pool)
+	return pool, true
+}
+
+// scaleNodePool adds or removes nodes to match the node quantity of pool. s.mu must be held.
+func (s *Server) scaleNodePool(pool *govultr.NodePool) {
+	if len(pool.Nodes) > pool.NodeQuantity {
+		pool.Nodes = pool.Nodes[:pool.NodeQuantity]
+	}
+
+	for len(pool.Nodes) < pool.NodeQuantity {
+		id := s.newID()
+		pool.Nodes = append(pool.Nodes, govultr.Node{
+			ID:          id,
+			DateCreated: now(),
+			Label:       pool.Label + "-" + id[len(id)-6:],
+			Status:      "active",
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package govultrtest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (s *Server) registerReservedIPs(mux *http.ServeMux) {
+	s.handle(mux, "POST /v2/reserved-ips", func(w http.ResponseWriter, r *http.Request) {
+		req := new(govultr.ReservedIPReq)
+		if !decode(w, r, req) || !require(w, "region", req.Region, "ip_type", string(req.IPType)) {
+			return
+		}
+
+		rip := &govultr.ReservedIP{ID: s.newID(), Region: req.Region, IPType: req.IPType, Label: req.Label}
+		switch req.IPType {
+		case "v4":
+			rip.Subnet, rip.SubnetSize = s.newIP("203.0.113"), 32
+		case "v6":
+			s.lastID++
+			rip.Subnet, rip.SubnetSize = fmt.Sprintf("2001:db8:%x::", s.lastID), 64
+		default:
+			writeError(w, http.StatusBadRequest, "Invalid ip_type, expected v4 or v6.")
+			return
+		}
+
+		s.reservedIPs.add(rip.ID, rip)
+		s.reservedIPs.writeItem(w, http.StatusCreated, rip)
+	})
+	s.handle(mux, "GET /v2/reserved-ips", func(w http.ResponseWriter, r *http.Request) {
+		s.reservedIPs.writeList(w, r, s.reservedIPs.all(nil))
+	})
+	s.handle(mux, "GET /v2/reserved-ips/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if rip, ok := s.reservedIP(w, r); ok {
+			s.reservedIPs.writeItem(w, http.StatusOK, rip)
+		}
+	})
+	s.handle(mux, "PATCH /v2/reserved-ips/{id}", func(w http.ResponseWriter, r *http.Request) {
+		rip, ok := s.reservedIP(w, r)
+		if !ok {
+			return
+		}
+
+		req := new(govultr.ReservedIPUpdateReq)
+		if !decode(w, r, req) {
+			return
+		}
+		update(&rip.Label, req.Label)
+		s.reservedIPs.writeItem(w, http.StatusAccepted, rip)
+	})
+	s.handle(mux, "DELETE /v2/reserved-ips/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.reservedIPs.remove(r.PathValue("id")) {
+			writeNotFound(w, "reserved-ip")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle(mux, "POST /v2/reserved-ips/{id}/attach", func(w http.ResponseWriter, r *http.Request) {
+		rip, ok := s.reservedIP(w, r)
+		if !ok {
+			return
+		}
+
+		req := new(govultr.ReservedIPReq)
+		if !decode(w, r, req) || !require(w, "instance_id", req.InstanceID) {
+			return
+		}
+
+		if _, ok := s.instances.get(req.InstanceID); !ok {
+			writeNotFound(w, "instance_id")
+			return
+		}
+		rip.InstanceID = req.InstanceID
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle(mux, "POST /v2/reserved-ips/{id}/detach", func(w http.ResponseWriter, r *http.Request) {
+		if rip, ok := s.reservedIP(w, r); ok {
+			rip.InstanceID = ""
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+}
+
+func (s *Server) reservedIP(w http.ResponseWriter, r *http.Request) (*govultr.ReservedIP, bool) {
+	rip, ok := s.reservedIPs.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "reserved-ip")
+	}
+	return rip, ok
+}
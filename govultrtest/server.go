@@ -0,0 +1,237 @@
+// Package govultrtest provides an in-memory fake of the Vultr API for tests.
+//
+// The fake keeps state: creates assign IDs, Get and List reflect them, List follows the page
+// cursors and deleted resources answer 404. It covers instances, VPCs, firewall groups and
+// rules, domains and records, block storage, reserved IPs, snapshots, Kubernetes clusters and
+// managed databases.
+//
+//	server := govultrtest.NewServer()
+//	defer server.Close()
+//
+//	client := server.Client()
+//	instance, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 387})
+package govultrtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// Server is a fake Vultr API listening on a local httptest.Server
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	lastID   int
+	requests int
+
+	latency     time.Duration
+	rateLimited int
+	failures    int
+
+	instances      *collection[govultr.Instance]
+	vpcs           *collection[govultr.VPC]
+	firewallGroups *collection[govultr.FirewallGroup]
+	firewallRules  map[string]*collection[govultr.FirewallRule]
+	domains        *collection[govultr.Domain]
+	domainRecords  map[string]*collection[govultr.DomainRecord]
+	blocks         *collection[govultr.BlockStorage]
+	reservedIPs    *collection[govultr.ReservedIP]
+	snapshots      *collection[govultr.Snapshot]
+	clusters       *collection[govultr.Cluster]
+	databases      *collection[govultr.Database]
+}
+
+// NewServer starts a fake Vultr API with no resources. Close it once done.
+func NewServer() *Server {
+	s := &Server{
+		instances:      newCollection[govultr.Instance]("instance", "instances"),
+		vpcs:           newCollection[govultr.VPC]("vpc", "vpcs"),
+		firewallGroups: newCollection[govultr.FirewallGroup]("firewall_group", "firewall_groups"),
+		firewallRules:  map[string]*collection[govultr.FirewallRule]{},
+		domains:        newCollection[govultr.Domain]("domain", "domains"),
+		domainRecords:  map[string]*collection[govultr.DomainRecord]{},
+		blocks:         newCollection[govultr.BlockStorage]("block", "blocks"),
+		reservedIPs:    newCollection[govultr.ReservedIP]("reserved_ip", "reserved_ips"),
+		snapshots:      newCollection[govultr.Snapshot]("snapshot", "snapshots"),
+		clusters:       newCollection[govultr.Cluster]("vke_cluster", "vke_clusters"),
+		databases:      newCollection[govultr.Database]("database", "databases"),
+	}
+
+	mux := http.NewServeMux()
+	s.registerInstances(mux)
+	s.registerVPCs(mux)
+	s.registerFirewalls(mux)
+	s.registerDomains(mux)
+	s.registerBlocks(mux)
+	s.registerReservedIPs(mux)
+	s.registerSnapshots(mux)
+	s.registerKubernetes(mux)
+	s.registerDatabases(mux)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s is not supported by the fake Vultr API", r.Method, r.URL.Path))
+	})
+
+	s.Server = httptest.NewServer(s.faults(mux))
+	return s
+}
+
+// Client returns a client for the fake API. Retries wait a millisecond so that faults are
+// retried quickly, opts are applied last.
+func (s *Server) Client(opts ...govultr.Option) *govultr.Client {
+	opts = append([]govultr.Option{
+		govultr.WithBaseURL(s.URL),
+		govultr.WithAPIKey("govultrtest"),
+		govultr.WithRetryWait(time.Millisecond, time.Millisecond),
+		govultr.WithRateLimiter(nil),
+	}, opts...)
+
+	client, err := govultr.New(opts...)
+	if err != nil {
+		panic(fmt.Sprintf("govultrtest: %v", err))
+	}
+	return client
+}
+
+// SetLatency delays every response by d
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// RateLimitNext answers the next n requests with a 429
+func (s *Server) RateLimitNext(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimited = n
+}
+
+// FailNext answers the next n requests with a 500
+func (s *Server) FailNext(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = n
+}
+
+// Requests returns the number of requests received, faults included
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// faults applies the latency and injected errors before handing the request to next
+func (s *Server) faults(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests++
+		latency := s.latency
+
+		status := 0
+		switch {
+		case s.rateLimited > 0:
+			s.rateLimited--
+			status = http.StatusTooManyRequests
+		case s.failures > 0:
+			s.failures--
+			status = http.StatusInternalServerError
+		}
+		s.mu.Unlock()
+
+		if latency > 0 {
+			select {
+			case <-time.After(latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		switch status {
+		case http.StatusTooManyRequests:
+			w.Header().Set("Retry-After", "0")
+			writeError(w, status, "Rate limit exceeded")
+		case http.StatusInternalServerError:
+			writeError(w, status, "Internal server error")
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// newID returns a unique, UUID formatted, resource ID. s.mu must be held.
+func (s *Server) newID() string {
+	s.lastID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012x", s.lastID)
+}
+
+// newIP returns a unique IPv4 address in a documentation range. s.mu must be held.
+func (s *Server) newIP(prefix string) string {
+	s.lastID++
+	return fmt.Sprintf("%s.%d", prefix, s.lastID%254+1)
+}
+
+func now() govultr.Timestamp {
+	return govultr.NewTimestamp(time.Now())
+}
+
+// handle registers a handler that runs with the state of the server locked
+func (s *Server) handle(mux *http.ServeMux, pattern string, handler http.HandlerFunc) {
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		handler(w, r)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{"error": message, "status": status})
+}
+
+func writeNotFound(w http.ResponseWriter, kind string) {
+	writeError(w, http.StatusNotFound, fmt.Sprintf("Invalid %s.", kind))
+}
+
+// decode reads the JSON body of r into v, answering 400 when it is invalid
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid JSON body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// update applies an optional field of an update request: set values are stored, null ones clear
+// the field and unset ones leave it unchanged
+func update[T any](field *T, value govultr.Optional[T]) {
+	switch {
+	case value.IsSet():
+		*field, _ = value.Get()
+	case value.IsNull():
+		var zero T
+		*field = zero
+	}
+}
+
+// require answers 400 when one of the required fields is empty. fields alternates names and values.
+func require(w http.ResponseWriter, fields ...string) bool {
+	for i := 0; i+1 < len(fields); i += 2 {
+		if fields[i+1] == "" {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Missing required field: %s", fields[i]))
+			return false
+		}
+	}
+	return true
+}
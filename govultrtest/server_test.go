@@ -0,0 +1,343 @@
+package govultrtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vultr/govultr/v3"
+)
+
+var ctx = context.Background()
+
+func TestServer_InstanceLifecycle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	created, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 387, Label: "web", Tags: []string{"a"}})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+	if created.ID == "" || created.DefaultPassword == "" || created.Status != "active" {
+		t.Errorf("Instance.Create returned %+v", created)
+	}
+
+	got, _, err := client.Instance.Get(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Instance.Get returned %+v", err)
+	}
+	if got.Label != "web" || got.Region != "ewr" || got.DefaultPassword != "" {
+		t.Errorf("Instance.Get returned %+v", got)
+	}
+
+	updated, _, err := client.Instance.Update(ctx, created.ID, &govultr.InstanceUpdateReq{Label: govultr.NewOptional("api")})
+	if err != nil {
+		t.Fatalf("Instance.Update returned %+v", err)
+	}
+	if updated.Label != "api" {
+		t.Errorf("Instance.Update label = %v, expected %v", updated.Label, "api")
+	}
+
+	if _, err := client.Instance.Halt(ctx, created.ID); err != nil {
+		t.Fatalf("Instance.Halt returned %+v", err)
+	}
+	if got, _, _ = client.Instance.Get(ctx, created.ID); got.PowerStatus != "stopped" {
+		t.Errorf("Instance.Halt power status = %v, expected %v", got.PowerStatus, "stopped")
+	}
+
+	if _, err := client.Instance.Delete(ctx, created.ID); err != nil {
+		t.Fatalf("Instance.Delete returned %+v", err)
+	}
+
+	if _, _, err := client.Instance.Get(ctx, created.ID); !govultr.IsNotFound(err) {
+		t.Errorf("Instance.Get after delete returned %+v, expected a not found error", err)
+	}
+
+	if _, err := client.Instance.Delete(ctx, created.ID); !govultr.IsNotFound(err) {
+		t.Errorf("Instance.Delete after delete returned %+v, expected a not found error", err)
+	}
+}
+
+func TestServer_Validation(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	// the server checks requests as the API does, even those the client does not validate
+	if _, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", SnapshotID: "missing"}); !govultr.IsNotFound(err) {
+		t.Errorf("Instance.Create from a missing snapshot returned %+v, expected a not found error", err)
+	}
+
+	var validationErr *govultr.ValidationError
+	if _, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{Plan: "vc2-1c-1gb", OsID: 387}); !errors.As(err, &validationErr) {
+		t.Errorf("Instance.Create without a region returned %+v, expected a validation error", err)
+	}
+	if server.Requests() != 1 {
+		t.Errorf("the server received %d requests, expected the invalid one not to be sent", server.Requests())
+	}
+
+	if _, _, err := client.Snapshot.Create(ctx, &govultr.SnapshotReq{InstanceID: "missing"}); !govultr.IsNotFound(err) {
+		t.Errorf("Snapshot.Create of a missing instance returned %+v, expected a not found error", err)
+	}
+
+	if _, _, err := client.Account.Get(ctx); !govultr.IsNotFound(err) {
+		t.Errorf("unsupported endpoint returned %+v, expected a not found error", err)
+	}
+}
+
+func TestServer_Pagination(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	for i := 0; i < 5; i++ {
+		if _, _, err := client.VPC.Create(ctx, &govultr.VPCReq{Region: "ewr"}); err != nil {
+			t.Fatalf("VPC.Create returned %+v", err)
+		}
+	}
+
+	page, meta, _, err := client.VPC.List(ctx, &govultr.ListOptions{PerPage: 2})
+	if err != nil {
+		t.Fatalf("VPC.List returned %+v", err)
+	}
+	if len(page) != 2 || meta.Total != 5 || meta.Links.Next == "" || meta.Links.Prev != "" {
+		t.Errorf("VPC.List returned %d items and %+v", len(page), meta.Links)
+	}
+
+	next, meta, _, err := client.VPC.List(ctx, &govultr.ListOptions{PerPage: 2, Cursor: meta.Links.Next})
+	if err != nil {
+		t.Fatalf("VPC.List returned %+v", err)
+	}
+	if len(next) != 2 || next[0].ID == page[0].ID || meta.Links.Prev == "" {
+		t.Errorf("VPC.List second page returned %+v", next)
+	}
+
+	all, err := govultr.ListAll(ctx, client.VPC.List, &govultr.ListOptions{PerPage: 2})
+	if err != nil {
+		t.Fatalf("ListAll returned %+v", err)
+	}
+	if len(all) != 5 {
+		t.Errorf("ListAll returned %d items, expected 5", len(all))
+	}
+
+	if _, err := client.VPC.Delete(ctx, all[0].ID); err != nil {
+		t.Fatalf("VPC.Delete returned %+v", err)
+	}
+	if all, _ = govultr.ListAll(ctx, client.VPC.List, nil); len(all) != 4 {
+		t.Errorf("ListAll after delete returned %d items, expected 4", len(all))
+	}
+}
+
+func TestServer_FirewallRules(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	group, _, err := client.FirewallGroup.Create(ctx, &govultr.FirewallGroupReq{Description: "web"})
+	if err != nil {
+		t.Fatalf("FirewallGroup.Create returned %+v", err)
+	}
+
+	rule, _, err := client.FirewallRule.Create(ctx, group.ID, &govultr.FirewallRuleReq{IPType: "v4", Protocol: "tcp", Port: "443", Subnet: "0.0.0.0", SubnetSize: 0})
+	if err != nil {
+		t.Fatalf("FirewallRule.Create returned %+v", err)
+	}
+
+	if got, _, err := client.FirewallRule.Get(ctx, group.ID, rule.ID); err != nil || got.Port != "443" {
+		t.Errorf("FirewallRule.Get returned %+v, %+v", got, err)
+	}
+
+	if group, _, _ = client.FirewallGroup.Get(ctx, group.ID); group.RuleCount != 1 {
+		t.Errorf("FirewallGroup rule count = %d, expected 1", group.RuleCount)
+	}
+
+	if _, err := client.FirewallGroup.Delete(ctx, group.ID); err != nil {
+		t.Fatalf("FirewallGroup.Delete returned %+v", err)
+	}
+	if _, _, _, err := client.FirewallRule.List(ctx, group.ID, nil); !govultr.IsNotFound(err) {
+		t.Errorf("FirewallRule.List after delete returned %+v, expected a not found error", err)
+	}
+}
+
+func TestServer_DomainRecords(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	if _, _, err := client.Domain.Create(ctx, &govultr.DomainReq{Domain: "example.com", IP: "192.0.2.1"}); err != nil {
+		t.Fatalf("Domain.Create returned %+v", err)
+	}
+
+	if _, _, err := client.Domain.Create(ctx, &govultr.DomainReq{Domain: "example.com"}); !govultr.IsBadRequest(err) {
+		t.Errorf("Domain.Create of an existing domain returned %+v, expected a bad request error", err)
+	}
+
+	record, _, err := client.DomainRecord.Create(ctx, "example.com", &govultr.DomainRecordReq{Name: "www", Type: "CNAME", Data: "example.com"})
+	if err != nil {
+		t.Fatalf("DomainRecord.Create returned %+v", err)
+	}
+
+	if _, err := client.DomainRecord.Update(ctx, "example.com", record.ID, &govultr.DomainRecordReq{Name: "www", Data: "other.example.com"}); err != nil {
+		t.Fatalf("DomainRecord.Update returned %+v", err)
+	}
+
+	records, err := govultr.ListAll(ctx, govultr.ScopedList(client.DomainRecord.List, "example.com"), nil)
+	if err != nil {
+		t.Fatalf("ListAll returned %+v", err)
+	}
+	if len(records) != 2 || records[1].Data != "other.example.com" {
+		t.Errorf("ListAll returned %+v", records)
+	}
+
+	if _, err := client.Domain.Delete(ctx, "example.com"); err != nil {
+		t.Fatalf("Domain.Delete returned %+v", err)
+	}
+	if _, _, err := client.DomainRecord.Get(ctx, "example.com", record.ID); !govultr.IsNotFound(err) {
+		t.Errorf("DomainRecord.Get after delete returned %+v, expected a not found error", err)
+	}
+}
+
+func TestServer_Attachments(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	instance, _, _ := client.Instance.Create(ctx, &govultr.InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 387})
+
+	block, _, err := client.BlockStorage.Create(ctx, &govultr.BlockStorageCreate{Region: "ewr", SizeGB: 10})
+	if err != nil {
+		t.Fatalf("BlockStorage.Create returned %+v", err)
+	}
+
+	rip, _, err := client.ReservedIP.Create(ctx, &govultr.ReservedIPReq{Region: "ewr", IPType: "v4"})
+	if err != nil {
+		t.Fatalf("ReservedIP.Create returned %+v", err)
+	}
+
+	if _, err := client.BlockStorage.Attach(ctx, block.ID, &govultr.BlockStorageAttach{InstanceID: instance.ID}); err != nil {
+		t.Fatalf("BlockStorage.Attach returned %+v", err)
+	}
+	if _, err := client.ReservedIP.Attach(ctx, rip.ID, instance.ID); err != nil {
+		t.Fatalf("ReservedIP.Attach returned %+v", err)
+	}
+
+	if block, _, _ = client.BlockStorage.Get(ctx, block.ID); block.AttachedToInstance != instance.ID {
+		t.Errorf("BlockStorage attached to %q, expected %q", block.AttachedToInstance, instance.ID)
+	}
+
+	if _, err := client.BlockStorage.Update(ctx, block.ID, &govultr.BlockStorageUpdate{SizeGB: govultr.NewOptional(5)}); !govultr.IsBadRequest(err) {
+		t.Errorf("BlockStorage.Update shrinking returned %+v, expected a bad request error", err)
+	}
+
+	client.Instance.Delete(ctx, instance.ID)
+
+	if block, _, _ = client.BlockStorage.Get(ctx, block.ID); block.AttachedToInstance != "" {
+		t.Errorf("BlockStorage still attached to deleted instance %q", block.AttachedToInstance)
+	}
+	if rip, _, _ = client.ReservedIP.Get(ctx, rip.ID); rip.InstanceID != "" {
+		t.Errorf("ReservedIP still attached to deleted instance %q", rip.InstanceID)
+	}
+}
+
+func TestServer_Kubernetes(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	cluster, _, err := client.Kubernetes.CreateCluster(ctx, &govultr.ClusterReq{
+		Label:     "vke",
+		Region:    "ewr",
+		Version:   "v1.29.1+1",
+		NodePools: []govultr.NodePoolReq{{NodeQuantity: 2, Label: "pool", Plan: "vc2-2c-4gb"}},
+	})
+	if err != nil {
+		t.Fatalf("Kubernetes.CreateCluster returned %+v", err)
+	}
+	if len(cluster.NodePools) != 1 || len(cluster.NodePools[0].Nodes) != 2 {
+		t.Fatalf("Kubernetes.CreateCluster returned %+v", cluster)
+	}
+
+	pool, _, err := client.Kubernetes.UpdateNodePool(ctx, cluster.ID, cluster.NodePools[0].ID, &govultr.NodePoolReqUpdate{NodeQuantity: govultr.NewOptional(3)})
+	if err != nil {
+		t.Fatalf("Kubernetes.UpdateNodePool returned %+v", err)
+	}
+	if len(pool.Nodes) != 3 {
+		t.Errorf("Kubernetes.UpdateNodePool returned %d nodes, expected 3", len(pool.Nodes))
+	}
+
+	if _, err := client.Kubernetes.DeleteNodePool(ctx, cluster.ID, pool.ID); err != nil {
+		t.Fatalf("Kubernetes.DeleteNodePool returned %+v", err)
+	}
+	if _, _, err := client.Kubernetes.GetNodePool(ctx, cluster.ID, pool.ID); !govultr.IsNotFound(err) {
+		t.Errorf("Kubernetes.GetNodePool after delete returned %+v, expected a not found error", err)
+	}
+
+	if config, _, err := client.Kubernetes.GetKubeConfig(ctx, cluster.ID); err != nil || config.KubeConfig == "" {
+		t.Errorf("Kubernetes.GetKubeConfig returned %+v, %+v", config, err)
+	}
+}
+
+func TestServer_Databases(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	for _, region := range []string{"ewr", "ams"} {
+		_, _, err := client.Database.Create(ctx, &govultr.DatabaseCreateReq{DatabaseEngine: "pg", DatabaseEngineVersion: "15", Region: region, Plan: "vultr-dbaas-startup-cc-1-55-2", Label: "db-" + region})
+		if err != nil {
+			t.Fatalf("Database.Create returned %+v", err)
+		}
+	}
+
+	databases, _, _, err := client.Database.List(ctx, &govultr.DBListOptions{Region: "ams"})
+	if err != nil {
+		t.Fatalf("Database.List returned %+v", err)
+	}
+	if len(databases) != 1 || databases[0].Label != "db-ams" {
+		t.Fatalf("Database.List returned %+v", databases)
+	}
+
+	updated, _, err := client.Database.Update(ctx, databases[0].ID, &govultr.DatabaseUpdateReq{Label: govultr.NewOptional("renamed")})
+	if err != nil || updated.Label != "renamed" || updated.Region != "ams" {
+		t.Errorf("Database.Update returned %+v, %+v", updated, err)
+	}
+}
+
+func TestServer_Faults(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	vpc, _, _ := client.VPC.Create(ctx, &govultr.VPCReq{Region: "ewr"})
+
+	server.FailNext(2)
+	if _, _, err := client.VPC.Get(ctx, vpc.ID); err != nil {
+		t.Errorf("VPC.Get was not retried past the failures: %+v", err)
+	}
+
+	server.RateLimitNext(1)
+	if _, _, err := client.VPC.Get(ctx, vpc.ID); err != nil {
+		t.Errorf("VPC.Get was not retried past the rate limit: %+v", err)
+	}
+
+	server.RateLimitNext(10)
+	noRetry := server.Client(govultr.WithRetryLimit(0))
+	if _, _, err := noRetry.VPC.Get(ctx, vpc.ID); !govultr.IsRateLimited(err) {
+		t.Errorf("VPC.Get returned %+v, expected a rate limited error", err)
+	}
+	server.RateLimitNext(0)
+
+	server.FailNext(1)
+	if _, _, err := client.VPC.Create(ctx, &govultr.VPCReq{Region: "ewr"}); err == nil {
+		t.Error("VPC.Create succeeded, expected the failure not to be retried")
+	}
+
+	server.SetLatency(50 * time.Millisecond)
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, _, err := client.VPC.Get(timeout, vpc.ID); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("VPC.Get returned %+v, expected a deadline exceeded error", err)
+	}
+}
@@ -0,0 +1,68 @@
+package govultrtest
+
+import (
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// snapshotSize is the size in bytes reported for every snapshot
+const snapshotSize = 25 << 30
+
+func (s *Server) registerSnapshots(mux *http.ServeMux) {
+	s.handle(mux, "POST /v2/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		req := new(govultr.SnapshotReq)
+		if !decode(w, r, req) || !require(w, "instance_id", req.InstanceID) {
+			return
+		}
+
+		instance, ok := s.instances.get(req.InstanceID)
+		if !ok {
+			writeNotFound(w, "instance_id")
+			return
+		}
+
+		snapshot := s.newSnapshot(req.Description)
+		snapshot.OsID, snapshot.AppID = instance.OsID, instance.AppID
+		s.snapshots.writeItem(w, http.StatusCreated, snapshot)
+	})
+	s.handle(mux, "POST /v2/snapshots/create-from-url", func(w http.ResponseWriter, r *http.Request) {
+		req := new(govultr.SnapshotURLReq)
+		if !decode(w, r, req) || !require(w, "url", req.URL) {
+			return
+		}
+		s.snapshots.writeItem(w, http.StatusCreated, s.newSnapshot(req.Description))
+	})
+	s.handle(mux, "GET /v2/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		s.snapshots.writeList(w, r, s.snapshots.all(nil))
+	})
+	s.handle(mux, "GET /v2/snapshots/{id}", func(w http.ResponseWriter, r *http.Request) {
+		snapshot, ok := s.snapshots.get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w, "snapshot-id")
+			return
+		}
+		s.snapshots.writeItem(w, http.StatusOK, snapshot)
+	})
+	s.handle(mux, "DELETE /v2/snapshots/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.snapshots.remove(r.PathValue("id")) {
+			writeNotFound(w, "snapshot-id")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// newSnapshot stores a completed snapshot. s.mu must be held.
+func (s *Server) newSnapshot(description string) *govultr.Snapshot {
+	snapshot := &govultr.Snapshot{
+		ID:             s.newID(),
+		DateCreated:    now(),
+		Description:    description,
+		Size:           snapshotSize,
+		CompressedSize: snapshotSize / 4,
+		Status:         "complete",
+	}
+	s.snapshots.add(snapshot.ID, snapshot)
+	return snapshot
+}
@@ -0,0 +1,66 @@
+package govultrtest
+
+import (
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (s *Server) registerVPCs(mux *http.ServeMux) {
+	s.handle(mux, "POST /v2/vpcs", func(w http.ResponseWriter, r *http.Request) {
+		req := new(govultr.VPCReq)
+		if !decode(w, r, req) || !require(w, "region", req.Region) {
+			return
+		}
+
+		vpc := &govultr.VPC{
+			ID:           s.newID(),
+			Region:       req.Region,
+			Description:  req.Description,
+			V4Subnet:     req.V4Subnet,
+			V4SubnetMask: req.V4SubnetMask,
+			DateCreated:  now(),
+		}
+		if vpc.V4Subnet == "" {
+			vpc.V4Subnet, vpc.V4SubnetMask = "10.0.0.0", 24
+		}
+		s.vpcs.add(vpc.ID, vpc)
+		s.vpcs.writeItem(w, http.StatusCreated, vpc)
+	})
+	s.handle(mux, "GET /v2/vpcs", func(w http.ResponseWriter, r *http.Request) {
+		s.vpcs.writeList(w, r, s.vpcs.all(nil))
+	})
+	s.handle(mux, "GET /v2/vpcs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if vpc, ok := s.vpc(w, r); ok {
+			s.vpcs.writeItem(w, http.StatusOK, vpc)
+		}
+	})
+	s.handle(mux, "PUT /v2/vpcs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		vpc, ok := s.vpc(w, r)
+		if !ok {
+			return
+		}
+
+		req := new(govultr.VPCReq)
+		if !decode(w, r, req) {
+			return
+		}
+		vpc.Description = req.Description
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle(mux, "DELETE /v2/vpcs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.vpcs.remove(r.PathValue("id")) {
+			writeNotFound(w, "vpc-id")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) vpc(w http.ResponseWriter, r *http.Request) (*govultr.VPC, bool) {
+	vpc, ok := s.vpcs.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "vpc-id")
+	}
+	return vpc, ok
+}
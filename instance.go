@@ -13,59 +13,59 @@ const instancePath = "/v2/instances"
 // InstanceService is the interface to interact with the instance endpoints on the Vultr API
 // Link: https://www.vultr.com/api/#tag/instances
 type InstanceService interface {
-	Create(ctx context.Context, instanceReq *InstanceCreateReq) (*Instance, *http.Response, error)
-	Get(ctx context.Context, instanceID string) (*Instance, *http.Response, error)
-	Update(ctx context.Context, instanceID string, instanceReq *InstanceUpdateReq) (*Instance, *http.Response, error)
-	Delete(ctx context.Context, instanceID string) error
-	List(ctx context.Context, options *ListOptions) ([]Instance, *Meta, *http.Response, error)
+	Create(ctx context.Context, instanceReq *InstanceCreateReq) (*Instance, *Response, error)
+	Get(ctx context.Context, instanceID string) (*Instance, *Response, error)
+	Update(ctx context.Context, instanceID string, instanceReq *InstanceUpdateReq) (*Instance, *Response, error)
+	Delete(ctx context.Context, instanceID string) (*Response, error)
+	List(ctx context.Context, options *ListOptions) ([]Instance, *Meta, *Response, error)
 
-	Start(ctx context.Context, instanceID string) error
-	Halt(ctx context.Context, instanceID string) error
-	Reboot(ctx context.Context, instanceID string) error
-	Reinstall(ctx context.Context, instanceID string, reinstallReq *ReinstallReq) (*Instance, *http.Response, error)
+	Start(ctx context.Context, instanceID string) (*Response, error)
+	Halt(ctx context.Context, instanceID string) (*Response, error)
+	Reboot(ctx context.Context, instanceID string) (*Response, error)
+	Reinstall(ctx context.Context, instanceID string, reinstallReq *ReinstallReq) (*Instance, *Response, error)
 
-	MassStart(ctx context.Context, instanceList []string) error
-	MassHalt(ctx context.Context, instanceList []string) error
-	MassReboot(ctx context.Context, instanceList []string) error
+	MassStart(ctx context.Context, instanceList []string) (*Response, error)
+	MassHalt(ctx context.Context, instanceList []string) (*Response, error)
+	MassReboot(ctx context.Context, instanceList []string) (*Response, error)
 
-	Restore(ctx context.Context, instanceID string, restoreReq *RestoreReq) (*http.Response, error)
+	Restore(ctx context.Context, instanceID string, restoreReq *RestoreReq) (*Response, error)
 
-	GetBandwidth(ctx context.Context, instanceID string) (*Bandwidth, *http.Response, error)
-	GetNeighbors(ctx context.Context, instanceID string) (*Neighbors, *http.Response, error)
+	GetBandwidth(ctx context.Context, instanceID string) (*Bandwidth, *Response, error)
+	GetNeighbors(ctx context.Context, instanceID string) (*Neighbors, *Response, error)
 
 	// Deprecated: ListPrivateNetworks should no longer be used. Instead, use ListVPCInfo.
-	ListPrivateNetworks(ctx context.Context, instanceID string, options *ListOptions) ([]PrivateNetwork, *Meta, *http.Response, error)
+	ListPrivateNetworks(ctx context.Context, instanceID string, options *ListOptions) ([]PrivateNetwork, *Meta, *Response, error)
 	// Deprecated: AttachPrivateNetwork should no longer be used. Instead, use AttachVPC.
-	AttachPrivateNetwork(ctx context.Context, instanceID, networkID string) error
+	AttachPrivateNetwork(ctx context.Context, instanceID, networkID string) (*Response, error)
 	// Deprecated: DetachPrivateNetwork should no longer be used. Instead, use DetachVPC.
-	DetachPrivateNetwork(ctx context.Context, instanceID, networkID string) error
+	DetachPrivateNetwork(ctx context.Context, instanceID, networkID string) (*Response, error)
 
-	ListVPCInfo(ctx context.Context, instanceID string, options *ListOptions) ([]VPCInfo, *Meta, *http.Response, error)
-	AttachVPC(ctx context.Context, instanceID, vpcID string) error
-	DetachVPC(ctx context.Context, instanceID, vpcID string) error
+	ListVPCInfo(ctx context.Context, instanceID string, options *ListOptions) ([]VPCInfo, *Meta, *Response, error)
+	AttachVPC(ctx context.Context, instanceID, vpcID string) (*Response, error)
+	DetachVPC(ctx context.Context, instanceID, vpcID string) (*Response, error)
 
-	ISOStatus(ctx context.Context, instanceID string) (*Iso, *http.Response, error)
-	AttachISO(ctx context.Context, instanceID, isoID string) (*http.Response, error)
-	DetachISO(ctx context.Context, instanceID string) (*http.Response, error)
+	ISOStatus(ctx context.Context, instanceID string) (*Iso, *Response, error)
+	AttachISO(ctx context.Context, instanceID, isoID string) (*Response, error)
+	DetachISO(ctx context.Context, instanceID string) (*Response, error)
 
-	GetBackupSchedule(ctx context.Context, instanceID string) (*BackupSchedule, *http.Response, error)
-	SetBackupSchedule(ctx context.Context, instanceID string, backup *BackupScheduleReq) (*http.Response, error)
+	GetBackupSchedule(ctx context.Context, instanceID string) (*BackupSchedule, *Response, error)
+	SetBackupSchedule(ctx context.Context, instanceID string, backup *BackupScheduleReq) (*Response, error)
 
-	CreateIPv4(ctx context.Context, instanceID string, reboot *bool) (*IPv4, *http.Response, error)
-	ListIPv4(ctx context.Context, instanceID string, option *ListOptions) ([]IPv4, *Meta, *http.Response, error)
-	DeleteIPv4(ctx context.Context, instanceID, ip string) error
-	ListIPv6(ctx context.Context, instanceID string, option *ListOptions) ([]IPv6, *Meta, *http.Response, error)
+	CreateIPv4(ctx context.Context, instanceID string, reboot *bool) (*IPv4, *Response, error)
+	ListIPv4(ctx context.Context, instanceID string, option *ListOptions) ([]IPv4, *Meta, *Response, error)
+	DeleteIPv4(ctx context.Context, instanceID, ip string) (*Response, error)
+	ListIPv6(ctx context.Context, instanceID string, option *ListOptions) ([]IPv6, *Meta, *Response, error)
 
-	CreateReverseIPv6(ctx context.Context, instanceID string, reverseReq *ReverseIP) error
-	ListReverseIPv6(ctx context.Context, instanceID string) ([]ReverseIP, *http.Response, error)
-	DeleteReverseIPv6(ctx context.Context, instanceID, ip string) error
+	CreateReverseIPv6(ctx context.Context, instanceID string, reverseReq *ReverseIP) (*Response, error)
+	ListReverseIPv6(ctx context.Context, instanceID string) ([]ReverseIP, *Response, error)
+	DeleteReverseIPv6(ctx context.Context, instanceID, ip string) (*Response, error)
 
-	CreateReverseIPv4(ctx context.Context, instanceID string, reverseReq *ReverseIP) error
-	DefaultReverseIPv4(ctx context.Context, instanceID, ip string) error
+	CreateReverseIPv4(ctx context.Context, instanceID string, reverseReq *ReverseIP) (*Response, error)
+	DefaultReverseIPv4(ctx context.Context, instanceID, ip string) (*Response, error)
 
-	GetUserData(ctx context.Context, instanceID string) (*UserData, *http.Response, error)
+	GetUserData(ctx context.Context, instanceID string) (*UserData, *Response, error)
 
-	GetUpgrades(ctx context.Context, instanceID string) (*Upgrades, *http.Response, error)
+	GetUpgrades(ctx context.Context, instanceID string) (*Upgrades, *Response, error)
 }
 
 // InstanceServiceHandler handles interaction with the server methods for the Vultr API
@@ -75,28 +75,28 @@ type InstanceServiceHandler struct {
 
 // Instance represents a VPS
 type Instance struct {
-	ID               string `json:"id"`
-	Os               string `json:"os"`
-	RAM              int    `json:"ram"`
-	Disk             int    `json:"disk"`
-	Plan             string `json:"plan"`
-	MainIP           string `json:"main_ip"`
-	VCPUCount        int    `json:"vcpu_count"`
-	Region           string `json:"region"`
-	DefaultPassword  string `json:"default_password,omitempty"`
-	DateCreated      string `json:"date_created"`
-	Status           string `json:"status"`
-	AllowedBandwidth int    `json:"allowed_bandwidth"`
-	NetmaskV4        string `json:"netmask_v4"`
-	GatewayV4        string `json:"gateway_v4"`
-	PowerStatus      string `json:"power_status"`
-	ServerStatus     string `json:"server_status"`
-	V6Network        string `json:"v6_network"`
-	V6MainIP         string `json:"v6_main_ip"`
-	V6NetworkSize    int    `json:"v6_network_size"`
-	Label            string `json:"label"`
-	InternalIP       string `json:"internal_ip"`
-	KVM              string `json:"kvm"`
+	ID               string         `json:"id"`
+	Os               string         `json:"os"`
+	RAM              int            `json:"ram"`
+	Disk             int            `json:"disk"`
+	Plan             string         `json:"plan"`
+	MainIP           string         `json:"main_ip"`
+	VCPUCount        int            `json:"vcpu_count"`
+	Region           string         `json:"region"`
+	DefaultPassword  string         `json:"default_password,omitempty"`
+	DateCreated      Timestamp      `json:"date_created"`
+	Status           InstanceStatus `json:"status"`
+	AllowedBandwidth int            `json:"allowed_bandwidth"`
+	NetmaskV4        string         `json:"netmask_v4"`
+	GatewayV4        string         `json:"gateway_v4"`
+	PowerStatus      PowerStatus    `json:"power_status"`
+	ServerStatus     ServerStatus   `json:"server_status"`
+	V6Network        string         `json:"v6_network"`
+	V6MainIP         string         `json:"v6_main_ip"`
+	V6NetworkSize    int            `json:"v6_network_size"`
+	Label            string         `json:"label"`
+	InternalIP       string         `json:"internal_ip"`
+	KVM              string         `json:"kvm"`
 	// Deprecated: Tag should no longer be used. Instead, use Tags.
 	Tag             string   `json:"tag"`
 	OsID            int      `json:"os_id"`
@@ -126,12 +126,16 @@ type Neighbors struct {
 	Neighbors []string `json:"neighbors"`
 }
 
-// Bandwidth used on a given instance.
+// Bandwidth used on a given instance or bare metal server, keyed by date (YYYY-MM-DD).
+// NewBandwidthReport turns it into a sorted daily series.
 type Bandwidth struct {
-	Bandwidth map[string]struct {
-		IncomingBytes int `json:"incoming_bytes"`
-		OutgoingBytes int `json:"outgoing_bytes"`
-	} `json:"bandwidth"`
+	Bandwidth map[string]BandwidthUsage `json:"bandwidth"`
+}
+
+// BandwidthUsage is the traffic of an instance or bare metal server over a period
+type BandwidthUsage struct {
+	IncomingBytes int `json:"incoming_bytes"`
+	OutgoingBytes int `json:"outgoing_bytes"`
 }
 
 type privateNetworksBase struct {
@@ -175,20 +179,118 @@ type backupScheduleBase struct {
 
 // BackupSchedule information for a given instance.
 type BackupSchedule struct {
-	Enabled             *bool  `json:"enabled,omitempty"`
-	Type                string `json:"type,omitempty"`
-	NextScheduleTimeUTC string `json:"next_scheduled_time_utc,omitempty"`
-	Hour                int    `json:"hour,omitempty"`
-	Dow                 int    `json:"dow,omitempty"`
-	Dom                 int    `json:"dom,omitempty"`
+	Enabled             *bool              `json:"enabled,omitempty"`
+	Type                BackupScheduleType `json:"type,omitempty"`
+	NextScheduleTimeUTC Timestamp          `json:"next_scheduled_time_utc,omitzero"`
+	Hour                int                `json:"hour,omitempty"`
+	Dow                 int                `json:"dow,omitempty"`
+	Dom                 int                `json:"dom,omitempty"`
 }
 
 // BackupScheduleReq struct used to create a backup schedule for an instance.
 type BackupScheduleReq struct {
-	Type string `json:"type"`
-	Hour *int   `json:"hour,omitempty"`
-	Dow  *int   `json:"dow,omitempty"`
-	Dom  int    `json:"dom,omitempty"`
+	Type BackupScheduleType `json:"type"`
+	Hour *int               `json:"hour,omitempty"`
+	Dow  *int               `json:"dow,omitempty"`
+	Dom  int                `json:"dom,omitempty"`
+}
+
+// Validate checks the type of the schedule and that the hour, day of the week and day of the
+// month are in range
+func (r BackupScheduleReq) Validate() error {
+	v := new(validation)
+	if !r.Type.IsValid() {
+		v.add("type", "%q is not a known backup schedule type", r.Type)
+	}
+	if r.Hour != nil && (*r.Hour < 0 || *r.Hour > 23) {
+		v.add("hour", "%d is outside of 0-23", *r.Hour)
+	}
+	if r.Dow != nil && (*r.Dow < 1 || *r.Dow > 7) {
+		v.add("dow", "%d is outside of 1-7", *r.Dow)
+	}
+	if (r.Type == BackupScheduleMonthly || r.Dom != 0) && (r.Dom < 1 || r.Dom > 28) {
+		v.add("dom", "%d is outside of 1-28", r.Dom)
+	}
+	return v.err()
+}
+
+// InstanceStatus is the subscription status of an instance
+type InstanceStatus string
+
+// Instance statuses
+const (
+	InstanceStatusActive    InstanceStatus = "active"
+	InstanceStatusPending   InstanceStatus = "pending"
+	InstanceStatusSuspended InstanceStatus = "suspended"
+	InstanceStatusResizing  InstanceStatus = "resizing"
+)
+
+// IsValid reports whether s is a known instance status
+func (s InstanceStatus) IsValid() bool {
+	switch s {
+	case InstanceStatusActive, InstanceStatusPending, InstanceStatusSuspended, InstanceStatusResizing:
+		return true
+	}
+	return false
+}
+
+// PowerStatus is the power state of an instance
+type PowerStatus string
+
+// Power statuses
+const (
+	PowerStatusRunning PowerStatus = "running"
+	PowerStatusStopped PowerStatus = "stopped"
+)
+
+// IsValid reports whether s is a known power status
+func (s PowerStatus) IsValid() bool {
+	switch s {
+	case PowerStatusRunning, PowerStatusStopped:
+		return true
+	}
+	return false
+}
+
+// ServerStatus is the health of the server of an instance, ok once it has booted
+type ServerStatus string
+
+// Server statuses
+const (
+	ServerStatusNone              ServerStatus = "none"
+	ServerStatusLocked            ServerStatus = "locked"
+	ServerStatusInstallingBooting ServerStatus = "installingbooting"
+	ServerStatusOK                ServerStatus = "ok"
+)
+
+// IsValid reports whether s is a known server status
+func (s ServerStatus) IsValid() bool {
+	switch s {
+	case ServerStatusNone, ServerStatusLocked, ServerStatusInstallingBooting, ServerStatusOK:
+		return true
+	}
+	return false
+}
+
+// BackupScheduleType is how often the backups of an instance are taken
+type BackupScheduleType string
+
+// Backup schedule types
+const (
+	BackupScheduleDaily        BackupScheduleType = "daily"
+	BackupScheduleWeekly       BackupScheduleType = "weekly"
+	BackupScheduleMonthly      BackupScheduleType = "monthly"
+	BackupScheduleDailyAltEven BackupScheduleType = "daily_alt_even"
+	BackupScheduleDailyAltOdd  BackupScheduleType = "daily_alt_odd"
+)
+
+// IsValid reports whether t is a known backup schedule type
+func (t BackupScheduleType) IsValid() bool {
+	switch t {
+	case BackupScheduleDaily, BackupScheduleWeekly, BackupScheduleMonthly, BackupScheduleDailyAltEven, BackupScheduleDailyAltOdd:
+		return true
+	}
+	return false
 }
 
 // RestoreReq struct used to supply whether a restore should be from a backup or snapshot.
@@ -261,30 +363,55 @@ type InstanceCreateReq struct {
 	ActivationEmail      *bool    `json:"activation_email,omitempty"`
 }
 
+// Validate checks that the region and plan are given, that the instance is created from exactly
+// one of an OS, ISO, application, image or snapshot, that the hostname is valid, and that the
+// user data is base64 encoded and within MaxUserDataSize
+func (r InstanceCreateReq) Validate() error {
+	v := new(validation)
+	v.required("region", r.Region, "plan", r.Plan)
+	v.exactlyOne([]string{"os_id", "iso_id", "app_id", "image_id", "snapshot_id"},
+		r.OsID != 0, r.ISOID != "", r.AppID != 0, r.ImageID != "", r.SnapshotID != "")
+	v.hostname("hostname", r.Hostname)
+	if r.Backups != "" && r.Backups != "enabled" && r.Backups != "disabled" {
+		v.add("backups", "%q is neither enabled nor disabled", r.Backups)
+	}
+	v.userData("user_data", r.UserData)
+	return v.err()
+}
+
 // InstanceUpdateReq struct used to update an instance.
 type InstanceUpdateReq struct {
-	Plan  string `json:"plan,omitempty"`
-	Label string `json:"label,omitempty"`
+	Plan  Optional[string] `json:"plan,omitzero"`
+	Label Optional[string] `json:"label,omitzero"`
 	// Deprecated: Tag should no longer be used. Instead, use Tags.
-	Tag        *string  `json:"tag,omitempty"`
-	Tags       []string `json:"tags"`
-	OsID       int      `json:"os_id,omitempty"`
-	AppID      int      `json:"app_id,omitempty"`
-	ImageID    string   `json:"image_id,omitempty"`
-	EnableIPv6 *bool    `json:"enable_ipv6,omitempty"`
+	Tag        *string            `json:"tag,omitempty"`
+	Tags       Optional[[]string] `json:"tags,omitzero"`
+	OsID       Optional[int]      `json:"os_id,omitzero"`
+	AppID      Optional[int]      `json:"app_id,omitzero"`
+	ImageID    Optional[string]   `json:"image_id,omitzero"`
+	EnableIPv6 Optional[bool]     `json:"enable_ipv6,omitzero"`
 	// Deprecated:  EnablePrivateNetwork should no longer be used. Instead, use EnableVPC.
 	EnablePrivateNetwork *bool `json:"enable_private_network,omitempty"`
 	// Deprecated:  AttachPrivateNetwork should no longer be used. Instead, use AttachVPC.
 	AttachPrivateNetwork []string `json:"attach_private_network,omitempty"`
 	// Deprecated:  DetachPrivateNetwork should no longer be used. Instead, use DetachVPC.
-	DetachPrivateNetwork []string `json:"detach_private_network,omitempty"`
-	EnableVPC            *bool    `json:"enable_vpc,omitempty"`
-	AttachVPC            []string `json:"attach_vpc,omitempty"`
-	DetachVPC            []string `json:"detach_vpc,omitempty"`
-	Backups              string   `json:"backups,omitempty"`
-	DDOSProtection       *bool    `json:"ddos_protection"`
-	UserData             string   `json:"user_data,omitempty"`
-	FirewallGroupID      string   `json:"firewall_group_id,omitempty"`
+	DetachPrivateNetwork []string           `json:"detach_private_network,omitempty"`
+	EnableVPC            Optional[bool]     `json:"enable_vpc,omitzero"`
+	AttachVPC            Optional[[]string] `json:"attach_vpc,omitzero"`
+	DetachVPC            Optional[[]string] `json:"detach_vpc,omitzero"`
+	Backups              Optional[string]   `json:"backups,omitzero"`
+	DDOSProtection       Optional[bool]     `json:"ddos_protection,omitzero"`
+	UserData             Optional[string]   `json:"user_data,omitzero"`
+	FirewallGroupID      Optional[string]   `json:"firewall_group_id,omitzero"`
+}
+
+// validateUpdate checks that the user data, when set, is base64 encoded and within MaxUserDataSize
+func (r InstanceUpdateReq) validateUpdate() error {
+	v := new(validation)
+	if userData, ok := r.UserData.Get(); ok {
+		v.userData("user_data", userData)
+	}
+	return v.err()
 }
 
 // ReinstallReq struct used to allow changes during a reinstall
@@ -292,15 +419,22 @@ type ReinstallReq struct {
 	Hostname string `json:"hostname,omitempty"`
 }
 
+// Validate checks that the hostname, which is optional, follows RFC 1123
+func (r ReinstallReq) Validate() error {
+	v := new(validation)
+	v.hostname("hostname", r.Hostname)
+	return v.err()
+}
+
 // Create will create the server with the given parameters
-func (i *InstanceServiceHandler) Create(ctx context.Context, instanceReq *InstanceCreateReq) (*Instance, *http.Response, error) {
+func (i *InstanceServiceHandler) Create(ctx context.Context, instanceReq *InstanceCreateReq) (*Instance, *Response, error) {
 	req, err := i.client.NewRequest(ctx, http.MethodPost, instancePath, instanceReq)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	instance := new(instanceBase)
-	resp, err := i.client.DoWithContext(ctx, req, instance)
+	resp, err := doCreate(ctx, i.client, req, instance, &instance.Instance, i.createMatch(instanceReq))
 	if err != nil {
 		return nil, resp, err
 	}
@@ -308,8 +442,27 @@ func (i *InstanceServiceHandler) Create(ctx context.Context, instanceReq *Instan
 	return instance.Instance, resp, nil
 }
 
+// createMatch recognizes the instances with the label, region and tags of instanceReq
+func (i *InstanceServiceHandler) createMatch(instanceReq *InstanceCreateReq) *createMatch[Instance] {
+	if instanceReq == nil || (instanceReq.Label == "" && len(instanceReq.Tags) == 0) {
+		return nil
+	}
+
+	return &createMatch[Instance]{
+		list:    i.List,
+		options: &ListOptions{Label: instanceReq.Label, Region: instanceReq.Region},
+		match: func(instance *Instance) bool {
+			return (instanceReq.Label == "" || instance.Label == instanceReq.Label) &&
+				(instanceReq.Region == "" || instance.Region == instanceReq.Region) &&
+				hasTags(instance.Tags, instanceReq.Tags)
+		},
+		id:          func(instance *Instance) string { return instance.ID },
+		dateCreated: func(instance *Instance) Timestamp { return instance.DateCreated },
+	}
+}
+
 // Get will get the server with the given instanceID
-func (i *InstanceServiceHandler) Get(ctx context.Context, instanceID string) (*Instance, *http.Response, error) {
+func (i *InstanceServiceHandler) Get(ctx context.Context, instanceID string) (*Instance, *Response, error) {
 	uri := fmt.Sprintf("%s/%s", instancePath, instanceID)
 
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -327,7 +480,7 @@ func (i *InstanceServiceHandler) Get(ctx context.Context, instanceID string) (*I
 }
 
 // Update will update the server with the given parameters
-func (i *InstanceServiceHandler) Update(ctx context.Context, instanceID string, instanceReq *InstanceUpdateReq) (*Instance, *http.Response, error) { //nolint:lll
+func (i *InstanceServiceHandler) Update(ctx context.Context, instanceID string, instanceReq *InstanceUpdateReq) (*Instance, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s", instancePath, instanceID)
 
 	req, err := i.client.NewRequest(ctx, http.MethodPatch, uri, instanceReq)
@@ -345,20 +498,19 @@ func (i *InstanceServiceHandler) Update(ctx context.Context, instanceID string,
 }
 
 // Delete an instance. All data will be permanently lost, and the IP address will be released
-func (i *InstanceServiceHandler) Delete(ctx context.Context, instanceID string) error {
+func (i *InstanceServiceHandler) Delete(ctx context.Context, instanceID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s", instancePath, instanceID)
 
 	req, err := i.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // List all instances on your account.
-func (i *InstanceServiceHandler) List(ctx context.Context, options *ListOptions) ([]Instance, *Meta, *http.Response, error) { //nolint:dupl
+func (i *InstanceServiceHandler) List(ctx context.Context, options *ListOptions) ([]Instance, *Meta, *Response, error) { //nolint:dupl
 	req, err := i.client.NewRequest(ctx, http.MethodGet, instancePath, nil)
 	if err != nil {
 		return nil, nil, nil, err
@@ -381,43 +533,40 @@ func (i *InstanceServiceHandler) List(ctx context.Context, options *ListOptions)
 }
 
 // Start will start a vps instance the machine is already running, it will be restarted.
-func (i *InstanceServiceHandler) Start(ctx context.Context, instanceID string) error {
+func (i *InstanceServiceHandler) Start(ctx context.Context, instanceID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/start", instancePath, instanceID)
 
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // Halt will pause an instance.
-func (i *InstanceServiceHandler) Halt(ctx context.Context, instanceID string) error {
+func (i *InstanceServiceHandler) Halt(ctx context.Context, instanceID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/halt", instancePath, instanceID)
 
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // Reboot an instance.
-func (i *InstanceServiceHandler) Reboot(ctx context.Context, instanceID string) error {
+func (i *InstanceServiceHandler) Reboot(ctx context.Context, instanceID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/reboot", instancePath, instanceID)
 
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // Reinstall an instance.
-func (i *InstanceServiceHandler) Reinstall(ctx context.Context, instanceID string, reinstallReq *ReinstallReq) (*Instance, *http.Response, error) { //nolint:lll
+func (i *InstanceServiceHandler) Reinstall(ctx context.Context, instanceID string, reinstallReq *ReinstallReq) (*Instance, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/reinstall", instancePath, instanceID)
 
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, reinstallReq)
@@ -434,46 +583,43 @@ func (i *InstanceServiceHandler) Reinstall(ctx context.Context, instanceID strin
 }
 
 // MassStart will start a list of instances the machine is already running, it will be restarted.
-func (i *InstanceServiceHandler) MassStart(ctx context.Context, instanceList []string) error {
+func (i *InstanceServiceHandler) MassStart(ctx context.Context, instanceList []string) (*Response, error) {
 	uri := fmt.Sprintf("%s/start", instancePath)
 
 	reqBody := RequestBody{"instance_ids": instanceList}
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, reqBody)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // MassHalt will pause a list of instances.
-func (i *InstanceServiceHandler) MassHalt(ctx context.Context, instanceList []string) error {
+func (i *InstanceServiceHandler) MassHalt(ctx context.Context, instanceList []string) (*Response, error) {
 	uri := fmt.Sprintf("%s/halt", instancePath)
 
 	reqBody := RequestBody{"instance_ids": instanceList}
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, reqBody)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // MassReboot reboots a list of instances.
-func (i *InstanceServiceHandler) MassReboot(ctx context.Context, instanceList []string) error {
+func (i *InstanceServiceHandler) MassReboot(ctx context.Context, instanceList []string) (*Response, error) {
 	uri := fmt.Sprintf("%s/reboot", instancePath)
 
 	reqBody := RequestBody{"instance_ids": instanceList}
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, reqBody)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // Restore an instance.
-func (i *InstanceServiceHandler) Restore(ctx context.Context, instanceID string, restoreReq *RestoreReq) (*http.Response, error) {
+func (i *InstanceServiceHandler) Restore(ctx context.Context, instanceID string, restoreReq *RestoreReq) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/restore", instancePath, instanceID)
 
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, restoreReq)
@@ -485,7 +631,7 @@ func (i *InstanceServiceHandler) Restore(ctx context.Context, instanceID string,
 }
 
 // GetBandwidth for a given instance.
-func (i *InstanceServiceHandler) GetBandwidth(ctx context.Context, instanceID string) (*Bandwidth, *http.Response, error) {
+func (i *InstanceServiceHandler) GetBandwidth(ctx context.Context, instanceID string) (*Bandwidth, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/bandwidth", instancePath, instanceID)
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -502,7 +648,7 @@ func (i *InstanceServiceHandler) GetBandwidth(ctx context.Context, instanceID st
 }
 
 // GetNeighbors gets a list of other instances in the same location as this Instance.
-func (i *InstanceServiceHandler) GetNeighbors(ctx context.Context, instanceID string) (*Neighbors, *http.Response, error) {
+func (i *InstanceServiceHandler) GetNeighbors(ctx context.Context, instanceID string) (*Neighbors, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/neighbors", instancePath, instanceID)
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -520,7 +666,7 @@ func (i *InstanceServiceHandler) GetNeighbors(ctx context.Context, instanceID st
 
 // ListPrivateNetworks currently attached to an instance.
 // Deprecated: ListPrivateNetworks should no longer be used. Instead, use ListVPCInfo
-func (i *InstanceServiceHandler) ListPrivateNetworks(ctx context.Context, instanceID string, options *ListOptions) ([]PrivateNetwork, *Meta, *http.Response, error) { //nolint:lll,dupl
+func (i *InstanceServiceHandler) ListPrivateNetworks(ctx context.Context, instanceID string, options *ListOptions) ([]PrivateNetwork, *Meta, *Response, error) { //nolint:lll,dupl
 	uri := fmt.Sprintf("%s/%s/private-networks", instancePath, instanceID)
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -545,36 +691,34 @@ func (i *InstanceServiceHandler) ListPrivateNetworks(ctx context.Context, instan
 
 // AttachPrivateNetwork to an instance
 // Deprecated: AttachPrivateNetwork should no longer be used. Instead, use AttachVPC
-func (i *InstanceServiceHandler) AttachPrivateNetwork(ctx context.Context, instanceID, networkID string) error {
+func (i *InstanceServiceHandler) AttachPrivateNetwork(ctx context.Context, instanceID, networkID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/private-networks/attach", instancePath, instanceID)
 	body := RequestBody{"network_id": networkID}
 
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // DetachPrivateNetwork from an instance.
 // Deprecated: DetachPrivateNetwork should no longer be used. Instead, use DetachVPC
-func (i *InstanceServiceHandler) DetachPrivateNetwork(ctx context.Context, instanceID, networkID string) error {
+func (i *InstanceServiceHandler) DetachPrivateNetwork(ctx context.Context, instanceID, networkID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/private-networks/detach", instancePath, instanceID)
 	body := RequestBody{"network_id": networkID}
 
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // ListVPCInfo currently attached to an instance.
-func (i *InstanceServiceHandler) ListVPCInfo(ctx context.Context, instanceID string, options *ListOptions) ([]VPCInfo, *Meta, *http.Response, error) { //nolint:lll,dupl
+func (i *InstanceServiceHandler) ListVPCInfo(ctx context.Context, instanceID string, options *ListOptions) ([]VPCInfo, *Meta, *Response, error) { //nolint:lll,dupl
 	uri := fmt.Sprintf("%s/%s/vpcs", instancePath, instanceID)
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -598,35 +742,33 @@ func (i *InstanceServiceHandler) ListVPCInfo(ctx context.Context, instanceID str
 }
 
 // AttachVPC to an instance
-func (i *InstanceServiceHandler) AttachVPC(ctx context.Context, instanceID, vpcID string) error {
+func (i *InstanceServiceHandler) AttachVPC(ctx context.Context, instanceID, vpcID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/vpcs/attach", instancePath, instanceID)
 	body := RequestBody{"vpc_id": vpcID}
 
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // DetachVPC from an instance.
-func (i *InstanceServiceHandler) DetachVPC(ctx context.Context, instanceID, vpcID string) error {
+func (i *InstanceServiceHandler) DetachVPC(ctx context.Context, instanceID, vpcID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/vpcs/detach", instancePath, instanceID)
 	body := RequestBody{"vpc_id": vpcID}
 
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // ISOStatus retrieves the current ISO state for a given VPS.
 // The returned state may be one of: ready | isomounting | isomounted.
-func (i *InstanceServiceHandler) ISOStatus(ctx context.Context, instanceID string) (*Iso, *http.Response, error) {
+func (i *InstanceServiceHandler) ISOStatus(ctx context.Context, instanceID string) (*Iso, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/iso", instancePath, instanceID)
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -642,7 +784,7 @@ func (i *InstanceServiceHandler) ISOStatus(ctx context.Context, instanceID strin
 }
 
 // AttachISO will attach an ISO to the given instance and reboot it
-func (i *InstanceServiceHandler) AttachISO(ctx context.Context, instanceID, isoID string) (*http.Response, error) {
+func (i *InstanceServiceHandler) AttachISO(ctx context.Context, instanceID, isoID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/iso/attach", instancePath, instanceID)
 	body := RequestBody{"iso_id": isoID}
 
@@ -655,7 +797,7 @@ func (i *InstanceServiceHandler) AttachISO(ctx context.Context, instanceID, isoI
 }
 
 // DetachISO will detach the currently mounted ISO and reboot the instance.
-func (i *InstanceServiceHandler) DetachISO(ctx context.Context, instanceID string) (*http.Response, error) {
+func (i *InstanceServiceHandler) DetachISO(ctx context.Context, instanceID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/iso/detach", instancePath, instanceID)
 
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, nil)
@@ -667,7 +809,7 @@ func (i *InstanceServiceHandler) DetachISO(ctx context.Context, instanceID strin
 }
 
 // GetBackupSchedule retrieves the backup schedule for a given instance - all time values are in UTC
-func (i *InstanceServiceHandler) GetBackupSchedule(ctx context.Context, instanceID string) (*BackupSchedule, *http.Response, error) {
+func (i *InstanceServiceHandler) GetBackupSchedule(ctx context.Context, instanceID string) (*BackupSchedule, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/backup-schedule", instancePath, instanceID)
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -684,7 +826,7 @@ func (i *InstanceServiceHandler) GetBackupSchedule(ctx context.Context, instance
 }
 
 // SetBackupSchedule sets the backup schedule for a given instance - all time values are in UTC.
-func (i *InstanceServiceHandler) SetBackupSchedule(ctx context.Context, instanceID string, backup *BackupScheduleReq) (*http.Response, error) { //nolint:lll
+func (i *InstanceServiceHandler) SetBackupSchedule(ctx context.Context, instanceID string, backup *BackupScheduleReq) (*Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/backup-schedule", instancePath, instanceID)
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, backup)
 	if err != nil {
@@ -695,7 +837,7 @@ func (i *InstanceServiceHandler) SetBackupSchedule(ctx context.Context, instance
 }
 
 // CreateIPv4 an additional IPv4 address for given instance.
-func (i *InstanceServiceHandler) CreateIPv4(ctx context.Context, instanceID string, reboot *bool) (*IPv4, *http.Response, error) {
+func (i *InstanceServiceHandler) CreateIPv4(ctx context.Context, instanceID string, reboot *bool) (*IPv4, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/ipv4", instancePath, instanceID)
 
 	body := RequestBody{"reboot": reboot}
@@ -715,7 +857,7 @@ func (i *InstanceServiceHandler) CreateIPv4(ctx context.Context, instanceID stri
 }
 
 // ListIPv4 addresses that are currently assigned to a given instance.
-func (i *InstanceServiceHandler) ListIPv4(ctx context.Context, instanceID string, options *ListOptions) ([]IPv4, *Meta, *http.Response, error) { //nolint:lll,dupl
+func (i *InstanceServiceHandler) ListIPv4(ctx context.Context, instanceID string, options *ListOptions) ([]IPv4, *Meta, *Response, error) { //nolint:lll,dupl
 	uri := fmt.Sprintf("%s/%s/ipv4", instancePath, instanceID)
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -738,19 +880,18 @@ func (i *InstanceServiceHandler) ListIPv4(ctx context.Context, instanceID string
 }
 
 // DeleteIPv4 address from a given instance.
-func (i *InstanceServiceHandler) DeleteIPv4(ctx context.Context, instanceID, ip string) error {
+func (i *InstanceServiceHandler) DeleteIPv4(ctx context.Context, instanceID, ip string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/ipv4/%s", instancePath, instanceID, ip)
 	req, err := i.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // ListIPv6 addresses that are currently assigned to a given instance.
-func (i *InstanceServiceHandler) ListIPv6(ctx context.Context, instanceID string, options *ListOptions) ([]IPv6, *Meta, *http.Response, error) { //nolint:lll,dupl
+func (i *InstanceServiceHandler) ListIPv6(ctx context.Context, instanceID string, options *ListOptions) ([]IPv6, *Meta, *Response, error) { //nolint:lll,dupl
 	uri := fmt.Sprintf("%s/%s/ipv6", instancePath, instanceID)
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -773,18 +914,17 @@ func (i *InstanceServiceHandler) ListIPv6(ctx context.Context, instanceID string
 }
 
 // CreateReverseIPv6 for a given instance.
-func (i *InstanceServiceHandler) CreateReverseIPv6(ctx context.Context, instanceID string, reverseReq *ReverseIP) error {
+func (i *InstanceServiceHandler) CreateReverseIPv6(ctx context.Context, instanceID string, reverseReq *ReverseIP) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/ipv6/reverse", instancePath, instanceID)
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, reverseReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // ListReverseIPv6 currently assigned to a given instance.
-func (i *InstanceServiceHandler) ListReverseIPv6(ctx context.Context, instanceID string) ([]ReverseIP, *http.Response, error) {
+func (i *InstanceServiceHandler) ListReverseIPv6(ctx context.Context, instanceID string) ([]ReverseIP, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/ipv6/reverse", instancePath, instanceID)
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -801,42 +941,39 @@ func (i *InstanceServiceHandler) ListReverseIPv6(ctx context.Context, instanceID
 }
 
 // DeleteReverseIPv6 a given reverse IPv6.
-func (i *InstanceServiceHandler) DeleteReverseIPv6(ctx context.Context, instanceID, ip string) error {
+func (i *InstanceServiceHandler) DeleteReverseIPv6(ctx context.Context, instanceID, ip string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/ipv6/reverse/%s", instancePath, instanceID, ip)
 	req, err := i.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // CreateReverseIPv4 for a given IP on a given instance.
-func (i *InstanceServiceHandler) CreateReverseIPv4(ctx context.Context, instanceID string, reverseReq *ReverseIP) error {
+func (i *InstanceServiceHandler) CreateReverseIPv4(ctx context.Context, instanceID string, reverseReq *ReverseIP) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/ipv4/reverse", instancePath, instanceID)
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, reverseReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // DefaultReverseIPv4 will set the IPs reverse setting back to the original one supplied by Vultr.
-func (i *InstanceServiceHandler) DefaultReverseIPv4(ctx context.Context, instanceID, ip string) error {
+func (i *InstanceServiceHandler) DefaultReverseIPv4(ctx context.Context, instanceID, ip string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/ipv4/reverse/default", instancePath, instanceID)
 	reqBody := RequestBody{"ip": ip}
 
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, reqBody)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
-// GetUserData from given instance. The userdata returned will be in base64 encoding.
-func (i *InstanceServiceHandler) GetUserData(ctx context.Context, instanceID string) (*UserData, *http.Response, error) {
+// GetUserData from given instance. The userdata returned will be in base64 encoding, use UserData.Decode to decode it.
+func (i *InstanceServiceHandler) GetUserData(ctx context.Context, instanceID string) (*UserData, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/user-data", instancePath, instanceID)
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -853,7 +990,7 @@ func (i *InstanceServiceHandler) GetUserData(ctx context.Context, instanceID str
 }
 
 // GetUpgrades that are available for a given instance.
-func (i *InstanceServiceHandler) GetUpgrades(ctx context.Context, instanceID string) (*Upgrades, *http.Response, error) {
+func (i *InstanceServiceHandler) GetUpgrades(ctx context.Context, instanceID string) (*Upgrades, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/upgrades", instancePath, instanceID)
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
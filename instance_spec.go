@@ -0,0 +1,322 @@
+package govultr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// InstanceSpec describes an instance with the names of the resources it uses rather than their
+// IDs. It is loaded from YAML or JSON with ParseInstanceSpec, and turned into an
+// InstanceCreateReq by a Resolver:
+//
+//	region: ewr
+//	plan: vc2-1c-1gb
+//	os: Ubuntu 22.04 x64
+//	hostname: web-1
+//	ssh_keys: [deploy]
+//	startup_script: bootstrap
+//	firewall_group: web
+//	vpcs: [backend]
+type InstanceSpec struct {
+	// Region is the ID or the city of the region, such as ewr or New Jersey
+	Region string `json:"region" yaml:"region"`
+	// Plan is the ID of the plan, such as vc2-1c-1gb
+	Plan string `json:"plan" yaml:"plan"`
+	// OS is the name or the ID of the operating system. Words can be left out, so Ubuntu 22.04 x64
+	// matches Ubuntu 22.04 LTS x64 as long as no other name matches.
+	OS       string   `json:"os,omitempty" yaml:"os,omitempty"`
+	Label    string   `json:"label,omitempty" yaml:"label,omitempty"`
+	Hostname string   `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Tags     []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	// SSHKeys are the names or IDs of SSH keys
+	SSHKeys []string `json:"ssh_keys,omitempty" yaml:"ssh_keys,omitempty"`
+	// StartupScript is the name or the ID of a startup script
+	StartupScript string `json:"startup_script,omitempty" yaml:"startup_script,omitempty"`
+	// FirewallGroup is the description or the ID of a firewall group
+	FirewallGroup string `json:"firewall_group,omitempty" yaml:"firewall_group,omitempty"`
+	// VPCs are the descriptions or IDs of VPCs of the region
+	VPCs []string `json:"vpcs,omitempty" yaml:"vpcs,omitempty"`
+	// UserData is the raw user data, such as a cloud-config document, it is base64 encoded by the
+	// Resolver
+	UserData       string `json:"user_data,omitempty" yaml:"user_data,omitempty"`
+	EnableIPv6     bool   `json:"enable_ipv6,omitempty" yaml:"enable_ipv6,omitempty"`
+	Backups        bool   `json:"backups,omitempty" yaml:"backups,omitempty"`
+	DDOSProtection bool   `json:"ddos_protection,omitempty" yaml:"ddos_protection,omitempty"`
+}
+
+// ParseInstanceSpec parses an InstanceSpec from YAML or JSON. Unknown fields are rejected, so a
+// misspelled field is not silently ignored.
+func ParseInstanceSpec(data []byte) (*InstanceSpec, error) {
+	spec := new(InstanceSpec)
+
+	var err error
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(spec)
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		err = dec.Decode(spec)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid instance spec: %w", err)
+	}
+	return spec, nil
+}
+
+// Resolver looks up the resources named by an InstanceSpec with the List methods of a client.
+// Every list is fetched once, when first needed, so a Resolver can resolve many specs cheaply.
+// It is safe for concurrent use.
+type Resolver struct {
+	client *Client
+
+	mu             sync.Mutex
+	regions        []Region
+	plans          []Plan
+	os             []OS
+	sshKeys        []SSHKey
+	startupScripts []StartupScript
+	firewallGroups []FirewallGroup
+	vpcs           []VPC
+}
+
+// NewResolver returns a Resolver using the services of client
+func NewResolver(client *Client) *Resolver {
+	return &Resolver{client: client}
+}
+
+// InstanceCreateReq resolves the names of spec into IDs and returns the validated request. Every
+// name that is missing or matches several resources is reported in a *ValidationError, along
+// with the fields InstanceCreateReq.Validate rejects.
+func (r *Resolver) InstanceCreateReq(ctx context.Context, spec *InstanceSpec) (*InstanceCreateReq, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	req := &InstanceCreateReq{
+		Label:    spec.Label,
+		Hostname: spec.Hostname,
+		Tags:     spec.Tags,
+	}
+	if spec.EnableIPv6 {
+		req.EnableIPv6 = BoolToBoolPtr(true)
+	}
+	if spec.Backups {
+		req.Backups = "enabled"
+	}
+	if spec.DDOSProtection {
+		req.DDOSProtection = BoolToBoolPtr(true)
+	}
+
+	v := new(validation)
+	if spec.UserData != "" {
+		if err := req.SetUserData([]byte(spec.UserData)); err != nil {
+			v.add("user_data", "%v", err)
+		}
+	}
+
+	if spec.Region != "" {
+		if err := loadOnce(ctx, &r.regions, r.client.Region.List); err != nil {
+			return nil, err
+		}
+		req.Region = resolveName(v, "region", "region", spec.Region, r.regions, func(region Region) []string {
+			return []string{region.ID, region.City}
+		})
+	}
+
+	if spec.Plan != "" {
+		if err := loadOnce(ctx, &r.plans, ScopedList(r.client.Plan.List, "")); err != nil {
+			return nil, err
+		}
+		req.Plan = resolveName(v, "plan", "plan", spec.Plan, r.plans, func(plan Plan) []string {
+			return []string{plan.ID}
+		})
+		if i := slices.IndexFunc(r.plans, func(plan Plan) bool { return plan.ID == req.Plan }); i >= 0 && req.Region != "" &&
+			!slices.Contains(r.plans[i].Locations, req.Region) {
+			v.add("plan", "%s is not available in %s", req.Plan, req.Region)
+		}
+	}
+
+	if spec.OS != "" {
+		if err := loadOnce(ctx, &r.os, r.client.OS.List); err != nil {
+			return nil, err
+		}
+		id := resolveName(v, "os", "OS", spec.OS, r.os, func(os OS) []string {
+			return []string{strconv.Itoa(os.ID), os.Name}
+		})
+		req.OsID, _ = strconv.Atoi(id)
+	}
+
+	if len(spec.SSHKeys) > 0 {
+		if err := loadOnce(ctx, &r.sshKeys, r.client.SSHKey.List); err != nil {
+			return nil, err
+		}
+		for i, name := range spec.SSHKeys {
+			if id := resolveName(v, fmt.Sprintf("ssh_keys[%d]", i), "SSH key", name, r.sshKeys, func(key SSHKey) []string {
+				return []string{key.ID, key.Name}
+			}); id != "" {
+				req.SSHKeys = append(req.SSHKeys, id)
+			}
+		}
+	}
+
+	if spec.StartupScript != "" {
+		if err := loadOnce(ctx, &r.startupScripts, r.client.StartupScript.List); err != nil {
+			return nil, err
+		}
+		req.ScriptID = resolveName(v, "startup_script", "startup script", spec.StartupScript, r.startupScripts,
+			func(script StartupScript) []string {
+				return []string{script.ID, script.Name}
+			})
+	}
+
+	if spec.FirewallGroup != "" {
+		if err := loadOnce(ctx, &r.firewallGroups, r.client.FirewallGroup.List); err != nil {
+			return nil, err
+		}
+		req.FirewallGroupID = resolveName(v, "firewall_group", "firewall group", spec.FirewallGroup, r.firewallGroups,
+			func(group FirewallGroup) []string {
+				return []string{group.ID, group.Description}
+			})
+	}
+
+	if len(spec.VPCs) > 0 {
+		if err := loadOnce(ctx, &r.vpcs, r.client.VPC.List); err != nil {
+			return nil, err
+		}
+		kind, regional := "VPC", r.vpcs
+		if req.Region != "" {
+			kind = "VPC in " + req.Region
+			regional = slices.DeleteFunc(slices.Clone(r.vpcs), func(vpc VPC) bool { return vpc.Region != req.Region })
+		}
+		for i, name := range spec.VPCs {
+			if id := resolveName(v, fmt.Sprintf("vpcs[%d]", i), kind, name, regional, func(vpc VPC) []string {
+				return []string{vpc.ID, vpc.Description}
+			}); id != "" {
+				req.AttachVPC = append(req.AttachVPC, id)
+			}
+		}
+	}
+
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// loadOnce fills list with every item of every page of listFunc, unless it was already fetched
+func loadOnce[T any](ctx context.Context, list *[]T, listFunc ListFunc[T]) error {
+	if *list != nil {
+		return nil
+	}
+
+	items, err := ListAll(ctx, listFunc, &ListOptions{PerPage: 500})
+	if err != nil {
+		return err
+	}
+	if items == nil {
+		items = []T{}
+	}
+	*list = items
+	return nil
+}
+
+// resolveName returns the ID of the only item matching name, keys returning the ID of an item
+// followed by its names. Names are compared case insensitively, and when no name is equal the
+// items whose name has every word of name are considered. Missing and ambiguous names are added
+// to v.
+func resolveName[T any](v *validation, field, kind, name string, items []T, keys func(T) []string) string {
+	query := strings.Fields(strings.ToLower(name))
+
+	var equal, contained []T
+	for _, item := range items {
+		for _, key := range keys(item) {
+			words := strings.Fields(strings.ToLower(key))
+			if slices.Equal(words, query) {
+				equal = append(equal, item)
+				break
+			}
+			if containsWords(words, query) {
+				contained = append(contained, item)
+				break
+			}
+		}
+	}
+
+	matches := equal
+	if len(matches) == 0 {
+		matches = contained
+	}
+
+	switch len(matches) {
+	case 1:
+		return keys(matches[0])[0]
+	case 0:
+		v.add(field, "no %s named %q%s", kind, name, suggest(query, items, keys))
+	default:
+		described := make([]string, len(matches))
+		for i, item := range matches {
+			described[i] = describeItem(keys(item))
+		}
+		v.add(field, "%q is ambiguous, it matches %s", name, strings.Join(described, ", "))
+	}
+	return ""
+}
+
+// containsWords reports whether every word of query is one of words
+func containsWords(words, query []string) bool {
+	for _, w := range query {
+		if !slices.Contains(words, w) {
+			return false
+		}
+	}
+	return len(query) > 0
+}
+
+// suggest names up to three items sharing the most words with query
+func suggest[T any](query []string, items []T, keys func(T) []string) string {
+	best := 0
+	var names []string
+	for _, item := range items {
+		k := keys(item)
+		shared := 0
+		for _, w := range strings.Fields(strings.ToLower(k[len(k)-1])) {
+			if slices.Contains(query, w) {
+				shared++
+			}
+		}
+
+		switch {
+		case shared == 0 || shared < best:
+		case shared > best:
+			best, names = shared, []string{describeItem(k)}
+		case len(names) < 3:
+			names = append(names, describeItem(k))
+		}
+	}
+
+	if len(names) == 0 {
+		return ""
+	}
+	return ", did you mean " + strings.Join(names, " or ") + "?"
+}
+
+// describeItem quotes the name of an item followed by its ID
+func describeItem(keys []string) string {
+	if len(keys) < 2 || keys[len(keys)-1] == keys[0] {
+		return strconv.Quote(keys[0])
+	}
+	return fmt.Sprintf("%q (%s)", keys[len(keys)-1], keys[0])
+}
@@ -0,0 +1,158 @@
+package govultr
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseInstanceSpec(t *testing.T) {
+	expected := &InstanceSpec{
+		Region:   "New Jersey",
+		Plan:     "vc2-1c-1gb",
+		OS:       "Ubuntu 22.04 x64",
+		Hostname: "web-1",
+		SSHKeys:  []string{"deploy", "ci"},
+		VPCs:     []string{"backend"},
+		Backups:  true,
+	}
+
+	specs := map[string]string{
+		"yaml": `
+region: New Jersey
+plan: vc2-1c-1gb
+os: Ubuntu 22.04 x64
+hostname: web-1
+ssh_keys: [deploy, ci]
+vpcs:
+  - backend
+backups: true
+`,
+		"json": `{
+	"region": "New Jersey",
+	"plan": "vc2-1c-1gb",
+	"os": "Ubuntu 22.04 x64",
+	"hostname": "web-1",
+	"ssh_keys": ["deploy", "ci"],
+	"vpcs": ["backend"],
+	"backups": true
+}`,
+	}
+
+	for format, data := range specs {
+		spec, err := ParseInstanceSpec([]byte(data))
+		if err != nil {
+			t.Fatalf("ParseInstanceSpec(%s) returned %+v", format, err)
+		}
+		if !reflect.DeepEqual(spec, expected) {
+			t.Errorf("ParseInstanceSpec(%s) returned %+v, expected %+v", format, spec, expected)
+		}
+	}
+
+	if _, err := ParseInstanceSpec([]byte("region: ewr\nssh_key: deploy\n")); err == nil || !strings.Contains(err.Error(), "ssh_key") {
+		t.Errorf("ParseInstanceSpec returned %+v, expected an error about the unknown field", err)
+	}
+}
+
+func setupResolver() {
+	handlers := map[string]string{
+		"/v2/regions": `{"regions":[{"id":"ewr","city":"New Jersey"},{"id":"ord","city":"Chicago"}],"meta":{"total":2}}`,
+		"/v2/plans":   `{"plans":[{"id":"vc2-1c-1gb","locations":["ewr","ord"]},{"id":"vc2-2c-4gb","locations":["ord"]}],"meta":{"total":2}}`,
+		"/v2/os": `{"os":[{"id":1743,"name":"Ubuntu 22.04 LTS x64"},{"id":2284,"name":"Ubuntu 24.04 LTS x64"},` +
+			`{"id":2136,"name":"Debian 12 x64 (bookworm)"}],"meta":{"total":3}}`,
+		"/v2/ssh-keys": `{"ssh_keys":[{"id":"3b8066a7","name":"deploy"},{"id":"0f2bc6ea","name":"ci"},` +
+			`{"id":"8c0e2c1b","name":"laptop"},{"id":"a2d8c3e0","name":"Laptop"}],"meta":{"total":4}}`,
+		"/v2/startup-scripts": `{"startup_scripts":[{"id":"4a5b6c7d","name":"bootstrap"}],"meta":{"total":1}}`,
+		"/v2/firewalls":       `{"firewall_groups":[{"id":"1234abcd","description":"web"}],"meta":{"total":1}}`,
+		"/v2/vpcs": `{"vpcs":[{"id":"6a8c5ca6","region":"ewr","description":"backend"},` +
+			`{"id":"98a0f6f4","region":"ord","description":"backend"}],"meta":{"total":2}}`,
+	}
+
+	for path, body := range handlers {
+		body := body
+		mux.HandleFunc(path, func(writer http.ResponseWriter, request *http.Request) {
+			fmt.Fprint(writer, body)
+		})
+	}
+}
+
+func TestResolver_InstanceCreateReq(t *testing.T) {
+	setup()
+	defer teardown()
+	setupResolver()
+
+	spec := &InstanceSpec{
+		Region:        "new jersey",
+		Plan:          "vc2-1c-1gb",
+		OS:            "Ubuntu 22.04 x64",
+		Hostname:      "web-1",
+		SSHKeys:       []string{"deploy", "0f2bc6ea"},
+		StartupScript: "bootstrap",
+		FirewallGroup: "web",
+		VPCs:          []string{"backend"},
+		UserData:      "#cloud-config\n",
+		EnableIPv6:    true,
+	}
+
+	req, err := NewResolver(client).InstanceCreateReq(ctx, spec)
+	if err != nil {
+		t.Fatalf("Resolver.InstanceCreateReq returned %+v", err)
+	}
+
+	expected := &InstanceCreateReq{
+		Region:          "ewr",
+		Plan:            "vc2-1c-1gb",
+		OsID:            1743,
+		Hostname:        "web-1",
+		SSHKeys:         []string{"3b8066a7", "0f2bc6ea"},
+		ScriptID:        "4a5b6c7d",
+		FirewallGroupID: "1234abcd",
+		AttachVPC:       []string{"6a8c5ca6"},
+		UserData:        base64.StdEncoding.EncodeToString([]byte("#cloud-config\n")),
+		EnableIPv6:      BoolToBoolPtr(true),
+	}
+	if !reflect.DeepEqual(req, expected) {
+		t.Errorf("Resolver.InstanceCreateReq returned %+v, expected %+v", req, expected)
+	}
+}
+
+func TestResolver_InstanceCreateReqErrors(t *testing.T) {
+	setup()
+	defer teardown()
+	setupResolver()
+
+	spec := &InstanceSpec{
+		Region:  "ewr",
+		Plan:    "vc2-2c-4gb",
+		OS:      "Ubuntu x64",
+		SSHKeys: []string{"laptop", "deploy-key"},
+		VPCs:    []string{"frontend"},
+	}
+
+	_, err := NewResolver(client).InstanceCreateReq(ctx, spec)
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Resolver.InstanceCreateReq returned %+v, expected a *ValidationError", err)
+	}
+
+	expected := map[string]string{
+		"plan":        "vc2-2c-4gb is not available in ewr",
+		"os":          `"Ubuntu x64" is ambiguous, it matches "Ubuntu 22.04 LTS x64" (1743), "Ubuntu 24.04 LTS x64" (2284)`,
+		"ssh_keys[0]": `"laptop" is ambiguous, it matches "laptop" (8c0e2c1b), "Laptop" (a2d8c3e0)`,
+		"ssh_keys[1]": `no SSH key named "deploy-key"`,
+		"vpcs[0]":     `no VPC in ewr named "frontend"`,
+	}
+	if len(validationErr.Fields) != len(expected) {
+		t.Errorf("Resolver.InstanceCreateReq returned %v, expected %d errors", err, len(expected))
+	}
+	for field, message := range expected {
+		if fieldErr := validationErr.Field(field); fieldErr == nil || fieldErr.Message != message {
+			t.Errorf("Resolver.InstanceCreateReq returned %v for %s, expected %q", fieldErr, field, message)
+		}
+	}
+}
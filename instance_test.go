@@ -1,6 +1,7 @@
 package govultr
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -61,7 +62,7 @@ func TestServerServiceHandler_GetBackupSchedule(t *testing.T) {
 	expected := &BackupSchedule{
 		Enabled:             BoolToBoolPtr(true),
 		Type:                "weekly",
-		NextScheduleTimeUTC: "2016-05-07 08:00:00",
+		NextScheduleTimeUTC: timestamp("2016-05-07 08:00:00"),
 		Hour:                8,
 		Dow:                 6,
 		Dom:                 0,
@@ -379,7 +380,7 @@ func TestServerServiceHandler_DestroyIPV4(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.Instance.DeleteIPv4(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "192.168.0.1")
+	_, err := client.Instance.DeleteIPv4(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "192.168.0.1")
 
 	if err != nil {
 		t.Errorf("Instance.DestroyIPV4 returned %+v", err)
@@ -410,10 +411,7 @@ func TestServerServiceHandler_GetBandwidth(t *testing.T) {
 	}
 
 	expected := &Bandwidth{
-		Bandwidth: map[string]struct {
-			IncomingBytes int `json:"incoming_bytes"`
-			OutgoingBytes int `json:"outgoing_bytes"`
-		}{
+		Bandwidth: map[string]BandwidthUsage{
 			"2017-04-01": {
 				IncomingBytes: 91571055,
 				OutgoingBytes: 3084731,
@@ -458,7 +456,7 @@ func TestServerServiceHandler_DefaultReverseIPv4(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	if err := client.Instance.DefaultReverseIPv4(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "129.123.123.1"); err != nil {
+	if _, err := client.Instance.DefaultReverseIPv4(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "129.123.123.1"); err != nil {
 		t.Errorf("Instance.DefaultReverseIPv4 returned %+v", err)
 	}
 }
@@ -471,7 +469,7 @@ func TestServerServiceHandler_DeleteReverseIPv6(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	if err := client.Instance.DeleteReverseIPv6(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "2001:19f0:8001:1480:5400:2ff:fe00:8228"); err != nil {
+	if _, err := client.Instance.DeleteReverseIPv6(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "2001:19f0:8001:1480:5400:2ff:fe00:8228"); err != nil {
 		t.Errorf("Instance.DeleteReverseIPv6 returned %+v", err)
 	}
 }
@@ -489,7 +487,7 @@ func TestServerServiceHandler_CreateReverseIPv4(t *testing.T) {
 		Reverse: "test.com",
 	}
 
-	if err := client.Instance.CreateReverseIPv4(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", reverseReq); err != nil {
+	if _, err := client.Instance.CreateReverseIPv4(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", reverseReq); err != nil {
 		t.Errorf("Instance.CreateReverseIPv4 returned %+v", err)
 	}
 }
@@ -507,7 +505,7 @@ func TestServerServiceHandler_CreateReverseIPv6(t *testing.T) {
 		Reverse: "test.com",
 	}
 
-	if err := client.Instance.CreateReverseIPv6(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", reverseReq); err != nil {
+	if _, err := client.Instance.CreateReverseIPv6(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", reverseReq); err != nil {
 		t.Errorf("Instance.CreateReverseIPv6 returned %+v", err)
 	}
 }
@@ -520,7 +518,7 @@ func TestServerServiceHandler_Halt(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	if err := client.Instance.Halt(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
+	if _, err := client.Instance.Halt(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
 		t.Errorf("Instance.Halt returned %+v", err)
 	}
 }
@@ -533,7 +531,7 @@ func TestServerServiceHandler_Start(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	if err := client.Instance.Start(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
+	if _, err := client.Instance.Start(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
 		t.Errorf("Instance.Start returned %+v", err)
 	}
 }
@@ -546,7 +544,7 @@ func TestServerServiceHandler_Reboot(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.Instance.Reboot(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
+	_, err := client.Instance.Reboot(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
 
 	if err != nil {
 		t.Errorf("Instance.Reboot returned %+v", err)
@@ -576,7 +574,7 @@ func TestServerServiceHandler_Reinstall(t *testing.T) {
 		VCPUCount:        2,
 		Region:           "ewr",
 		DefaultPassword:  "nreqnusibni",
-		DateCreated:      "2013-12-19 14:45:41",
+		DateCreated:      timestamp("2013-12-19 14:45:41"),
 		Status:           "active",
 		AllowedBandwidth: 2000,
 		NetmaskV4:        "255.255.255.248",
@@ -609,7 +607,7 @@ func TestServerServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.Instance.Delete(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
+	_, err := client.Instance.Delete(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
 
 	if err != nil {
 		t.Errorf("Instance.Delete returned %+v", err)
@@ -625,6 +623,8 @@ func TestServerServiceHandler_Create(t *testing.T) {
 	})
 
 	options := &InstanceCreateReq{
+		Region:          "ewr",
+		Plan:            "vc2-1c-2gb",
 		IPXEChainURL:    "test.org",
 		ISOID:           "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
 		ScriptID:        "213",
@@ -633,14 +633,12 @@ func TestServerServiceHandler_Create(t *testing.T) {
 		UserData:        "dW5vLWRvcy10cmVz",
 		ActivationEmail: BoolToBoolPtr(true),
 		DDOSProtection:  BoolToBoolPtr(true),
-		SnapshotID:      "12ab",
 		Hostname:        "hostname-3000",
 		Tags:            []string{"my tag"},
 		Label:           "label-extreme",
 		SSHKeys:         []string{"14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "dev-preview-abc124"},
 		ReservedIPv4:    "63.209.35.79",
 		FirewallGroupID: "1234",
-		AppID:           1,
 	}
 
 	server, _, err := client.Instance.Create(ctx, options)
@@ -658,7 +656,7 @@ func TestServerServiceHandler_Create(t *testing.T) {
 		VCPUCount:        2,
 		Region:           "ewr",
 		DefaultPassword:  "nreqnusibni",
-		DateCreated:      "2013-12-19 14:45:41",
+		DateCreated:      timestamp("2013-12-19 14:45:41"),
 		Status:           "active",
 		AllowedBandwidth: 2000,
 		NetmaskV4:        "255.255.255.248",
@@ -749,7 +747,7 @@ func TestServerServiceHandler_List(t *testing.T) {
 			VCPUCount:        2,
 			Region:           "ewr",
 			DefaultPassword:  "nreqnusibni",
-			DateCreated:      "2013-12-19 14:45:41",
+			DateCreated:      timestamp("2013-12-19 14:45:41"),
 			Status:           "active",
 			AllowedBandwidth: 2000,
 			NetmaskV4:        "255.255.255.248",
@@ -810,7 +808,7 @@ func TestServerServiceHandler_GetServer(t *testing.T) {
 		VCPUCount:        2,
 		Region:           "ewr",
 		DefaultPassword:  "nreqnusibni",
-		DateCreated:      "2013-12-19 14:45:41",
+		DateCreated:      timestamp("2013-12-19 14:45:41"),
 		Status:           "active",
 		AllowedBandwidth: 2000,
 		NetmaskV4:        "255.255.255.248",
@@ -906,7 +904,7 @@ func TestServerServiceHandler_MassStart(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	if err := client.Instance.MassStart(ctx, []string{"14b3e7d6-ffb5-4994-8502-57fcd9db3b33"}); err != nil {
+	if _, err := client.Instance.MassStart(ctx, []string{"14b3e7d6-ffb5-4994-8502-57fcd9db3b33"}); err != nil {
 		t.Errorf("Instance.MassStart returned %+v", err)
 	}
 }
@@ -919,7 +917,7 @@ func TestServerServiceHandler_MassReboot(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	if err := client.Instance.MassReboot(ctx, []string{"14b3e7d6-ffb5-4994-8502-57fcd9db3b33"}); err != nil {
+	if _, err := client.Instance.MassReboot(ctx, []string{"14b3e7d6-ffb5-4994-8502-57fcd9db3b33"}); err != nil {
 		t.Errorf("Instance.MassReboot returned %+v", err)
 	}
 }
@@ -932,7 +930,7 @@ func TestServerServiceHandler_MassHalt(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	if err := client.Instance.MassHalt(ctx, []string{"14b3e7d6-ffb5-4994-8502-57fcd9db3b33"}); err != nil {
+	if _, err := client.Instance.MassHalt(ctx, []string{"14b3e7d6-ffb5-4994-8502-57fcd9db3b33"}); err != nil {
 		t.Errorf("Instance.MassHalt returned %+v", err)
 	}
 }
@@ -945,7 +943,7 @@ func TestServerServiceHandler_AttachPrivateNetwork(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	if err := client.Instance.AttachPrivateNetwork(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
+	if _, err := client.Instance.AttachPrivateNetwork(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
 		t.Errorf("Instance.AttachPrivateNetwork returned %+v", err)
 	}
 }
@@ -958,7 +956,7 @@ func TestServerServiceHandler_DetachPrivateNetwork(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	if err := client.Instance.DetachPrivateNetwork(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
+	if _, err := client.Instance.DetachPrivateNetwork(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
 		t.Errorf("Instance.DetachPrivateNetwork returned %+v", err)
 	}
 }
@@ -971,7 +969,7 @@ func TestServerServiceHandler_AttachVPC(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	if err := client.Instance.AttachVPC(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
+	if _, err := client.Instance.AttachVPC(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
 		t.Errorf("Instance.AttachVPC returned %+v", err)
 	}
 }
@@ -984,7 +982,7 @@ func TestServerServiceHandler_DetachVPC(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	if err := client.Instance.DetachVPC(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
+	if _, err := client.Instance.DetachVPC(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"); err != nil {
 		t.Errorf("Instance.DetachVPC returned %+v", err)
 	}
 }
@@ -1047,14 +1045,14 @@ func TestServerServiceHandler_Update(t *testing.T) {
 	})
 
 	options := &InstanceUpdateReq{
-		EnableIPv6:      BoolToBoolPtr(true),
-		Backups:         "enabled",
-		UserData:        "dW5vLWRvcy10cmVz",
-		DDOSProtection:  BoolToBoolPtr(true),
-		Tags:            []string{"my tag"},
-		Label:           "label-extreme",
-		FirewallGroupID: "1234",
-		AppID:           1,
+		EnableIPv6:      NewOptional(true),
+		Backups:         NewOptional("enabled"),
+		UserData:        NewOptional("dW5vLWRvcy10cmVz"),
+		DDOSProtection:  NewOptional(true),
+		Tags:            NewOptional([]string{"my tag"}),
+		Label:           NewOptional("label-extreme"),
+		FirewallGroupID: NewOptional("1234"),
+		AppID:           NewOptional(1),
 	}
 
 	server, _, err := client.Instance.Update(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", options)
@@ -1072,7 +1070,7 @@ func TestServerServiceHandler_Update(t *testing.T) {
 		VCPUCount:        2,
 		Region:           "ewr",
 		DefaultPassword:  "nreqnusibni",
-		DateCreated:      "2013-12-19 14:45:41",
+		DateCreated:      timestamp("2013-12-19 14:45:41"),
 		Status:           "active",
 		AllowedBandwidth: 2000,
 		NetmaskV4:        "255.255.255.248",
@@ -1140,15 +1138,15 @@ func TestServerServiceHandler_CreateMarketplace(t *testing.T) {
 	})
 
 	options := &InstanceCreateReq{
+		Region:          "ewr",
+		Plan:            "vc2-1c-2gb",
 		IPXEChainURL:    "test.org",
-		ISOID:           "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
 		ScriptID:        "213",
 		EnableIPv6:      BoolToBoolPtr(true),
 		Backups:         "enabled",
 		UserData:        "dW5vLWRvcy10cmVz",
 		ActivationEmail: BoolToBoolPtr(true),
 		DDOSProtection:  BoolToBoolPtr(true),
-		SnapshotID:      "12ab",
 		Hostname:        "hostname-3000",
 		Tags:            []string{"tagger"},
 		Label:           "label-extreme",
@@ -1175,7 +1173,7 @@ func TestServerServiceHandler_CreateMarketplace(t *testing.T) {
 		VCPUCount:        2,
 		Region:           "ewr",
 		DefaultPassword:  "nreqnusibni",
-		DateCreated:      "2013-12-19 14:45:41",
+		DateCreated:      timestamp("2013-12-19 14:45:41"),
 		Status:           "active",
 		AllowedBandwidth: 2000,
 		NetmaskV4:        "255.255.255.248",
@@ -1200,3 +1198,26 @@ func TestServerServiceHandler_CreateMarketplace(t *testing.T) {
 		t.Errorf("Instance.Create returned %+v, expected %+v", server, expected)
 	}
 }
+
+func TestInstanceStatuses(t *testing.T) {
+	instance := new(Instance)
+	if err := json.Unmarshal([]byte(`{"status":"active","power_status":"running","server_status":"rebuilding"}`), instance); err != nil {
+		t.Fatalf("json.Unmarshal returned %+v", err)
+	}
+
+	if instance.Status != InstanceStatusActive || !instance.Status.IsValid() {
+		t.Errorf("Status = %q, expected %q", instance.Status, InstanceStatusActive)
+	}
+	if !instance.PowerStatus.IsValid() {
+		t.Errorf("PowerStatus %q is not valid", instance.PowerStatus)
+	}
+
+	// values added to the API after this client was released are kept as they are
+	if instance.ServerStatus != "rebuilding" || instance.ServerStatus.IsValid() {
+		t.Errorf("ServerStatus = %q, expected an unknown %q", instance.ServerStatus, "rebuilding")
+	}
+
+	if BackupScheduleType("hourly").IsValid() || !BackupScheduleDailyAltOdd.IsValid() {
+		t.Error("BackupScheduleType.IsValid returned unexpected results")
+	}
+}
@@ -0,0 +1,232 @@
+// Package servicegen reads the service interfaces exposed by govultr.Client, for the generators
+// of the otelgovultr and govultrfake packages.
+package servicegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+)
+
+// Service is an interface held by a field of govultr.Client
+type Service struct {
+	// Field is the name of the Client field, e.g. Instance
+	Field string
+	// Interface is the name of the interface type, e.g. InstanceService
+	Interface string
+	Methods   []Method
+}
+
+// Method of a service interface
+type Method struct {
+	Name    string
+	Params  []Param
+	Results []string
+}
+
+// Param is a named method parameter, Type is qualified with the govultr package name
+type Param struct {
+	Name     string
+	Type     string
+	Variadic bool
+}
+
+// HasContext reports whether the first parameter of the method is a context.Context
+func (m Method) HasContext() bool {
+	return len(m.Params) > 0 && m.Params[0].Type == "context.Context"
+}
+
+// ReturnsError reports whether the last result of the method is an error
+func (m Method) ReturnsError() bool {
+	return len(m.Results) > 0 && m.Results[len(m.Results)-1] == "error"
+}
+
+// Signature returns the parameter and result list of the method, e.g.
+// (ctx context.Context, id string) (*govultr.Instance, error)
+func (m Method) Signature() string {
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		params[i] = p.Name + " " + p.Type
+		if p.Variadic {
+			params[i] = p.Name + " ..." + strings.TrimPrefix(p.Type, "[]")
+		}
+	}
+
+	sig := "(" + strings.Join(params, ", ") + ")"
+	switch len(m.Results) {
+	case 0:
+	case 1:
+		sig += " " + m.Results[0]
+	default:
+		sig += " (" + strings.Join(m.Results, ", ") + ")"
+	}
+
+	return sig
+}
+
+// Args returns the arguments forwarding the parameters of the method to another call
+func (m Method) Args() string {
+	args := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		args[i] = p.Name
+		if p.Variadic {
+			args[i] += "..."
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// Load parses the govultr package in dir and returns the services of govultr.Client, in the
+// order of the Client fields.
+func Load(dir string) ([]Service, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, ok := pkgs["govultr"]
+	if !ok {
+		return nil, fmt.Errorf("package govultr not found in %s", dir)
+	}
+
+	types := map[string]ast.Expr{}
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				types[ts.Name.Name] = ts.Type
+			}
+		}
+	}
+
+	client, ok := types["Client"].(*ast.StructType)
+	if !ok {
+		return nil, fmt.Errorf("type Client not found in %s", dir)
+	}
+
+	var services []Service
+	for _, field := range client.Fields.List {
+		ident, ok := field.Type.(*ast.Ident)
+		if !ok || len(field.Names) != 1 || !field.Names[0].IsExported() {
+			continue
+		}
+
+		iface, ok := types[ident.Name].(*ast.InterfaceType)
+		if !ok {
+			continue
+		}
+
+		service := Service{Field: field.Names[0].Name, Interface: ident.Name}
+		for _, m := range iface.Methods.List {
+			fn, ok := m.Type.(*ast.FuncType)
+			if !ok {
+				return nil, fmt.Errorf("%s embeds an interface, which is not supported", ident.Name)
+			}
+			for _, name := range m.Names {
+				service.Methods = append(service.Methods, newMethod(name.Name, fn, types))
+			}
+		}
+		services = append(services, service)
+	}
+
+	return services, nil
+}
+
+func newMethod(name string, fn *ast.FuncType, types map[string]ast.Expr) Method {
+	m := Method{Name: name}
+
+	for _, field := range fn.Params.List {
+		typ := field.Type
+		variadic := false
+		if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+			typ = &ast.ArrayType{Elt: ellipsis.Elt}
+			variadic = true
+		}
+
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", len(m.Params)))}
+		}
+		for _, n := range names {
+			m.Params = append(m.Params, Param{Name: n.Name, Type: qualify(typ, types), Variadic: variadic})
+		}
+	}
+
+	if fn.Results != nil {
+		for _, field := range fn.Results.List {
+			count := len(field.Names)
+			if count == 0 {
+				count = 1
+			}
+			for i := 0; i < count; i++ {
+				m.Results = append(m.Results, qualify(field.Type, types))
+			}
+		}
+	}
+
+	return m
+}
+
+// qualify prints a type expression, prefixing the types declared by govultr with its package name
+func qualify(expr ast.Expr, types map[string]ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if _, ok := types[t.Name]; ok {
+			return "govultr." + t.Name
+		}
+		return t.Name
+	case *ast.SelectorExpr:
+		return qualify(t.X, types) + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + qualify(t.X, types)
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return "[]" + qualify(t.Elt, types)
+		}
+		return "[" + exprString(t.Len) + "]" + qualify(t.Elt, types)
+	case *ast.MapType:
+		return "map[" + qualify(t.Key, types) + "]" + qualify(t.Value, types)
+	case *ast.IndexExpr:
+		return qualify(t.X, types) + "[" + qualify(t.Index, types) + "]"
+	case *ast.IndexListExpr:
+		indices := make([]string, len(t.Indices))
+		for i, index := range t.Indices {
+			indices[i] = qualify(index, types)
+		}
+		return qualify(t.X, types) + "[" + strings.Join(indices, ", ") + "]"
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return "interface{}"
+		}
+	}
+
+	return exprString(expr)
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	_ = format.Node(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+// Format gofmts generated source, returning the unformatted source along with the error so
+// that it can be inspected.
+func Format(src []byte) ([]byte, error) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return src, err
+	}
+	return formatted, nil
+}
@@ -17,6 +17,24 @@ type IPv6 struct {
 	Type        string `json:"type,omitempty"`
 }
 
+// IPType is the version of the IP addresses a rule or a reserved IP applies to
+type IPType string
+
+// IP types
+const (
+	IPTypeV4 IPType = "v4"
+	IPTypeV6 IPType = "v6"
+)
+
+// IsValid reports whether t is a known IP type
+func (t IPType) IsValid() bool {
+	switch t {
+	case IPTypeV4, IPTypeV6:
+		return true
+	}
+	return false
+}
+
 type ipBase struct {
 	IPv4s []IPv4 `json:"ipv4s,omitempty"`
 	IPv6s []IPv6 `json:"ipv6s,omitempty"`
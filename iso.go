@@ -11,11 +11,11 @@ import (
 // ISOService is the interface to interact with the ISO endpoints on the Vultr API
 // Link : https://www.vultr.com/api/#tag/iso
 type ISOService interface {
-	Create(ctx context.Context, isoReq *ISOReq) (*ISO, *http.Response, error)
-	Get(ctx context.Context, isoID string) (*ISO, *http.Response, error)
-	Delete(ctx context.Context, isoID string) error
-	List(ctx context.Context, options *ListOptions) ([]ISO, *Meta, *http.Response, error)
-	ListPublic(ctx context.Context, options *ListOptions) ([]PublicISO, *Meta, *http.Response, error)
+	Create(ctx context.Context, isoReq *ISOReq) (*ISO, *Response, error)
+	Get(ctx context.Context, isoID string) (*ISO, *Response, error)
+	Delete(ctx context.Context, isoID string) (*Response, error)
+	List(ctx context.Context, options *ListOptions) ([]ISO, *Meta, *Response, error)
+	ListPublic(ctx context.Context, options *ListOptions) ([]PublicISO, *Meta, *Response, error)
 }
 
 // ISOServiceHandler handles interaction with the ISO methods for the Vultr API
@@ -25,13 +25,13 @@ type ISOServiceHandler struct {
 
 // ISO represents ISOs currently available on this account.
 type ISO struct {
-	ID          string `json:"id"`
-	DateCreated string `json:"date_created"`
-	FileName    string `json:"filename"`
-	Size        int    `json:"size,omitempty"`
-	MD5Sum      string `json:"md5sum,omitempty"`
-	SHA512Sum   string `json:"sha512sum,omitempty"`
-	Status      string `json:"status"`
+	ID          string    `json:"id"`
+	DateCreated Timestamp `json:"date_created"`
+	FileName    string    `json:"filename"`
+	Size        int       `json:"size,omitempty"`
+	MD5Sum      string    `json:"md5sum,omitempty"`
+	SHA512Sum   string    `json:"sha512sum,omitempty"`
+	Status      string    `json:"status"`
 }
 
 // PublicISO represents public ISOs offered in the Vultr ISO library.
@@ -47,6 +47,14 @@ type ISOReq struct {
 	URL string `json:"url"`
 }
 
+// Validate checks that the URL is given and is an http or https URL
+func (r ISOReq) Validate() error {
+	v := new(validation)
+	v.required("url", r.URL)
+	v.url("url", r.URL)
+	return v.err()
+}
+
 type isosBase struct {
 	ISOs []ISO `json:"isos"`
 	Meta *Meta `json:"meta"`
@@ -62,7 +70,7 @@ type publicIsosBase struct {
 }
 
 // Create will create a new ISO image on your account
-func (i *ISOServiceHandler) Create(ctx context.Context, isoReq *ISOReq) (*ISO, *http.Response, error) {
+func (i *ISOServiceHandler) Create(ctx context.Context, isoReq *ISOReq) (*ISO, *Response, error) {
 	uri := "/v2/iso"
 
 	req, err := i.client.NewRequest(ctx, http.MethodPost, uri, isoReq)
@@ -80,7 +88,7 @@ func (i *ISOServiceHandler) Create(ctx context.Context, isoReq *ISOReq) (*ISO, *
 }
 
 // Get an ISO
-func (i *ISOServiceHandler) Get(ctx context.Context, isoID string) (*ISO, *http.Response, error) {
+func (i *ISOServiceHandler) Get(ctx context.Context, isoID string) (*ISO, *Response, error) {
 	uri := fmt.Sprintf("/v2/iso/%s", isoID)
 
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -97,20 +105,19 @@ func (i *ISOServiceHandler) Get(ctx context.Context, isoID string) (*ISO, *http.
 }
 
 // Delete will delete an ISO image from your account
-func (i *ISOServiceHandler) Delete(ctx context.Context, isoID string) error {
+func (i *ISOServiceHandler) Delete(ctx context.Context, isoID string) (*Response, error) {
 	uri := fmt.Sprintf("/v2/iso/%s", isoID)
 
 	req, err := i.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = i.client.DoWithContext(ctx, req, nil)
-	return err
+	return i.client.DoWithContext(ctx, req, nil)
 }
 
 // List will list all ISOs currently available on your account
-func (i *ISOServiceHandler) List(ctx context.Context, options *ListOptions) ([]ISO, *Meta, *http.Response, error) { //nolint:dupl
+func (i *ISOServiceHandler) List(ctx context.Context, options *ListOptions) ([]ISO, *Meta, *Response, error) { //nolint:dupl
 	uri := "/v2/iso"
 
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -135,7 +142,7 @@ func (i *ISOServiceHandler) List(ctx context.Context, options *ListOptions) ([]I
 }
 
 // ListPublic will list public ISOs offered in the Vultr ISO library.
-func (i *ISOServiceHandler) ListPublic(ctx context.Context, options *ListOptions) ([]PublicISO, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (i *ISOServiceHandler) ListPublic(ctx context.Context, options *ListOptions) ([]PublicISO, *Meta, *Response, error) { //nolint:dupl,lll
 	uri := "/v2/iso-public"
 
 	req, err := i.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -24,7 +24,7 @@ func TestIsoServiceHandler_Create(t *testing.T) {
 
 	expected := &ISO{
 		ID:          "9931",
-		DateCreated: "2020-07-0917:15:27",
+		DateCreated: timestamp("2020-07-0917:15:27"),
 		FileName:    "CentOS-8.1.1911-x86_64-dvd1.iso",
 		Size:        0,
 		MD5Sum:      "",
@@ -53,7 +53,7 @@ func TestIsoServiceHandler_Get(t *testing.T) {
 
 	expected := &ISO{
 		ID:          "9931",
-		DateCreated: "2020-07-0917:15:27",
+		DateCreated: timestamp("2020-07-0917:15:27"),
 		FileName:    "CentOS-8.1.1911-x86_64-dvd1.iso",
 		Size:        0,
 		MD5Sum:      "",
@@ -74,7 +74,7 @@ func TestIsoServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.ISO.Delete(ctx, "24")
+	_, err := client.ISO.Delete(ctx, "24")
 
 	if err != nil {
 		t.Errorf("Iso.Delete returned %+v, expected %+v", err, nil)
@@ -98,7 +98,7 @@ func TestIsoServiceHandler_List(t *testing.T) {
 	expectedIso := []ISO{
 		{
 			ID:          "9931",
-			DateCreated: "2020-07-0917:15:27",
+			DateCreated: timestamp("2020-07-0917:15:27"),
 			FileName:    "CentOS-8.1.1911-x86_64-dvd1.iso",
 			Size:        0,
 			MD5Sum:      "",
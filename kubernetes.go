@@ -13,27 +13,27 @@ const vkePath = "/v2/kubernetes/clusters"
 // KubernetesService is the interface to interact with kubernetes endpoint on the Vultr API
 // Link : https://www.vultr.com/api/#tag/kubernetes
 type KubernetesService interface {
-	CreateCluster(ctx context.Context, createReq *ClusterReq) (*Cluster, *http.Response, error)
-	GetCluster(ctx context.Context, id string) (*Cluster, *http.Response, error)
-	ListClusters(ctx context.Context, options *ListOptions) ([]Cluster, *Meta, *http.Response, error)
-	UpdateCluster(ctx context.Context, vkeID string, updateReq *ClusterReqUpdate) error
-	DeleteCluster(ctx context.Context, id string) error
-	DeleteClusterWithResources(ctx context.Context, id string) error
+	CreateCluster(ctx context.Context, createReq *ClusterReq) (*Cluster, *Response, error)
+	GetCluster(ctx context.Context, id string) (*Cluster, *Response, error)
+	ListClusters(ctx context.Context, options *ListOptions) ([]Cluster, *Meta, *Response, error)
+	UpdateCluster(ctx context.Context, vkeID string, updateReq *ClusterReqUpdate) (*Response, error)
+	DeleteCluster(ctx context.Context, id string) (*Response, error)
+	DeleteClusterWithResources(ctx context.Context, id string) (*Response, error)
 
-	CreateNodePool(ctx context.Context, vkeID string, nodePoolReq *NodePoolReq) (*NodePool, *http.Response, error)
-	ListNodePools(ctx context.Context, vkeID string, options *ListOptions) ([]NodePool, *Meta, *http.Response, error)
-	GetNodePool(ctx context.Context, vkeID, nodePoolID string) (*NodePool, *http.Response, error)
-	UpdateNodePool(ctx context.Context, vkeID, nodePoolID string, updateReq *NodePoolReqUpdate) (*NodePool, *http.Response, error)
-	DeleteNodePool(ctx context.Context, vkeID, nodePoolID string) error
+	CreateNodePool(ctx context.Context, vkeID string, nodePoolReq *NodePoolReq) (*NodePool, *Response, error)
+	ListNodePools(ctx context.Context, vkeID string, options *ListOptions) ([]NodePool, *Meta, *Response, error)
+	GetNodePool(ctx context.Context, vkeID, nodePoolID string) (*NodePool, *Response, error)
+	UpdateNodePool(ctx context.Context, vkeID, nodePoolID string, updateReq *NodePoolReqUpdate) (*NodePool, *Response, error)
+	DeleteNodePool(ctx context.Context, vkeID, nodePoolID string) (*Response, error)
 
-	DeleteNodePoolInstance(ctx context.Context, vkeID, nodePoolID, nodeID string) error
-	RecycleNodePoolInstance(ctx context.Context, vkeID, nodePoolID, nodeID string) error
+	DeleteNodePoolInstance(ctx context.Context, vkeID, nodePoolID, nodeID string) (*Response, error)
+	RecycleNodePoolInstance(ctx context.Context, vkeID, nodePoolID, nodeID string) (*Response, error)
 
-	GetKubeConfig(ctx context.Context, vkeID string) (*KubeConfig, *http.Response, error)
-	GetVersions(ctx context.Context) (*Versions, *http.Response, error)
+	GetKubeConfig(ctx context.Context, vkeID string) (*KubeConfig, *Response, error)
+	GetVersions(ctx context.Context) (*Versions, *Response, error)
 
-	GetUpgrades(ctx context.Context, vkeID string) ([]string, *http.Response, error)
-	Upgrade(ctx context.Context, vkeID string, body *ClusterUpgradeReq) error
+	GetUpgrades(ctx context.Context, vkeID string) ([]string, *Response, error)
+	Upgrade(ctx context.Context, vkeID string, body *ClusterUpgradeReq) (*Response, error)
 }
 
 // KubernetesHandler handles interaction with the kubernetes methods for the Vultr API
@@ -45,7 +45,7 @@ type KubernetesHandler struct {
 type Cluster struct {
 	ID            string     `json:"id"`
 	Label         string     `json:"label"`
-	DateCreated   string     `json:"date_created"`
+	DateCreated   Timestamp  `json:"date_created"`
 	ClusterSubnet string     `json:"cluster_subnet"`
 	ServiceSubnet string     `json:"service_subnet"`
 	IP            string     `json:"ip"`
@@ -58,26 +58,26 @@ type Cluster struct {
 
 // NodePool represents a pool of nodes that are grouped by their label and plan type
 type NodePool struct {
-	ID           string `json:"id"`
-	DateCreated  string `json:"date_created"`
-	DateUpdated  string `json:"date_updated"`
-	Label        string `json:"label"`
-	Plan         string `json:"plan"`
-	Status       string `json:"status"`
-	NodeQuantity int    `json:"node_quantity"`
-	MinNodes     int    `json:"min_nodes"`
-	MaxNodes     int    `json:"max_nodes"`
-	AutoScaler   bool   `json:"auto_scaler"`
-	Tag          string `json:"tag"`
-	Nodes        []Node `json:"nodes"`
+	ID           string    `json:"id"`
+	DateCreated  Timestamp `json:"date_created"`
+	DateUpdated  Timestamp `json:"date_updated"`
+	Label        string    `json:"label"`
+	Plan         string    `json:"plan"`
+	Status       string    `json:"status"`
+	NodeQuantity int       `json:"node_quantity"`
+	MinNodes     int       `json:"min_nodes"`
+	MaxNodes     int       `json:"max_nodes"`
+	AutoScaler   bool      `json:"auto_scaler"`
+	Tag          string    `json:"tag"`
+	Nodes        []Node    `json:"nodes"`
 }
 
 // Node represents a node that will live within a nodepool
 type Node struct {
-	ID          string `json:"id"`
-	DateCreated string `json:"date_created"`
-	Label       string `json:"label"`
-	Status      string `json:"status"`
+	ID          string    `json:"id"`
+	DateCreated Timestamp `json:"date_created"`
+	Label       string    `json:"label"`
+	Status      string    `json:"status"`
 }
 
 // KubeConfig will contain the kubeconfig b64 encoded
@@ -93,9 +93,22 @@ type ClusterReq struct {
 	NodePools []NodePoolReq `json:"node_pools"`
 }
 
+// Validate checks that the region and version are given, and that the cluster has valid node pools
+func (r ClusterReq) Validate() error {
+	v := new(validation)
+	v.required("region", r.Region, "version", r.Version)
+	if len(r.NodePools) == 0 {
+		v.add("node_pools", "at least one node pool is required")
+	}
+	for i, pool := range r.NodePools {
+		pool.validate(v, fmt.Sprintf("node_pools[%d].", i))
+	}
+	return v.err()
+}
+
 // ClusterReqUpdate struct used to update update a cluster
 type ClusterReqUpdate struct {
-	Label string `json:"label"`
+	Label Optional[string] `json:"label,omitzero"`
 }
 
 // NodePoolReq struct used to create a node pool
@@ -109,13 +122,30 @@ type NodePoolReq struct {
 	AutoScaler   *bool  `json:"auto_scaler"`
 }
 
+// Validate checks that the label and plan are given, and the node counts
+func (r NodePoolReq) Validate() error {
+	v := new(validation)
+	r.validate(v, "")
+	return v.err()
+}
+
+func (r NodePoolReq) validate(v *validation, prefix string) {
+	v.required(prefix+"label", r.Label, prefix+"plan", r.Plan)
+	if r.NodeQuantity < 1 {
+		v.add(prefix+"node_quantity", "%d is less than 1", r.NodeQuantity)
+	}
+	if r.MaxNodes != 0 && r.MinNodes > r.MaxNodes {
+		v.add(prefix+"min_nodes", "%d is more than max_nodes %d", r.MinNodes, r.MaxNodes)
+	}
+}
+
 // NodePoolReqUpdate struct used to update a node pool
 type NodePoolReqUpdate struct {
-	NodeQuantity int     `json:"node_quantity,omitempty"`
-	Tag          *string `json:"tag,omitempty"`
-	MinNodes     int     `json:"min_nodes,omitempty"`
-	MaxNodes     int     `json:"max_nodes,omitempty"`
-	AutoScaler   *bool   `json:"auto_scaler,omitempty"`
+	NodeQuantity Optional[int]    `json:"node_quantity,omitzero"`
+	Tag          Optional[string] `json:"tag,omitzero"`
+	MinNodes     Optional[int]    `json:"min_nodes,omitzero"`
+	MaxNodes     Optional[int]    `json:"max_nodes,omitzero"`
+	AutoScaler   Optional[bool]   `json:"auto_scaler,omitzero"`
 }
 
 type vkeClustersBase struct {
@@ -151,8 +181,15 @@ type ClusterUpgradeReq struct {
 	UpgradeVersion string `json:"upgrade_version,omitempty"`
 }
 
+// Validate checks that the version to upgrade to is given
+func (r ClusterUpgradeReq) Validate() error {
+	v := new(validation)
+	v.required("upgrade_version", r.UpgradeVersion)
+	return v.err()
+}
+
 // CreateCluster will create a Kubernetes cluster.
-func (k *KubernetesHandler) CreateCluster(ctx context.Context, createReq *ClusterReq) (*Cluster, *http.Response, error) {
+func (k *KubernetesHandler) CreateCluster(ctx context.Context, createReq *ClusterReq) (*Cluster, *Response, error) {
 	req, err := k.client.NewRequest(ctx, http.MethodPost, vkePath, createReq)
 	if err != nil {
 		return nil, nil, err
@@ -168,7 +205,7 @@ func (k *KubernetesHandler) CreateCluster(ctx context.Context, createReq *Cluste
 }
 
 // GetCluster will return a Kubernetes cluster.
-func (k *KubernetesHandler) GetCluster(ctx context.Context, id string) (*Cluster, *http.Response, error) {
+func (k *KubernetesHandler) GetCluster(ctx context.Context, id string) (*Cluster, *Response, error) {
 	req, err := k.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s", vkePath, id), nil)
 	if err != nil {
 		return nil, nil, err
@@ -184,7 +221,7 @@ func (k *KubernetesHandler) GetCluster(ctx context.Context, id string) (*Cluster
 }
 
 // ListClusters will return all kubernetes clusters.
-func (k *KubernetesHandler) ListClusters(ctx context.Context, options *ListOptions) ([]Cluster, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (k *KubernetesHandler) ListClusters(ctx context.Context, options *ListOptions) ([]Cluster, *Meta, *Response, error) { //nolint:dupl,lll
 	req, err := k.client.NewRequest(ctx, http.MethodGet, vkePath, nil)
 	if err != nil {
 		return nil, nil, nil, err
@@ -207,38 +244,35 @@ func (k *KubernetesHandler) ListClusters(ctx context.Context, options *ListOptio
 }
 
 // UpdateCluster updates label on VKE cluster
-func (k *KubernetesHandler) UpdateCluster(ctx context.Context, vkeID string, updateReq *ClusterReqUpdate) error {
+func (k *KubernetesHandler) UpdateCluster(ctx context.Context, vkeID string, updateReq *ClusterReqUpdate) (*Response, error) {
 	req, err := k.client.NewRequest(ctx, http.MethodPut, fmt.Sprintf("%s/%s", vkePath, vkeID), updateReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = k.client.DoWithContext(ctx, req, nil)
-	return err
+	return k.client.DoWithContext(ctx, req, nil)
 }
 
 // DeleteCluster will delete a Kubernetes cluster.
-func (k *KubernetesHandler) DeleteCluster(ctx context.Context, id string) error {
+func (k *KubernetesHandler) DeleteCluster(ctx context.Context, id string) (*Response, error) {
 	req, err := k.client.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", vkePath, id), nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = k.client.DoWithContext(ctx, req, nil)
-	return err
+	return k.client.DoWithContext(ctx, req, nil)
 }
 
 // DeleteClusterWithResources will delete a Kubernetes cluster and all related resources.
-func (k *KubernetesHandler) DeleteClusterWithResources(ctx context.Context, id string) error {
+func (k *KubernetesHandler) DeleteClusterWithResources(ctx context.Context, id string) (*Response, error) {
 	req, err := k.client.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/%s/delete-with-linked-resources", vkePath, id), nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = k.client.DoWithContext(ctx, req, nil)
-	return err
+	return k.client.DoWithContext(ctx, req, nil)
 }
 
 // CreateNodePool creates a nodepool on a VKE cluster
-func (k *KubernetesHandler) CreateNodePool(ctx context.Context, vkeID string, nodePoolReq *NodePoolReq) (*NodePool, *http.Response, error) {
+func (k *KubernetesHandler) CreateNodePool(ctx context.Context, vkeID string, nodePoolReq *NodePoolReq) (*NodePool, *Response, error) {
 	req, err := k.client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("%s/%s/node-pools", vkePath, vkeID), nodePoolReq)
 	if err != nil {
 		return nil, nil, err
@@ -254,7 +288,7 @@ func (k *KubernetesHandler) CreateNodePool(ctx context.Context, vkeID string, no
 }
 
 // ListNodePools will return all nodepools for a given VKE cluster
-func (k *KubernetesHandler) ListNodePools(ctx context.Context, vkeID string, options *ListOptions) ([]NodePool, *Meta, *http.Response, error) { //nolint:lll
+func (k *KubernetesHandler) ListNodePools(ctx context.Context, vkeID string, options *ListOptions) ([]NodePool, *Meta, *Response, error) { //nolint:lll
 	req, err := k.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/node-pools", vkePath, vkeID), nil)
 	if err != nil {
 		return nil, nil, nil, err
@@ -277,7 +311,7 @@ func (k *KubernetesHandler) ListNodePools(ctx context.Context, vkeID string, opt
 }
 
 // GetNodePool will return a single nodepool
-func (k *KubernetesHandler) GetNodePool(ctx context.Context, vkeID, nodePoolID string) (*NodePool, *http.Response, error) {
+func (k *KubernetesHandler) GetNodePool(ctx context.Context, vkeID, nodePoolID string) (*NodePool, *Response, error) {
 	req, err := k.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/node-pools/%s", vkePath, vkeID, nodePoolID), nil)
 	if err != nil {
 		return nil, nil, err
@@ -293,7 +327,7 @@ func (k *KubernetesHandler) GetNodePool(ctx context.Context, vkeID, nodePoolID s
 }
 
 // UpdateNodePool will allow you change the quantity of nodes within a nodepool
-func (k *KubernetesHandler) UpdateNodePool(ctx context.Context, vkeID, nodePoolID string, updateReq *NodePoolReqUpdate) (*NodePool, *http.Response, error) { //nolint:lll
+func (k *KubernetesHandler) UpdateNodePool(ctx context.Context, vkeID, nodePoolID string, updateReq *NodePoolReqUpdate) (*NodePool, *Response, error) { //nolint:lll
 	req, err := k.client.NewRequest(ctx, http.MethodPatch, fmt.Sprintf("%s/%s/node-pools/%s", vkePath, vkeID, nodePoolID), updateReq)
 	if err != nil {
 		return nil, nil, err
@@ -309,18 +343,17 @@ func (k *KubernetesHandler) UpdateNodePool(ctx context.Context, vkeID, nodePoolI
 }
 
 // DeleteNodePool will remove a nodepool from a VKE cluster
-func (k *KubernetesHandler) DeleteNodePool(ctx context.Context, vkeID, nodePoolID string) error {
+func (k *KubernetesHandler) DeleteNodePool(ctx context.Context, vkeID, nodePoolID string) (*Response, error) {
 	req, err := k.client.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/%s/node-pools/%s", vkePath, vkeID, nodePoolID), nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = k.client.DoWithContext(ctx, req, nil)
-	return err
+	return k.client.DoWithContext(ctx, req, nil)
 }
 
 // DeleteNodePoolInstance will remove a specified node from a nodepool
-func (k *KubernetesHandler) DeleteNodePoolInstance(ctx context.Context, vkeID, nodePoolID, nodeID string) error {
+func (k *KubernetesHandler) DeleteNodePoolInstance(ctx context.Context, vkeID, nodePoolID, nodeID string) (*Response, error) {
 	req, err := k.client.NewRequest(
 		ctx,
 		http.MethodDelete,
@@ -328,15 +361,14 @@ func (k *KubernetesHandler) DeleteNodePoolInstance(ctx context.Context, vkeID, n
 		nil,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = k.client.DoWithContext(ctx, req, nil)
-	return err
+	return k.client.DoWithContext(ctx, req, nil)
 }
 
 // RecycleNodePoolInstance will recycle (destroy + redeploy) a given node on a nodepool
-func (k *KubernetesHandler) RecycleNodePoolInstance(ctx context.Context, vkeID, nodePoolID, nodeID string) error {
+func (k *KubernetesHandler) RecycleNodePoolInstance(ctx context.Context, vkeID, nodePoolID, nodeID string) (*Response, error) {
 	req, err := k.client.NewRequest(
 		ctx,
 		http.MethodPost,
@@ -344,15 +376,14 @@ func (k *KubernetesHandler) RecycleNodePoolInstance(ctx context.Context, vkeID,
 		nil,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = k.client.DoWithContext(ctx, req, nil)
-	return err
+	return k.client.DoWithContext(ctx, req, nil)
 }
 
 // GetKubeConfig returns the kubeconfig for the specified VKE cluster
-func (k *KubernetesHandler) GetKubeConfig(ctx context.Context, vkeID string) (*KubeConfig, *http.Response, error) {
+func (k *KubernetesHandler) GetKubeConfig(ctx context.Context, vkeID string) (*KubeConfig, *Response, error) {
 	req, err := k.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/config", vkePath, vkeID), nil)
 	if err != nil {
 		return nil, nil, err
@@ -368,7 +399,7 @@ func (k *KubernetesHandler) GetKubeConfig(ctx context.Context, vkeID string) (*K
 }
 
 // GetVersions returns the supported kubernetes versions
-func (k *KubernetesHandler) GetVersions(ctx context.Context) (*Versions, *http.Response, error) {
+func (k *KubernetesHandler) GetVersions(ctx context.Context) (*Versions, *Response, error) {
 	uri := "/v2/kubernetes/versions"
 	req, err := k.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -385,7 +416,7 @@ func (k *KubernetesHandler) GetVersions(ctx context.Context) (*Versions, *http.R
 }
 
 // GetUpgrades returns all version a VKE cluster can upgrade to
-func (k *KubernetesHandler) GetUpgrades(ctx context.Context, vkeID string) ([]string, *http.Response, error) {
+func (k *KubernetesHandler) GetUpgrades(ctx context.Context, vkeID string) ([]string, *Response, error) {
 	req, err := k.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s/available-upgrades", vkePath, vkeID), nil)
 	if err != nil {
 		return nil, nil, err
@@ -401,12 +432,11 @@ func (k *KubernetesHandler) GetUpgrades(ctx context.Context, vkeID string) ([]st
 }
 
 // Upgrade beings a VKE cluster upgrade
-func (k *KubernetesHandler) Upgrade(ctx context.Context, vkeID string, body *ClusterUpgradeReq) error {
+func (k *KubernetesHandler) Upgrade(ctx context.Context, vkeID string, body *ClusterUpgradeReq) (*Response, error) {
 	req, err := k.client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("%s/%s/upgrades", vkePath, vkeID), body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = k.client.DoWithContext(ctx, req, nil)
-	return err
+	return k.client.DoWithContext(ctx, req, nil)
 }
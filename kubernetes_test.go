@@ -56,7 +56,7 @@ func TestKubernetesHandler_CreateCluster(t *testing.T) {
 		Label:     "vke",
 		Region:    "lax",
 		Version:   "1.20",
-		NodePools: nil,
+		NodePools: []NodePoolReq{{NodeQuantity: 1, Label: "my-label-48957292", Plan: "vc2-1c-2gb"}},
 	}
 	vke, _, err := client.Kubernetes.CreateCluster(ctx, createReq)
 	if err != nil {
@@ -66,7 +66,7 @@ func TestKubernetesHandler_CreateCluster(t *testing.T) {
 	expected := &Cluster{
 		ID:            "014da059-21e3-47eb-acb5-91bf697c31aa",
 		Label:         "vke",
-		DateCreated:   "2021-07-13T14:20:16+00:00",
+		DateCreated:   timestamp("2021-07-13T14:20:16+00:00"),
 		ClusterSubnet: "10.244.0.0/16",
 		ServiceSubnet: "10.96.0.0/12",
 		IP:            "0.0.0.0",
@@ -77,7 +77,7 @@ func TestKubernetesHandler_CreateCluster(t *testing.T) {
 		NodePools: []NodePool{
 			{
 				ID:           "e1c7a313-e42d-43bb-82ef-4f287639b303",
-				DateCreated:  "2021-07-13T14:20:16+00:00",
+				DateCreated:  timestamp("2021-07-13T14:20:16+00:00"),
 				Label:        "my-label-48957292",
 				Plan:         "vc2-1c-2gb",
 				Status:       "pending",
@@ -88,7 +88,7 @@ func TestKubernetesHandler_CreateCluster(t *testing.T) {
 				Nodes: []Node{
 					{
 						ID:          "38364f79-17e3-4f1f-b7df-d9494bce0e4a",
-						DateCreated: "2021-07-13T14:20:16+00:00",
+						DateCreated: timestamp("2021-07-13T14:20:16+00:00"),
 						Label:       "my-label-48957292-fef60eda12071",
 						Status:      "pending",
 					},
@@ -160,7 +160,7 @@ func TestKubernetesHandler_GetCluster(t *testing.T) {
 	expected := &Cluster{
 		ID:            "014da059-21e3-47eb-acb5-91bf697c31aa",
 		Label:         "vke",
-		DateCreated:   "2021-07-13T14:20:16+00:00",
+		DateCreated:   timestamp("2021-07-13T14:20:16+00:00"),
 		ClusterSubnet: "10.244.0.0/16",
 		ServiceSubnet: "10.96.0.0/12",
 		IP:            "0.0.0.0",
@@ -171,7 +171,7 @@ func TestKubernetesHandler_GetCluster(t *testing.T) {
 		NodePools: []NodePool{
 			{
 				ID:           "e1c7a313-e42d-43bb-82ef-4f287639b303",
-				DateCreated:  "2021-07-13T14:20:16+00:00",
+				DateCreated:  timestamp("2021-07-13T14:20:16+00:00"),
 				Label:        "my-label-48957292",
 				Plan:         "vc2-1c-2gb",
 				Status:       "pending",
@@ -182,7 +182,7 @@ func TestKubernetesHandler_GetCluster(t *testing.T) {
 				Nodes: []Node{
 					{
 						ID:          "38364f79-17e3-4f1f-b7df-d9494bce0e4a",
-						DateCreated: "2021-07-13T14:20:16+00:00",
+						DateCreated: timestamp("2021-07-13T14:20:16+00:00"),
 						Label:       "my-label-48957292-fef60eda12071",
 						Status:      "pending",
 					},
@@ -264,7 +264,7 @@ func TestKubernetesHandler_ListClusters(t *testing.T) {
 		{
 			ID:            "014da059-21e3-47eb-acb5-91bf697c31aa",
 			Label:         "vke",
-			DateCreated:   "2021-07-13T14:20:16+00:00",
+			DateCreated:   timestamp("2021-07-13T14:20:16+00:00"),
 			ClusterSubnet: "10.244.0.0/16",
 			ServiceSubnet: "10.96.0.0/12",
 			IP:            "0.0.0.0",
@@ -275,7 +275,7 @@ func TestKubernetesHandler_ListClusters(t *testing.T) {
 			NodePools: []NodePool{
 				{
 					ID:           "e1c7a313-e42d-43bb-82ef-4f287639b303",
-					DateCreated:  "2021-07-13T14:20:16+00:00",
+					DateCreated:  timestamp("2021-07-13T14:20:16+00:00"),
 					Label:        "my-label-48957292",
 					Plan:         "vc2-1c-2gb",
 					Status:       "pending",
@@ -287,7 +287,7 @@ func TestKubernetesHandler_ListClusters(t *testing.T) {
 					Nodes: []Node{
 						{
 							ID:          "38364f79-17e3-4f1f-b7df-d9494bce0e4a",
-							DateCreated: "2021-07-13T14:20:16+00:00",
+							DateCreated: timestamp("2021-07-13T14:20:16+00:00"),
 							Label:       "my-label-48957292-fef60eda12071",
 							Status:      "pending",
 						},
@@ -325,8 +325,8 @@ func TestKubernetesHandler_UpdateCluster(t *testing.T) {
 	mux.HandleFunc(fmt.Sprintf("%s/%s", vkePath, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33"), func(writer http.ResponseWriter, request *http.Request) {
 		fmt.Fprint(writer)
 	})
-	update := ClusterReqUpdate{Label: "new label"}
-	err := client.Kubernetes.UpdateCluster(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", &update)
+	update := ClusterReqUpdate{Label: NewOptional("new label")}
+	_, err := client.Kubernetes.UpdateCluster(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", &update)
 
 	if err != nil {
 		t.Errorf("Kubernetes.UpdateCluster returned %+v", err)
@@ -341,7 +341,7 @@ func TestKubernetesHandler_DeleteCluster(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.Kubernetes.DeleteCluster(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
+	_, err := client.Kubernetes.DeleteCluster(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
 	if err != nil {
 		t.Errorf("Kubernetes.DeleteCluster returned %+v", err)
 	}
@@ -355,7 +355,7 @@ func TestKubernetesHandler_DeleteClusterWithResources(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.Kubernetes.DeleteClusterWithResources(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
+	_, err := client.Kubernetes.DeleteClusterWithResources(ctx, "14b3e7d6-ffb5-4994-8502-57fcd9db3b33")
 	if err != nil {
 		t.Errorf("Kubernetes.DeleteClusterWithResources returned %+v", err)
 	}
@@ -404,7 +404,7 @@ func TestKubernetesHandler_CreateNodePool(t *testing.T) {
 
 	expected := &NodePool{
 		ID:           "554e7248-705a-5862-516f-4f4a6735346a",
-		DateCreated:  "2021-07-13T15:42:21+00:00",
+		DateCreated:  timestamp("2021-07-13T15:42:21+00:00"),
 		Label:        "nodepool-48959140",
 		Plan:         "vc2-1c-2gb",
 		Status:       "pending",
@@ -417,7 +417,7 @@ func TestKubernetesHandler_CreateNodePool(t *testing.T) {
 			{
 				ID:          "3e1ca1e0-25be-4977-907a-3dee42b9bb15",
 				Label:       "nodepool-48959140-74a60edb45de0",
-				DateCreated: "2021-07-13T15:42:21+00:00",
+				DateCreated: timestamp("2021-07-13T15:42:21+00:00"),
 				Status:      "pending",
 			},
 		},
@@ -472,7 +472,7 @@ func TestKubernetesHandler_GetNodePool(t *testing.T) {
 
 	expected := &NodePool{
 		ID:           "554e7248-705a-5862-516f-4f4a6735346a",
-		DateCreated:  "2021-07-13T15:42:21+00:00",
+		DateCreated:  timestamp("2021-07-13T15:42:21+00:00"),
 		Label:        "nodepool-48959140",
 		Plan:         "vc2-1c-2gb",
 		Status:       "pending",
@@ -485,7 +485,7 @@ func TestKubernetesHandler_GetNodePool(t *testing.T) {
 			{
 				ID:          "3e1ca1e0-25be-4977-907a-3dee42b9bb15",
 				Label:       "nodepool-48959140-74a60edb45de0",
-				DateCreated: "2021-07-13T15:42:21+00:00",
+				DateCreated: timestamp("2021-07-13T15:42:21+00:00"),
 				Status:      "pending",
 			},
 		},
@@ -548,7 +548,7 @@ func TestKubernetesHandler_ListNodePools(t *testing.T) {
 	expected := []NodePool{
 		{
 			ID:           "554e7248-705a-5862-516f-4f4a6735346a",
-			DateCreated:  "2021-07-13T15:42:21+00:00",
+			DateCreated:  timestamp("2021-07-13T15:42:21+00:00"),
 			Label:        "nodepool-48959140",
 			Plan:         "vc2-1c-2gb",
 			Status:       "pending",
@@ -561,7 +561,7 @@ func TestKubernetesHandler_ListNodePools(t *testing.T) {
 				{
 					ID:          "3e1ca1e0-25be-4977-907a-3dee42b9bb15",
 					Label:       "nodepool-48959140-74a60edb45de0",
-					DateCreated: "2021-07-13T15:42:21+00:00",
+					DateCreated: timestamp("2021-07-13T15:42:21+00:00"),
 					Status:      "pending",
 				},
 			},
@@ -621,7 +621,7 @@ func TestKubernetesHandler_UpdateNodePool(t *testing.T) {
 }`
 		fmt.Fprint(writer, response)
 	})
-	update := NodePoolReqUpdate{NodeQuantity: 1}
+	update := NodePoolReqUpdate{NodeQuantity: NewOptional(1)}
 	response, _, err := client.Kubernetes.UpdateNodePool(ctx, "1", "2", &update)
 	if err != nil {
 		t.Errorf("Kubernetes.UpdateNodePool returned %+v", err)
@@ -629,8 +629,8 @@ func TestKubernetesHandler_UpdateNodePool(t *testing.T) {
 
 	expected := &NodePool{
 		ID:           "e97bdee9-2781-4f31-be03-60fc75f399ae",
-		DateCreated:  "2021-07-07T23:27:08+00:00",
-		DateUpdated:  "2021-07-08T12:12:44+00:00",
+		DateCreated:  timestamp("2021-07-07T23:27:08+00:00"),
+		DateUpdated:  timestamp("2021-07-08T12:12:44+00:00"),
 		Label:        "my-label-48770703",
 		Plan:         "vc2-1c-2gb",
 		Status:       "active",
@@ -642,7 +642,7 @@ func TestKubernetesHandler_UpdateNodePool(t *testing.T) {
 		Nodes: []Node{
 			{
 				ID:          "f2e11430-76e5-4dc6-a1c9-ef5682c21ddf",
-				DateCreated: "2021-07-07T23:27:08+00:00",
+				DateCreated: timestamp("2021-07-07T23:27:08+00:00"),
 				Label:       "my-label-48770703-44060e6384c45",
 				Status:      "active",
 			},
@@ -669,7 +669,7 @@ func TestKubernetesHandler_DeleteNodePool(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.Kubernetes.DeleteNodePool(ctx, "1", "2")
+	_, err := client.Kubernetes.DeleteNodePool(ctx, "1", "2")
 	if err != nil {
 		t.Errorf("Kubernetes.DeleteNodePool returned %+v", err)
 	}
@@ -683,7 +683,7 @@ func TestKubernetesHandler_DeleteNodePoolInstance(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.Kubernetes.DeleteNodePoolInstance(ctx, "1", "2", "3")
+	_, err := client.Kubernetes.DeleteNodePoolInstance(ctx, "1", "2", "3")
 	if err != nil {
 		t.Errorf("Kubernetes.DeleteNodePoolInstance returned %+v", err)
 	}
@@ -697,7 +697,7 @@ func TestKubernetesHandler_RecycleNodePoolInstance(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.Kubernetes.RecycleNodePoolInstance(ctx, "1", "2", "3")
+	_, err := client.Kubernetes.RecycleNodePoolInstance(ctx, "1", "2", "3")
 	if err != nil {
 		t.Errorf("Kubernetes.RecycleNodePoolInstance returned %+v", err)
 	}
@@ -793,7 +793,7 @@ func TestKubernetesHandler_Upgrade(t *testing.T) {
 	})
 
 	req := &ClusterUpgradeReq{UpgradeVersion: "2"}
-	err := client.Kubernetes.Upgrade(ctx, "1", req)
+	_, err := client.Kubernetes.Upgrade(ctx, "1", req)
 	if err != nil {
 		t.Errorf("Kubernetes.StartUpgrade returned %+v", err)
 	}
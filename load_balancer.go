@@ -13,17 +13,17 @@ const lbPath = "/v2/load-balancers"
 // LoadBalancerService is the interface to interact with the server endpoints on the Vultr API
 // Link : https://www.vultr.com/api/#tag/load-balancer
 type LoadBalancerService interface {
-	Create(ctx context.Context, createReq *LoadBalancerReq) (*LoadBalancer, *http.Response, error)
-	Get(ctx context.Context, lbID string) (*LoadBalancer, *http.Response, error)
-	Update(ctx context.Context, lbID string, updateReq *LoadBalancerReq) error
-	Delete(ctx context.Context, lbID string) error
-	List(ctx context.Context, options *ListOptions) ([]LoadBalancer, *Meta, *http.Response, error)
-	CreateForwardingRule(ctx context.Context, lbID string, rule *ForwardingRule) (*ForwardingRule, *http.Response, error)
-	GetForwardingRule(ctx context.Context, lbID string, ruleID string) (*ForwardingRule, *http.Response, error)
-	DeleteForwardingRule(ctx context.Context, lbID string, RuleID string) error
-	ListForwardingRules(ctx context.Context, lbID string, options *ListOptions) ([]ForwardingRule, *Meta, *http.Response, error)
-	ListFirewallRules(ctx context.Context, lbID string, options *ListOptions) ([]LBFirewallRule, *Meta, *http.Response, error)
-	GetFirewallRule(ctx context.Context, lbID string, ruleID string) (*LBFirewallRule, *http.Response, error)
+	Create(ctx context.Context, createReq *LoadBalancerReq) (*LoadBalancer, *Response, error)
+	Get(ctx context.Context, lbID string) (*LoadBalancer, *Response, error)
+	Update(ctx context.Context, lbID string, updateReq *LoadBalancerReq) (*Response, error)
+	Delete(ctx context.Context, lbID string) (*Response, error)
+	List(ctx context.Context, options *ListOptions) ([]LoadBalancer, *Meta, *Response, error)
+	CreateForwardingRule(ctx context.Context, lbID string, rule *ForwardingRule) (*ForwardingRule, *Response, error)
+	GetForwardingRule(ctx context.Context, lbID string, ruleID string) (*ForwardingRule, *Response, error)
+	DeleteForwardingRule(ctx context.Context, lbID string, RuleID string) (*Response, error)
+	ListForwardingRules(ctx context.Context, lbID string, options *ListOptions) ([]ForwardingRule, *Meta, *Response, error)
+	ListFirewallRules(ctx context.Context, lbID string, options *ListOptions) ([]LBFirewallRule, *Meta, *Response, error)
+	GetFirewallRule(ctx context.Context, lbID string, ruleID string) (*LBFirewallRule, *Response, error)
 }
 
 // LoadBalancerHandler handles interaction with the server methods for the Vultr API
@@ -34,7 +34,7 @@ type LoadBalancerHandler struct {
 // LoadBalancer represent the structure of a load balancer
 type LoadBalancer struct {
 	ID              string           `json:"id,omitempty"`
-	DateCreated     string           `json:"date_created,omitempty"`
+	DateCreated     Timestamp        `json:"date_created,omitzero"`
 	Region          string           `json:"region,omitempty"`
 	Label           string           `json:"label,omitempty"`
 	Status          string           `json:"status,omitempty"`
@@ -51,23 +51,85 @@ type LoadBalancer struct {
 
 // LoadBalancerReq gives options for creating or updating a load balancer
 type LoadBalancerReq struct {
-	Region             string           `json:"region,omitempty"`
-	Label              string           `json:"label,omitempty"`
-	Instances          []string         `json:"instances,omitempty"`
-	Nodes              int              `json:"nodes,omitempty"`
-	HealthCheck        *HealthCheck     `json:"health_check,omitempty"`
-	StickySessions     *StickySessions  `json:"sticky_session,omitempty"`
-	ForwardingRules    []ForwardingRule `json:"forwarding_rules,omitempty"`
-	SSL                *SSL             `json:"ssl,omitempty"`
-	SSLRedirect        *bool            `json:"ssl_redirect,omitempty"`
-	ProxyProtocol      *bool            `json:"proxy_protocol,omitempty"`
-	BalancingAlgorithm string           `json:"balancing_algorithm,omitempty"`
-	FirewallRules      []LBFirewallRule `json:"firewall_rules"`
+	Region             string             `json:"region,omitempty"`
+	Label              string             `json:"label,omitempty"`
+	Instances          []string           `json:"instances,omitempty"`
+	Nodes              int                `json:"nodes,omitempty"`
+	HealthCheck        *HealthCheck       `json:"health_check,omitempty"`
+	StickySessions     *StickySessions    `json:"sticky_session,omitempty"`
+	ForwardingRules    []ForwardingRule   `json:"forwarding_rules,omitempty"`
+	SSL                *SSL               `json:"ssl,omitempty"`
+	SSLRedirect        *bool              `json:"ssl_redirect,omitempty"`
+	ProxyProtocol      *bool              `json:"proxy_protocol,omitempty"`
+	BalancingAlgorithm BalancingAlgorithm `json:"balancing_algorithm,omitempty"`
+	FirewallRules      []LBFirewallRule   `json:"firewall_rules"`
 	// Deprecated:  PrivateNetwork should no longer be used. Instead, use VPC.
 	PrivateNetwork *string `json:"private_network,omitempty"`
 	VPC            *string `json:"vpc,omitempty"`
 }
 
+// Validate checks the balancing algorithm and the protocols and ports of the forwarding rules
+func (r LoadBalancerReq) Validate() error {
+	v := new(validation)
+	if r.BalancingAlgorithm != "" && !r.BalancingAlgorithm.IsValid() {
+		v.add("balancing_algorithm", "%q is not a known balancing algorithm", r.BalancingAlgorithm)
+	}
+
+	for i, rule := range r.ForwardingRules {
+		field := fmt.Sprintf("forwarding_rules[%d].", i)
+		if !rule.FrontendProtocol.IsValid() {
+			v.add(field+"frontend_protocol", "%q is not a known protocol", rule.FrontendProtocol)
+		}
+		if !rule.BackendProtocol.IsValid() {
+			v.add(field+"backend_protocol", "%q is not a known protocol", rule.BackendProtocol)
+		}
+		if rule.FrontendPort < 1 || rule.FrontendPort > 65535 {
+			v.add(field+"frontend_port", "%d is outside of 1-65535", rule.FrontendPort)
+		}
+		if rule.BackendPort < 1 || rule.BackendPort > 65535 {
+			v.add(field+"backend_port", "%d is outside of 1-65535", rule.BackendPort)
+		}
+	}
+	return v.err()
+}
+
+// BalancingAlgorithm is how a load balancer spreads requests across its instances
+type BalancingAlgorithm string
+
+// Balancing algorithms
+const (
+	BalancingRoundRobin BalancingAlgorithm = "roundrobin"
+	BalancingLeastConn  BalancingAlgorithm = "leastconn"
+)
+
+// IsValid reports whether a is a known balancing algorithm
+func (a BalancingAlgorithm) IsValid() bool {
+	switch a {
+	case BalancingRoundRobin, BalancingLeastConn:
+		return true
+	}
+	return false
+}
+
+// LBProtocol is a protocol of the forwarding rules and health checks of a load balancer
+type LBProtocol string
+
+// Load balancer protocols
+const (
+	LBProtocolHTTP  LBProtocol = "http"
+	LBProtocolHTTPS LBProtocol = "https"
+	LBProtocolTCP   LBProtocol = "tcp"
+)
+
+// IsValid reports whether p is a known load balancer protocol
+func (p LBProtocol) IsValid() bool {
+	switch p {
+	case LBProtocolHTTP, LBProtocolHTTPS, LBProtocolTCP:
+		return true
+	}
+	return false
+}
+
 // InstanceList represents instances that are attached to your load balancer
 type InstanceList struct {
 	InstanceList []string
@@ -75,21 +137,21 @@ type InstanceList struct {
 
 // HealthCheck represents your health check configuration for your load balancer.
 type HealthCheck struct {
-	Protocol           string `json:"protocol,omitempty"`
-	Port               int    `json:"port,omitempty"`
-	Path               string `json:"path,omitempty"`
-	CheckInterval      int    `json:"check_interval,omitempty"`
-	ResponseTimeout    int    `json:"response_timeout,omitempty"`
-	UnhealthyThreshold int    `json:"unhealthy_threshold,omitempty"`
-	HealthyThreshold   int    `json:"healthy_threshold,omitempty"`
+	Protocol           LBProtocol `json:"protocol,omitempty"`
+	Port               int        `json:"port,omitempty"`
+	Path               string     `json:"path,omitempty"`
+	CheckInterval      int        `json:"check_interval,omitempty"`
+	ResponseTimeout    int        `json:"response_timeout,omitempty"`
+	UnhealthyThreshold int        `json:"unhealthy_threshold,omitempty"`
+	HealthyThreshold   int        `json:"healthy_threshold,omitempty"`
 }
 
 // GenericInfo represents generic configuration of your load balancer
 type GenericInfo struct {
-	BalancingAlgorithm string          `json:"balancing_algorithm,omitempty"`
-	SSLRedirect        *bool           `json:"ssl_redirect,omitempty"`
-	StickySessions     *StickySessions `json:"sticky_sessions,omitempty"`
-	ProxyProtocol      *bool           `json:"proxy_protocol,omitempty"`
+	BalancingAlgorithm BalancingAlgorithm `json:"balancing_algorithm,omitempty"`
+	SSLRedirect        *bool              `json:"ssl_redirect,omitempty"`
+	StickySessions     *StickySessions    `json:"sticky_sessions,omitempty"`
+	ProxyProtocol      *bool              `json:"proxy_protocol,omitempty"`
 	// Deprecated:  PrivateNetwork should no longer be used. Instead, use VPC.
 	PrivateNetwork string `json:"private_network,omitempty"`
 	VPC            string `json:"vpc,omitempty"`
@@ -107,18 +169,18 @@ type ForwardingRules struct {
 
 // ForwardingRule represent a single forwarding rule
 type ForwardingRule struct {
-	RuleID           string `json:"id,omitempty"`
-	FrontendProtocol string `json:"frontend_protocol,omitempty"`
-	FrontendPort     int    `json:"frontend_port,omitempty"`
-	BackendProtocol  string `json:"backend_protocol,omitempty"`
-	BackendPort      int    `json:"backend_port,omitempty"`
+	RuleID           string     `json:"id,omitempty"`
+	FrontendProtocol LBProtocol `json:"frontend_protocol,omitempty"`
+	FrontendPort     int        `json:"frontend_port,omitempty"`
+	BackendProtocol  LBProtocol `json:"backend_protocol,omitempty"`
+	BackendPort      int        `json:"backend_port,omitempty"`
 }
 
 // LBFirewallRule represent a single firewall rule
 type LBFirewallRule struct {
 	RuleID string `json:"id,omitempty"`
 	Port   int    `json:"port,omitempty"`
-	IPType string `json:"ip_type,omitempty"`
+	IPType IPType `json:"ip_type,omitempty"`
 	Source string `json:"source,omitempty"`
 }
 
@@ -157,7 +219,7 @@ type lbFWRuleBase struct {
 }
 
 // Create a load balancer
-func (l *LoadBalancerHandler) Create(ctx context.Context, createReq *LoadBalancerReq) (*LoadBalancer, *http.Response, error) {
+func (l *LoadBalancerHandler) Create(ctx context.Context, createReq *LoadBalancerReq) (*LoadBalancer, *Response, error) {
 	req, err := l.client.NewRequest(ctx, http.MethodPost, lbPath, createReq)
 	if err != nil {
 		return nil, nil, err
@@ -173,7 +235,7 @@ func (l *LoadBalancerHandler) Create(ctx context.Context, createReq *LoadBalance
 }
 
 // Get a load balancer
-func (l *LoadBalancerHandler) Get(ctx context.Context, lbID string) (*LoadBalancer, *http.Response, error) {
+func (l *LoadBalancerHandler) Get(ctx context.Context, lbID string) (*LoadBalancer, *Response, error) {
 	uri := fmt.Sprintf("%s/%s", lbPath, lbID)
 	req, err := l.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -190,30 +252,28 @@ func (l *LoadBalancerHandler) Get(ctx context.Context, lbID string) (*LoadBalanc
 }
 
 // Update updates your your load balancer
-func (l *LoadBalancerHandler) Update(ctx context.Context, lbID string, updateReq *LoadBalancerReq) error {
+func (l *LoadBalancerHandler) Update(ctx context.Context, lbID string, updateReq *LoadBalancerReq) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s", lbPath, lbID)
 	req, err := l.client.NewRequest(ctx, http.MethodPatch, uri, updateReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = l.client.DoWithContext(ctx, req, nil)
-	return err
+	return l.client.DoWithContext(ctx, req, nil)
 }
 
 // Delete a load balancer subscription.
-func (l *LoadBalancerHandler) Delete(ctx context.Context, lbID string) error {
+func (l *LoadBalancerHandler) Delete(ctx context.Context, lbID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s", lbPath, lbID)
 	req, err := l.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = l.client.DoWithContext(ctx, req, nil)
-	return err
+	return l.client.DoWithContext(ctx, req, nil)
 }
 
 // List all load balancer subscriptions on the current account.
-func (l *LoadBalancerHandler) List(ctx context.Context, options *ListOptions) ([]LoadBalancer, *Meta, *http.Response, error) { //nolint:dupl
+func (l *LoadBalancerHandler) List(ctx context.Context, options *ListOptions) ([]LoadBalancer, *Meta, *Response, error) { //nolint:dupl
 	req, err := l.client.NewRequest(ctx, http.MethodGet, lbPath, nil)
 	if err != nil {
 		return nil, nil, nil, err
@@ -237,7 +297,7 @@ func (l *LoadBalancerHandler) List(ctx context.Context, options *ListOptions) ([
 
 // CreateForwardingRule will create a new forwarding rule for your load balancer subscription.
 // Note the RuleID will be returned in the ForwardingRule struct
-func (l *LoadBalancerHandler) CreateForwardingRule(ctx context.Context, lbID string, rule *ForwardingRule) (*ForwardingRule, *http.Response, error) { //nolint:lll
+func (l *LoadBalancerHandler) CreateForwardingRule(ctx context.Context, lbID string, rule *ForwardingRule) (*ForwardingRule, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s/forwarding-rules", lbPath, lbID)
 	req, err := l.client.NewRequest(ctx, http.MethodPost, uri, rule)
 	if err != nil {
@@ -254,7 +314,7 @@ func (l *LoadBalancerHandler) CreateForwardingRule(ctx context.Context, lbID str
 }
 
 // GetForwardingRule will get a forwarding rule from your load balancer subscription.
-func (l *LoadBalancerHandler) GetForwardingRule(ctx context.Context, lbID, ruleID string) (*ForwardingRule, *http.Response, error) {
+func (l *LoadBalancerHandler) GetForwardingRule(ctx context.Context, lbID, ruleID string) (*ForwardingRule, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/forwarding-rules/%s", lbPath, lbID, ruleID)
 	req, err := l.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -271,7 +331,7 @@ func (l *LoadBalancerHandler) GetForwardingRule(ctx context.Context, lbID, ruleI
 }
 
 // ListForwardingRules lists all forwarding rules for a load balancer subscription
-func (l *LoadBalancerHandler) ListForwardingRules(ctx context.Context, lbID string, options *ListOptions) ([]ForwardingRule, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (l *LoadBalancerHandler) ListForwardingRules(ctx context.Context, lbID string, options *ListOptions) ([]ForwardingRule, *Meta, *Response, error) { //nolint:dupl,lll
 	uri := fmt.Sprintf("%s/%s/forwarding-rules", lbPath, lbID)
 	req, err := l.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -295,19 +355,18 @@ func (l *LoadBalancerHandler) ListForwardingRules(ctx context.Context, lbID stri
 }
 
 // DeleteForwardingRule removes a forwarding rule from a load balancer subscription
-func (l *LoadBalancerHandler) DeleteForwardingRule(ctx context.Context, lbID, ruleID string) error {
+func (l *LoadBalancerHandler) DeleteForwardingRule(ctx context.Context, lbID, ruleID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/forwarding-rules/%s", lbPath, lbID, ruleID)
 	req, err := l.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = l.client.DoWithContext(ctx, req, nil)
-	return err
+	return l.client.DoWithContext(ctx, req, nil)
 }
 
 // GetFirewallRule will get a firewall rule from your load balancer subscription.
-func (l *LoadBalancerHandler) GetFirewallRule(ctx context.Context, lbID, ruleID string) (*LBFirewallRule, *http.Response, error) {
+func (l *LoadBalancerHandler) GetFirewallRule(ctx context.Context, lbID, ruleID string) (*LBFirewallRule, *Response, error) {
 	uri := fmt.Sprintf("%s/%s/firewall-rules/%s", lbPath, lbID, ruleID)
 	req, err := l.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -324,7 +383,7 @@ func (l *LoadBalancerHandler) GetFirewallRule(ctx context.Context, lbID, ruleID
 }
 
 // ListFirewallRules lists all firewall rules for a load balancer subscription
-func (l *LoadBalancerHandler) ListFirewallRules(ctx context.Context, lbID string, options *ListOptions) ([]LBFirewallRule, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (l *LoadBalancerHandler) ListFirewallRules(ctx context.Context, lbID string, options *ListOptions) ([]LBFirewallRule, *Meta, *Response, error) { //nolint:dupl,lll
 	uri := fmt.Sprintf("%s/%s/firewall-rules", lbPath, lbID)
 	req, err := l.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -87,7 +87,7 @@ func TestLoadBalancerHandler_List(t *testing.T) {
 	expected := []LoadBalancer{
 		{
 			ID:          "1317575",
-			DateCreated: "2020-01-07 17:24:23",
+			DateCreated: timestamp("2020-01-07 17:24:23"),
 			Label:       "my label",
 			Status:      "active",
 			Region:      "ewr",
@@ -158,7 +158,7 @@ func TestLoadBalancerHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	if err := client.LoadBalancer.Delete(ctx, "1317575"); err != nil {
+	if _, err := client.LoadBalancer.Delete(ctx, "1317575"); err != nil {
 		t.Errorf("LoadBalancer.Delete returned %+v", err)
 	}
 }
@@ -234,7 +234,7 @@ func TestLoadBalancerHandler_Get(t *testing.T) {
 
 	expected := &LoadBalancer{
 		ID:          "1317575",
-		DateCreated: "2020-01-07 17:24:23",
+		DateCreated: timestamp("2020-01-07 17:24:23"),
 		Label:       "my label",
 		Status:      "active",
 		Region:      "ewr",
@@ -352,7 +352,7 @@ func TestLoadBalancerHandler_DeleteForwardingRule(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	if err := client.LoadBalancer.DeleteForwardingRule(ctx, "12345", "abcde1234"); err != nil {
+	if _, err := client.LoadBalancer.DeleteForwardingRule(ctx, "12345", "abcde1234"); err != nil {
 		t.Errorf("LoadBalancer.DeleteForwardingRule returned %+v", err)
 	}
 }
@@ -502,7 +502,7 @@ func TestLoadBalancerHandler_Create(t *testing.T) {
 
 	expected := &LoadBalancer{
 		ID:          "1317575",
-		DateCreated: "2020-01-07 17:24:23",
+		DateCreated: timestamp("2020-01-07 17:24:23"),
 		Label:       "my label",
 		Status:      "active",
 		Region:      "ewr",
@@ -591,7 +591,7 @@ func TestLoadBalancerHandler_Update(t *testing.T) {
 		},
 	}
 
-	err := client.LoadBalancer.Update(ctx, "d9dbc01c-aaca-4d4b-8c4a-bbb24c946141", lbCreate)
+	_, err := client.LoadBalancer.Update(ctx, "d9dbc01c-aaca-4d4b-8c4a-bbb24c946141", lbCreate)
 	if err != nil {
 		t.Errorf("LoadBalancer.Update returned %+v", err)
 	}
@@ -0,0 +1,130 @@
+package govultr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// LogRequests returns a middleware logging every request made to the Vultr API: method, path,
+// status, duration and number of attempts. When debug logging is enabled the request and
+// response bodies are logged too, with secret fields redacted by RedactJSON. It is installed
+// automatically by WithLogger.
+func LogRequests(logger *slog.Logger) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			debug := logger.Enabled(ctx, slog.LevelDebug)
+
+			var reqBody []byte
+			if debug && req.GetBody != nil {
+				if body, err := req.GetBody(); err == nil {
+					reqBody, _ = io.ReadAll(body)
+				}
+			}
+
+			start := time.Now()
+			res, err := next.Do(req)
+
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("path", req.URL.Path),
+				slog.Duration("duration", time.Since(start)),
+			}
+
+			if stats := requestStatsFrom(ctx); stats != nil && stats.attempts > 0 {
+				attrs = append(attrs, slog.Int("attempts", stats.attempts))
+			}
+
+			status := 0
+			var apiErr *APIError
+			if errors.As(err, &apiErr) {
+				status = apiErr.StatusCode
+			} else if res != nil {
+				status = res.StatusCode
+			}
+			if status != 0 {
+				attrs = append(attrs, slog.Int("status", status))
+			}
+
+			if debug {
+				if len(reqBody) > 0 {
+					attrs = append(attrs, slog.String("request_body", string(RedactJSON(reqBody))))
+				}
+				if body := responseBody(res, apiErr); len(body) > 0 {
+					attrs = append(attrs, slog.String("response_body", string(RedactJSON(body))))
+				}
+			}
+
+			level := slog.LevelInfo
+			switch {
+			case apiErr != nil:
+				level = slog.LevelWarn
+			case err != nil:
+				level = slog.LevelError
+			}
+
+			if err != nil {
+				attrs = append(attrs, slog.String("error", errorSummary(err, apiErr)))
+			}
+
+			logger.LogAttrs(ctx, level, "vultr api request", attrs...)
+			return res, err
+		})
+	}
+}
+
+// responseBody returns the buffered response body, leaving the response readable
+func responseBody(res *http.Response, apiErr *APIError) []byte {
+	if res == nil || res.Body == nil {
+		if apiErr != nil {
+			return apiErr.Body
+		}
+		return nil
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close() //nolint:errcheck,gosec
+	if err != nil {
+		return nil
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body
+}
+
+// errorSummary avoids logging raw bodies outside debug level, since an API error body may echo
+// back secrets sent in the request
+func errorSummary(err error, apiErr *APIError) string {
+	if apiErr == nil {
+		return err.Error()
+	}
+	if apiErr.Message != "" {
+		return apiErr.Message
+	}
+	return http.StatusText(apiErr.StatusCode)
+}
+
+type requestStatsKey struct{}
+
+// requestStats collects what happened while a request was sent
+type requestStats struct {
+	attempts int
+	cached   bool
+}
+
+func withRequestStats(ctx context.Context) context.Context {
+	if requestStatsFrom(ctx) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, requestStatsKey{}, &requestStats{})
+}
+
+func requestStatsFrom(ctx context.Context) *requestStats {
+	stats, _ := ctx.Value(requestStatsKey{}).(*requestStats)
+	return stats
+}
@@ -0,0 +1,104 @@
+package govultr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLogRequests(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"instance":{"id":"14b3e7d6-ffb5-4994-8502-57fcd9db3b33","default_password":"nreqnusibni"}}`)
+	})
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	c, err := client.With(WithLogger(logger), WithAPIKey("secret-api-key"))
+	if err != nil {
+		t.Fatalf("With returned %+v", err)
+	}
+
+	_, _, err = c.Instance.Create(ctx, &InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", UserData: "data", AppID: 1})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	out := buf.String()
+	for _, secret := range []string{"nreqnusibni", "secret-api-key", "Bearer"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("log output contains %q: %s", secret, out)
+		}
+	}
+
+	for _, want := range []string{`"method":"POST"`, `"path":"/v2/instances"`, `"status":200`, `"attempts":1`, `"request_body"`, `"response_body"`, RedactedValue} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output is missing %s: %s", want, out)
+		}
+	}
+}
+
+func TestLogRequests_Info(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances/abc", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"instance":{"id":"abc","default_password":"nreqnusibni"}}`)
+	})
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	c, _ := client.With(WithLogger(logger))
+	if _, _, err := c.Instance.Get(ctx, "abc"); err != nil {
+		t.Fatalf("Instance.Get returned %+v", err)
+	}
+
+	out := buf.String()
+	if strings.Contains(out, "response_body") || strings.Contains(out, "nreqnusibni") {
+		t.Errorf("bodies logged above debug level: %s", out)
+	}
+	if !strings.Contains(out, `"level":"INFO"`) {
+		t.Errorf("request not logged at info level: %s", out)
+	}
+}
+
+func TestLogRequests_Retries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/v2/instances/abc", func(writer http.ResponseWriter, request *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writer.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(writer, `{"error":"Invalid instance-id.","status":404}`)
+	})
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	c, _ := client.With(WithLogger(logger), WithRetryWait(time.Millisecond, time.Millisecond))
+	_, _, err := c.Instance.Get(context.Background(), "abc")
+	if !IsNotFound(err) {
+		t.Fatalf("Instance.Get returned %+v, expected a not found error", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{`"level":"WARN"`, `"attempts":3`, `"status":404`, `"error":"Invalid instance-id."`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output is missing %s: %s", want, out)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package govultr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Doer sends an API request and returns its response. Responses from the Vultr API are returned
+// with a fully buffered body, and unsuccessful responses are returned along with an *APIError.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapts an ordinary function to the Doer interface
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req)
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps every request made by the service handlers. It may modify the request,
+// short-circuit it by returning without calling next, or inspect the response and error.
+type Middleware func(next Doer) Doer
+
+// chainMiddleware wraps d so that the first middleware is the outermost one
+func chainMiddleware(d Doer, middleware []Middleware) Doer {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		d = middleware[i](d)
+	}
+	return d
+}
+
+// ErrReadOnly is returned by the ReadOnly middleware for requests that would modify resources
+var ErrReadOnly = errors.New("govultr: request blocked by read-only policy")
+
+// RequestCompleted returns a middleware calling rc after every request made to the Vultr API.
+// The response is nil when the request failed without a response.
+func RequestCompleted(rc RequestCompletionCallback) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			res, err := next.Do(req)
+			rc(req, res)
+			return res, err
+		})
+	}
+}
+
+// SetHeader returns a middleware setting a header on every request
+func SetHeader(key, value string) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set(key, value)
+			return next.Do(req)
+		})
+	}
+}
+
+// ReadOnly returns a middleware rejecting every request but GET and HEAD with ErrReadOnly,
+// without sending them.
+func ReadOnly() Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet && req.Method != http.MethodHead {
+				return nil, fmt.Errorf("%w: %s %s", ErrReadOnly, req.Method, req.URL.Path)
+			}
+			return next.Do(req)
+		})
+	}
+}
+
+// InjectError returns a middleware failing the requests matched by match with err, without
+// sending them. A nil match fails every request.
+func InjectError(match func(*http.Request) bool, err error) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			if match == nil || match(req) {
+				return nil, err
+			}
+			return next.Do(req)
+		})
+	}
+}
+
+// InjectStatus returns a middleware answering the requests matched by match with the given
+// status code and body, without sending them. Non-2xx statuses are returned along with an
+// *APIError, as if the API had answered. A nil match answers every request.
+func InjectStatus(match func(*http.Request) bool, status int, body string) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			if match != nil && !match(req) {
+				return next.Do(req)
+			}
+
+			res := &http.Response{
+				Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+				StatusCode: status,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Request:    req,
+			}
+
+			if status < http.StatusOK || status > http.StatusNoContent {
+				return res, newAPIError(res, []byte(body))
+			}
+			return res, nil
+		})
+	}
+}
+
+// Observation describes a request made through the Observe middleware
+type Observation struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Duration   time.Duration
+	Err        error
+	// Attempts is the number of times the request was sent, retries included. It is zero when
+	// the request was answered by a middleware.
+	Attempts int
+}
+
+// Observe returns a middleware reporting the outcome and latency of every request to fn
+func Observe(fn func(Observation)) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			res, err := next.Do(req)
+
+			o := Observation{
+				Method:   req.Method,
+				Path:     req.URL.Path,
+				Duration: time.Since(start),
+				Err:      err,
+			}
+
+			var apiErr *APIError
+			if res != nil {
+				o.StatusCode = res.StatusCode
+			} else if errors.As(err, &apiErr) {
+				o.StatusCode = apiErr.StatusCode
+			}
+
+			if stats := requestStatsFrom(req.Context()); stats != nil {
+				o.Attempts = stats.attempts
+			}
+
+			fn(o)
+			return res, err
+		})
+	}
+}
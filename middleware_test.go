@@ -0,0 +1,139 @@
+package govultr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestMiddleware_SetHeader(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		if v := request.Header.Get("X-Request-Source"); v != "tests" {
+			t.Errorf("X-Request-Source = %v, expected %v", v, "tests")
+		}
+		fmt.Fprint(writer, `{"account":{}}`)
+	})
+
+	c, _ := New(WithBaseURL(server.URL), WithMiddleware(SetHeader("X-Request-Source", "tests")))
+	if _, _, err := c.Account.Get(ctx); err != nil {
+		t.Fatalf("Account.Get returned %+v", err)
+	}
+}
+
+func TestMiddleware_ReadOnly(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances/1", func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			t.Errorf("%s request was not blocked", request.Method)
+		}
+		fmt.Fprint(writer, `{"instance":{"id":"1"}}`)
+	})
+
+	c, _ := New(WithBaseURL(server.URL), WithMiddleware(ReadOnly()))
+	if _, _, err := c.Instance.Get(ctx, "1"); err != nil {
+		t.Fatalf("Instance.Get returned %+v", err)
+	}
+
+	if _, err := c.Instance.Delete(ctx, "1"); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Instance.Delete returned %v, expected %v", err, ErrReadOnly)
+	}
+}
+
+func TestMiddleware_InjectStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		t.Error("request should not reach the server")
+	})
+
+	isAccount := func(r *http.Request) bool { return strings.HasPrefix(r.URL.Path, "/v2/account") }
+	c, _ := New(WithBaseURL(server.URL), WithMiddleware(InjectStatus(isAccount, http.StatusTooManyRequests, `{"error":"slow down","status":429}`)))
+
+	_, _, err := c.Account.Get(ctx)
+	if !IsRateLimited(err) {
+		t.Fatalf("Account.Get returned %v, expected a rate limited APIError", err)
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.Message != "slow down" {
+		t.Errorf("APIError.Message = %v, expected %v", apiErr.Message, "slow down")
+	}
+
+	c, _ = New(WithMiddleware(InjectStatus(nil, http.StatusOK, `{"account":{"name":"faked"}}`)))
+	account, _, err := c.Account.Get(ctx)
+	if err != nil {
+		t.Fatalf("Account.Get returned %+v", err)
+	}
+	if account.Name != "faked" {
+		t.Errorf("Account.Get returned %+v, expected the injected account", account)
+	}
+}
+
+func TestMiddleware_InjectError(t *testing.T) {
+	errFault := errors.New("connection reset")
+
+	c, _ := New(WithMiddleware(InjectError(nil, errFault)))
+	if _, _, err := c.Account.Get(ctx); !errors.Is(err, errFault) {
+		t.Errorf("Account.Get returned %v, expected %v", err, errFault)
+	}
+}
+
+func TestMiddleware_Observe(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"account":{}}`)
+	})
+
+	mux.HandleFunc("/v2/instances/missing", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(writer, `{"error":"not found","status":404}`)
+	})
+
+	var observed []Observation
+	c, _ := New(WithBaseURL(server.URL), WithMiddleware(Observe(func(o Observation) {
+		observed = append(observed, o)
+	})))
+
+	c.Account.Get(ctx)
+	c.Instance.Get(ctx, "missing")
+
+	if len(observed) != 2 {
+		t.Fatalf("observed %d requests, expected 2", len(observed))
+	}
+
+	if o := observed[0]; o.Method != http.MethodGet || o.Path != "/v2/account" || o.StatusCode != http.StatusOK || o.Err != nil || o.Duration <= 0 || o.Attempts != 1 {
+		t.Errorf("first observation = %+v", o)
+	}
+
+	if o := observed[1]; o.StatusCode != http.StatusNotFound || !IsNotFound(o.Err) {
+		t.Errorf("second observation = %+v", o)
+	}
+}
+
+func TestMiddleware_RequestCompleted(t *testing.T) {
+	var completed []string
+	c, _ := New(WithMiddleware(
+		RequestCompleted(func(req *http.Request, res *http.Response) {
+			completed = append(completed, fmt.Sprintf("%s %d", req.URL.Path, res.StatusCode))
+		}),
+		InjectStatus(nil, http.StatusNoContent, ""),
+	))
+
+	if _, err := c.Instance.Start(ctx, "1"); err != nil {
+		t.Fatalf("Instance.Start returned %+v", err)
+	}
+
+	if len(completed) != 1 || completed[0] != "/v2/instances/1/start 204" {
+		t.Errorf("completed requests = %v, expected %v", completed, []string{"/v2/instances/1/start 204"})
+	}
+}
@@ -15,15 +15,15 @@ const netPath = "/v2/private-networks"
 // Deprecated: NetworkService should no longer be used. Instead, use VPCService.
 type NetworkService interface {
 	// Deprecated: NetworkService Create should no longer be used. Instead, use VPCService Create.
-	Create(ctx context.Context, createReq *NetworkReq) (*Network, *http.Response, error)
+	Create(ctx context.Context, createReq *NetworkReq) (*Network, *Response, error)
 	// Deprecated: NetworkService Get should no longer be used. Instead, use VPCService Get.
-	Get(ctx context.Context, networkID string) (*Network, *http.Response, error)
+	Get(ctx context.Context, networkID string) (*Network, *Response, error)
 	// Deprecated: NetworkService Update should no longer be used. Instead, use VPCService Update.
-	Update(ctx context.Context, networkID string, description string) error
+	Update(ctx context.Context, networkID string, description string) (*Response, error)
 	// Deprecated: NetworkService Delete should no longer be used. Instead, use VPCService Delete.
-	Delete(ctx context.Context, networkID string) error
+	Delete(ctx context.Context, networkID string) (*Response, error)
 	// Deprecated: NetworkService List should no longer be used. Instead, use VPCService List.
-	List(ctx context.Context, options *ListOptions) ([]Network, *Meta, *http.Response, error)
+	List(ctx context.Context, options *ListOptions) ([]Network, *Meta, *Response, error)
 }
 
 // NetworkServiceHandler handles interaction with the network methods for the Vultr API
@@ -35,12 +35,12 @@ type NetworkServiceHandler struct {
 // Network represents a Vultr private network
 // Deprecated: Network should no longer be used. Instead, use VPC.
 type Network struct {
-	NetworkID    string `json:"id"`
-	Region       string `json:"region"`
-	Description  string `json:"description"`
-	V4Subnet     string `json:"v4_subnet"`
-	V4SubnetMask int    `json:"v4_subnet_mask"`
-	DateCreated  string `json:"date_created"`
+	NetworkID    string    `json:"id"`
+	Region       string    `json:"region"`
+	Description  string    `json:"description"`
+	V4Subnet     string    `json:"v4_subnet"`
+	V4SubnetMask int       `json:"v4_subnet_mask"`
+	DateCreated  Timestamp `json:"date_created"`
 }
 
 // NetworkReq represents parameters to create or update Network resource
@@ -63,7 +63,7 @@ type networkBase struct {
 
 // Create a new private network. A private network can only be used at the location for which it was created.
 // Deprecated: NetworkServiceHandler Create should no longer be used. Instead, use VPCServiceHandler Create.
-func (n *NetworkServiceHandler) Create(ctx context.Context, createReq *NetworkReq) (*Network, *http.Response, error) {
+func (n *NetworkServiceHandler) Create(ctx context.Context, createReq *NetworkReq) (*Network, *Response, error) {
 	req, err := n.client.NewRequest(ctx, http.MethodPost, netPath, createReq)
 	if err != nil {
 		return nil, nil, err
@@ -80,7 +80,7 @@ func (n *NetworkServiceHandler) Create(ctx context.Context, createReq *NetworkRe
 
 // Get gets the private networks of the requested ID
 // Deprecated: NetworkServiceHandler Get should no longer be used.  Instead use VPCServiceHandler Create.
-func (n *NetworkServiceHandler) Get(ctx context.Context, networkID string) (*Network, *http.Response, error) {
+func (n *NetworkServiceHandler) Get(ctx context.Context, networkID string) (*Network, *Response, error) {
 	uri := fmt.Sprintf("%s/%s", netPath, networkID)
 	req, err := n.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -98,34 +98,32 @@ func (n *NetworkServiceHandler) Get(ctx context.Context, networkID string) (*Net
 
 // Update updates a private network
 // Deprecated: NetworkServiceHandler Update should no longer be used. Instead, use VPCServiceHandler Update.
-func (n *NetworkServiceHandler) Update(ctx context.Context, networkID, description string) error {
+func (n *NetworkServiceHandler) Update(ctx context.Context, networkID, description string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s", netPath, networkID)
 
 	netReq := RequestBody{"description": description}
 	req, err := n.client.NewRequest(ctx, http.MethodPut, uri, netReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = n.client.DoWithContext(ctx, req, nil)
-	return err
+	return n.client.DoWithContext(ctx, req, nil)
 }
 
 // Delete a private network. Before deleting, a network must be disabled from all instances
 // Deprecated: NetworkServiceHandler Delete should no longer be used. Instead, use VPCServiceHandler Delete.
-func (n *NetworkServiceHandler) Delete(ctx context.Context, networkID string) error {
+func (n *NetworkServiceHandler) Delete(ctx context.Context, networkID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s", netPath, networkID)
 	req, err := n.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = n.client.DoWithContext(ctx, req, nil)
-	return err
+	return n.client.DoWithContext(ctx, req, nil)
 }
 
 // List lists all private networks on the current account
 // Deprecated: NetworkServiceHandler List should no longer be used. Instead, use VPCServiceHandler List.
-func (n *NetworkServiceHandler) List(ctx context.Context, options *ListOptions) ([]Network, *Meta, *http.Response, error) { //nolint:dupl
+func (n *NetworkServiceHandler) List(ctx context.Context, options *ListOptions) ([]Network, *Meta, *Response, error) { //nolint:dupl
 	req, err := n.client.NewRequest(ctx, http.MethodGet, netPath, nil)
 	if err != nil {
 		return nil, nil, nil, err
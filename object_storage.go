@@ -11,14 +11,14 @@ import (
 // ObjectStorageService is the interface to interact with the object storage endpoints on the Vultr API.
 // Link : https://www.vultr.com/api/#tag/s3
 type ObjectStorageService interface {
-	Create(ctx context.Context, clusterID int, label string) (*ObjectStorage, *http.Response, error)
-	Get(ctx context.Context, id string) (*ObjectStorage, *http.Response, error)
-	Update(ctx context.Context, id, label string) error
-	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, options *ListOptions) ([]ObjectStorage, *Meta, *http.Response, error)
+	Create(ctx context.Context, clusterID int, label string) (*ObjectStorage, *Response, error)
+	Get(ctx context.Context, id string) (*ObjectStorage, *Response, error)
+	Update(ctx context.Context, id, label string) (*Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+	List(ctx context.Context, options *ListOptions) ([]ObjectStorage, *Meta, *Response, error)
 
-	ListCluster(ctx context.Context, options *ListOptions) ([]ObjectStorageCluster, *Meta, *http.Response, error)
-	RegenerateKeys(ctx context.Context, id string) (*S3Keys, *http.Response, error)
+	ListCluster(ctx context.Context, options *ListOptions) ([]ObjectStorageCluster, *Meta, *Response, error)
+	RegenerateKeys(ctx context.Context, id string) (*S3Keys, *Response, error)
 }
 
 // ObjectStorageServiceHandler handles interaction with the firewall rule methods for the Vultr API.
@@ -28,13 +28,13 @@ type ObjectStorageServiceHandler struct {
 
 // ObjectStorage represents a Vultr Object Storage subscription.
 type ObjectStorage struct {
-	ID                   string `json:"id"`
-	DateCreated          string `json:"date_created"`
-	ObjectStoreClusterID int    `json:"cluster_id"`
-	Region               string `json:"region"`
-	Location             string `json:"location"`
-	Label                string `json:"label"`
-	Status               string `json:"status"`
+	ID                   string    `json:"id"`
+	DateCreated          Timestamp `json:"date_created"`
+	ObjectStoreClusterID int       `json:"cluster_id"`
+	Region               string    `json:"region"`
+	Location             string    `json:"location"`
+	Label                string    `json:"label"`
+	Status               string    `json:"status"`
 	S3Keys
 }
 
@@ -72,7 +72,7 @@ type s3KeysBase struct {
 }
 
 // Create an object storage subscription
-func (o *ObjectStorageServiceHandler) Create(ctx context.Context, clusterID int, label string) (*ObjectStorage, *http.Response, error) {
+func (o *ObjectStorageServiceHandler) Create(ctx context.Context, clusterID int, label string) (*ObjectStorage, *Response, error) {
 	uri := "/v2/object-storage"
 
 	values := RequestBody{"cluster_id": clusterID, "label": label}
@@ -91,7 +91,7 @@ func (o *ObjectStorageServiceHandler) Create(ctx context.Context, clusterID int,
 }
 
 // Get returns a specified object storage by the provided ID
-func (o *ObjectStorageServiceHandler) Get(ctx context.Context, id string) (*ObjectStorage, *http.Response, error) {
+func (o *ObjectStorageServiceHandler) Get(ctx context.Context, id string) (*ObjectStorage, *Response, error) {
 	uri := fmt.Sprintf("/v2/object-storage/%s", id)
 
 	req, err := o.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -109,34 +109,32 @@ func (o *ObjectStorageServiceHandler) Get(ctx context.Context, id string) (*Obje
 }
 
 // Update a Object Storage Subscription.
-func (o *ObjectStorageServiceHandler) Update(ctx context.Context, id, label string) error {
+func (o *ObjectStorageServiceHandler) Update(ctx context.Context, id, label string) (*Response, error) {
 	uri := fmt.Sprintf("/v2/object-storage/%s", id)
 
 	value := RequestBody{"label": label}
 	req, err := o.client.NewRequest(ctx, http.MethodPut, uri, value)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = o.client.DoWithContext(ctx, req, nil)
-	return err
+	return o.client.DoWithContext(ctx, req, nil)
 }
 
 // Delete a object storage subscription.
-func (o *ObjectStorageServiceHandler) Delete(ctx context.Context, id string) error {
+func (o *ObjectStorageServiceHandler) Delete(ctx context.Context, id string) (*Response, error) {
 	uri := fmt.Sprintf("/v2/object-storage/%s", id)
 
 	req, err := o.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = o.client.DoWithContext(ctx, req, nil)
-	return err
+	return o.client.DoWithContext(ctx, req, nil)
 }
 
 // List all object storage subscriptions on the current account. This includes both pending and active subscriptions.
-func (o *ObjectStorageServiceHandler) List(ctx context.Context, options *ListOptions) ([]ObjectStorage, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (o *ObjectStorageServiceHandler) List(ctx context.Context, options *ListOptions) ([]ObjectStorage, *Meta, *Response, error) { //nolint:dupl,lll
 	uri := "/v2/object-storage"
 
 	req, err := o.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -162,7 +160,7 @@ func (o *ObjectStorageServiceHandler) List(ctx context.Context, options *ListOpt
 
 // ListCluster returns back your object storage clusters.
 // Clusters may be removed over time. The "deploy" field can be used to determine whether or not new deployments are allowed in the cluster.
-func (o *ObjectStorageServiceHandler) ListCluster(ctx context.Context, options *ListOptions) ([]ObjectStorageCluster, *Meta, *http.Response, error) { //nolint:lll
+func (o *ObjectStorageServiceHandler) ListCluster(ctx context.Context, options *ListOptions) ([]ObjectStorageCluster, *Meta, *Response, error) { //nolint:lll
 	uri := "/v2/object-storage/clusters"
 	req, err := o.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -179,7 +177,7 @@ func (o *ObjectStorageServiceHandler) ListCluster(ctx context.Context, options *
 }
 
 // RegenerateKeys of the S3 API Keys for an object storage subscription
-func (o *ObjectStorageServiceHandler) RegenerateKeys(ctx context.Context, id string) (*S3Keys, *http.Response, error) {
+func (o *ObjectStorageServiceHandler) RegenerateKeys(ctx context.Context, id string) (*S3Keys, *Response, error) {
 	uri := fmt.Sprintf("/v2/object-storage/%s/regenerate-keys", id)
 
 	req, err := o.client.NewRequest(ctx, http.MethodPost, uri, nil)
@@ -23,7 +23,7 @@ func TestObjectStorageServiceHandler_Create(t *testing.T) {
 
 	expected := &ObjectStorage{
 		ID:                   "39239784",
-		DateCreated:          "2020-07-1414:07:28",
+		DateCreated:          timestamp("2020-07-1414:07:28"),
 		ObjectStoreClusterID: 2,
 		Region:               "ewr",
 		Location:             "New Jersey",
@@ -53,7 +53,7 @@ func TestObjectStorageServiceHandler_Get(t *testing.T) {
 
 	expected := &ObjectStorage{
 		ID:                   "39239784",
-		DateCreated:          "2020-07-1414:07:28",
+		DateCreated:          timestamp("2020-07-1414:07:28"),
 		ObjectStoreClusterID: 2,
 		Region:               "ewr",
 		Label:                "",
@@ -77,7 +77,7 @@ func TestObjectStorageServiceHandler_Update(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.ObjectStorage.Update(ctx, "1234", "s3 label")
+	_, err := client.ObjectStorage.Update(ctx, "1234", "s3 label")
 	if err != nil {
 		t.Errorf("ObjectStorage.Create returned %+v", err)
 	}
@@ -91,7 +91,7 @@ func TestObjectStorageServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.ObjectStorage.Delete(ctx, "1234")
+	_, err := client.ObjectStorage.Delete(ctx, "1234")
 	if err != nil {
 		t.Errorf("ObjectStorage.Delete returned %+v", err)
 	}
@@ -113,7 +113,7 @@ func TestObjectStorageServiceHandler_List(t *testing.T) {
 	expectedObject := []ObjectStorage{
 		{
 			ID:                   "39240368",
-			DateCreated:          "2020-07-1414:22:38",
+			DateCreated:          timestamp("2020-07-1414:22:38"),
 			ObjectStoreClusterID: 2,
 			Region:               "ewr",
 			Label:                "govultr",
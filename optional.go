@@ -0,0 +1,98 @@
+package govultr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Optional is a field of an update request that is either unset, null or set to a value. Unset
+// fields are left out of the request, so the API leaves them unchanged, null fields are sent as
+// null to clear them, and set fields are sent with their value, even when it is the zero value.
+//
+// The zero value is unset. Fields of this type are tagged omitzero.
+type Optional[T any] struct {
+	value T
+	state optionalState
+}
+
+type optionalState uint8
+
+const (
+	optionalUnset optionalState = iota
+	optionalNull
+	optionalSet
+)
+
+// NewOptional returns an Optional set to value
+func NewOptional[T any](value T) Optional[T] {
+	return Optional[T]{value: value, state: optionalSet}
+}
+
+// Null returns an Optional sent as null
+func Null[T any]() Optional[T] {
+	return Optional[T]{state: optionalNull}
+}
+
+// IsZero reports whether the Optional is unset. It is used by the omitzero tag option.
+func (o Optional[T]) IsZero() bool {
+	return o.state == optionalUnset
+}
+
+// IsNull reports whether the Optional is null
+func (o Optional[T]) IsNull() bool {
+	return o.state == optionalNull
+}
+
+// IsSet reports whether the Optional is set to a value
+func (o Optional[T]) IsSet() bool {
+	return o.state == optionalSet
+}
+
+// Get returns the value of the Optional and whether it is set
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.state == optionalSet
+}
+
+// Or returns the value of the Optional, or fallback when it is unset or null
+func (o Optional[T]) Or(fallback T) T {
+	if o.state == optionalSet {
+		return o.value
+	}
+	return fallback
+}
+
+// String formats the Optional for debugging
+func (o Optional[T]) String() string {
+	switch o.state {
+	case optionalNull:
+		return "null"
+	case optionalSet:
+		return fmt.Sprint(o.value)
+	}
+	return "unset"
+}
+
+// MarshalJSON encodes the value of the Optional, or null. An unset Optional is encoded as null
+// too, unless the field is tagged omitzero.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if o.state != optionalSet {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes a value, or null. Fields missing from the JSON document stay unset.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Null[T]()
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = NewOptional(value)
+	return nil
+}
@@ -0,0 +1,64 @@
+package govultr
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestOptional_Marshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      InstanceUpdateReq
+		expected string
+	}{
+		{"unset", InstanceUpdateReq{}, `{}`},
+		{"value", InstanceUpdateReq{Label: NewOptional("web")}, `{"label":"web"}`},
+		{"zero value", InstanceUpdateReq{Tags: NewOptional([]string{}), DDOSProtection: NewOptional(false)}, `{"tags":[],"ddos_protection":false}`},
+		{"null", InstanceUpdateReq{FirewallGroupID: Null[string]()}, `{"firewall_group_id":null}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(test.req)
+			if err != nil {
+				t.Fatalf("json.Marshal returned %+v", err)
+			}
+			if string(data) != test.expected {
+				t.Errorf("json.Marshal returned %s, expected %s", data, test.expected)
+			}
+		})
+	}
+}
+
+func TestOptional_Unmarshal(t *testing.T) {
+	req := new(BareMetalUpdate)
+	if err := json.Unmarshal([]byte(`{"label":"","tags":null,"app_id":3}`), req); err != nil {
+		t.Fatalf("json.Unmarshal returned %+v", err)
+	}
+
+	if label, ok := req.Label.Get(); !ok || label != "" {
+		t.Errorf("Label = %v, expected an empty string", req.Label)
+	}
+	if !req.Tags.IsNull() {
+		t.Errorf("Tags = %v, expected null", req.Tags)
+	}
+	if req.AppID.Or(0) != 3 {
+		t.Errorf("AppID = %v, expected 3", req.AppID)
+	}
+	if !req.OsID.IsZero() || req.OsID.IsSet() || req.OsID.IsNull() {
+		t.Errorf("OsID = %v, expected it to be unset", req.OsID)
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("json.Marshal returned %+v", err)
+	}
+	roundTrip := new(BareMetalUpdate)
+	if err := json.Unmarshal(data, roundTrip); err != nil {
+		t.Fatalf("json.Unmarshal returned %+v", err)
+	}
+	if !reflect.DeepEqual(roundTrip, req) {
+		t.Errorf("round trip returned %+v, expected %+v", roundTrip, req)
+	}
+}
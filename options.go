@@ -0,0 +1,217 @@
+package govultr
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option configures a Client built with New or derived with Client.With
+type Option func(*clientOptions) error
+
+type clientOptions struct {
+	httpClient         *http.Client
+	baseURL            *url.URL
+	userAgent          string
+	retryLimit         int
+	retryWaitMin       time.Duration
+	retryWaitMax       time.Duration
+	logger             *slog.Logger
+	middleware         []Middleware
+	credentials        *Credentials
+	onRequestCompleted RequestCompletionCallback
+	rateLimiter        *RateLimiter
+	rateLimitObserver  RateLimitObserver
+	created            *createdIDs
+	retryPolicy        RetryPolicy
+	createSkew         time.Duration
+}
+
+func defaultClientOptions() *clientOptions {
+	baseURL, _ := url.Parse(defaultBase)
+
+	return &clientOptions{
+		baseURL:      baseURL,
+		userAgent:    userAgent,
+		retryLimit:   retryLimit,
+		retryWaitMin: rateLimit / 3 * 2,
+		retryWaitMax: rateLimit,
+		rateLimiter:  NewRateLimiter(defaultRequestRate, defaultRequestBurst),
+		createSkew:   defaultCreateSkew,
+	}
+}
+
+// WithHTTPClient sets the http.Client used to send requests. Clients derived with Client.With
+// keep using it, and therefore share its connection pool.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *clientOptions) error {
+		if httpClient == nil {
+			return errors.New("http client must not be nil")
+		}
+		o.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithBaseURL overrides the default base URL
+func WithBaseURL(baseURL string) Option {
+	return func(o *clientOptions) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		o.baseURL = u
+		return nil
+	}
+}
+
+// WithUserAgent overrides the default user agent
+func WithUserAgent(ua string) Option {
+	return func(o *clientOptions) error {
+		o.userAgent = ua
+		return nil
+	}
+}
+
+// WithRetryLimit sets the maximum number of times a request is retried
+func WithRetryLimit(n int) Option {
+	return func(o *clientOptions) error {
+		if n < 0 {
+			return errors.New("retry limit must not be negative")
+		}
+		o.retryLimit = n
+		return nil
+	}
+}
+
+// WithRetryPolicy sets which requests are retried after a connection error or a 5xx response.
+// It can be overridden per request with ContextWithRetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *clientOptions) error {
+		o.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithCreateSkew sets how much earlier than a create request the resource found by its
+// reconciliation may be dated, 10 seconds by default. It covers the clock skew between the client
+// and the API, and the second precision of the creation dates.
+func WithCreateSkew(skew time.Duration) Option {
+	return func(o *clientOptions) error {
+		if skew < 0 {
+			return errors.New("create skew must not be negative")
+		}
+		o.createSkew = skew
+		return nil
+	}
+}
+
+// WithRetryWait sets the minimum and maximum time waited between retries
+func WithRetryWait(waitMin, waitMax time.Duration) Option {
+	return func(o *clientOptions) error {
+		if waitMin > waitMax {
+			return errors.New("minimum retry wait must not exceed the maximum")
+		}
+		o.retryWaitMin = waitMin
+		o.retryWaitMax = waitMax
+		return nil
+	}
+}
+
+// WithRateLimit sets the delay between retries the same way SetRateLimit does: exponential
+// backoff is used with the minimum wait being 2/3rds the time provided.
+func WithRateLimit(t time.Duration) Option {
+	return WithRetryWait(t/3*2, t)
+}
+
+// WithLogger logs every request made to the Vultr API with LogRequests. Bodies are only logged
+// at debug level, with secrets redacted.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *clientOptions) error {
+		o.logger = logger
+		return nil
+	}
+}
+
+// WithMiddleware appends middleware wrapping every request made by the service handlers.
+// The first middleware given is the outermost one.
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(o *clientOptions) error {
+		o.middleware = append(o.middleware, middleware...)
+		return nil
+	}
+}
+
+// WithCache serves the catalog endpoints, or those configured on the cache, from cache. The
+// cache sits after the middleware given with WithMiddleware.
+func WithCache(cache *Cache) Option {
+	return func(o *clientOptions) error {
+		if cache == nil {
+			return errors.New("cache can not be nil")
+		}
+		o.middleware = append(o.middleware, cache.Middleware())
+		return nil
+	}
+}
+
+// WithAPIKey sets the API key sent as a bearer token with every request
+func WithAPIKey(apiKey string) Option {
+	return func(o *clientOptions) error {
+		if apiKey == "" {
+			return ErrNoCredentials
+		}
+		o.credentials = &Credentials{APIKey: apiKey, Source: CredentialSourceExplicit}
+		return nil
+	}
+}
+
+// WithCredentials resolves the API key from the given provider, for example DefaultCredentials
+func WithCredentials(provider CredentialsProvider) Option {
+	return func(o *clientOptions) error {
+		creds, err := provider.Retrieve()
+		if err != nil {
+			return err
+		}
+		o.credentials = creds
+		return nil
+	}
+}
+
+// WithRequestCompleted sets the function called after every request made to the Vultr API
+func WithRequestCompleted(rc RequestCompletionCallback) Option {
+	return func(o *clientOptions) error {
+		o.onRequestCompleted = rc
+		return nil
+	}
+}
+
+// WithRequestRate throttles the client to rps requests per second, with bursts of up to burst
+// requests. By default the client allows 30 requests per second, Vultr's documented limit.
+func WithRequestRate(rps float64, burst int) Option {
+	return func(o *clientOptions) error {
+		if rps <= 0 {
+			return errors.New("request rate must be positive")
+		}
+		o.rateLimiter = NewRateLimiter(rps, burst)
+		return nil
+	}
+}
+
+// WithRateLimiter makes the client draw from the given limiter, which may be shared with other
+// clients. A nil limiter disables client-side rate limiting.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(o *clientOptions) error {
+		o.rateLimiter = limiter
+		return nil
+	}
+}
+
+// WithRateLimitObserver sets the function called every time a request waits for the rate limiter
+func WithRateLimitObserver(observer RateLimitObserver) Option {
+	return func(o *clientOptions) error {
+		o.rateLimitObserver = observer
+		return nil
+	}
+}
@@ -0,0 +1,205 @@
+package govultr
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	httpClient := &http.Client{}
+	logger := slog.Default()
+
+	c, err := New(
+		WithHTTPClient(httpClient),
+		WithBaseURL("http://localhost/vultr"),
+		WithUserAgent("vultr/testing"),
+		WithRetryLimit(5),
+		WithRetryWait(time.Second, 2*time.Second),
+		WithLogger(logger),
+		WithAPIKey("secret-key"),
+	)
+	if err != nil {
+		t.Fatalf("New returned %+v", err)
+	}
+
+	if c.httpClient != httpClient {
+		t.Error("New did not use the given http client")
+	}
+
+	if c.BaseURL.String() != "http://localhost/vultr" {
+		t.Errorf("New BaseURL = %v, expected %v", c.BaseURL, "http://localhost/vultr")
+	}
+
+	if c.UserAgent != "vultr/testing" {
+		t.Errorf("New UserAgent = %v, expected %v", c.UserAgent, "vultr/testing")
+	}
+
+	if c.client.RetryMax != 5 {
+		t.Errorf("New RetryMax = %v, expected %v", c.client.RetryMax, 5)
+	}
+
+	if c.client.RetryWaitMin != time.Second || c.client.RetryWaitMax != 2*time.Second {
+		t.Errorf("New retry wait = %v-%v, expected %v-%v", c.client.RetryWaitMin, c.client.RetryWaitMax, time.Second, 2*time.Second)
+	}
+
+	if c.logger != logger {
+		t.Error("New did not use the given logger")
+	}
+
+	if c.CredentialSource() != CredentialSourceExplicit {
+		t.Errorf("New CredentialSource = %v, expected %v", c.CredentialSource(), CredentialSourceExplicit)
+	}
+
+	if c.Instance == nil {
+		t.Error("New did not set up the services")
+	}
+}
+
+func TestNew_Defaults(t *testing.T) {
+	c, err := New()
+	if err != nil {
+		t.Fatalf("New returned %+v", err)
+	}
+
+	if c.BaseURL.String() != defaultBase {
+		t.Errorf("New BaseURL = %v, expected %v", c.BaseURL, defaultBase)
+	}
+
+	if c.UserAgent != userAgent {
+		t.Errorf("New UserAgent = %v, expected %v", c.UserAgent, userAgent)
+	}
+
+	if c.client.RetryMax != retryLimit || c.client.RetryWaitMax != rateLimit {
+		t.Errorf("New retry policy = %v/%v, expected %v/%v", c.client.RetryMax, c.client.RetryWaitMax, retryLimit, rateLimit)
+	}
+
+	if c.client.HTTPClient == nil {
+		t.Error("New did not set a default http client")
+	}
+}
+
+func TestNew_InvalidOptions(t *testing.T) {
+	tests := map[string]Option{
+		"base url":    WithBaseURL(":"),
+		"http client": WithHTTPClient(nil),
+		"retry limit": WithRetryLimit(-1),
+		"retry wait":  WithRetryWait(2*time.Second, time.Second),
+		"rate":        WithRequestRate(0, 1),
+		"api key":     WithAPIKey(""),
+		"credentials": WithCredentials(CredentialChain{}),
+	}
+
+	for name, opt := range tests {
+		if _, err := New(opt); err == nil {
+			t.Errorf("expected invalid %s to fail", name)
+		}
+	}
+}
+
+func TestClient_With(t *testing.T) {
+	base, err := New(WithUserAgent("vultr/testing"), WithAPIKey("secret-key"))
+	if err != nil {
+		t.Fatalf("New returned %+v", err)
+	}
+
+	staging, err := base.With(WithBaseURL("http://localhost/staging"))
+	if err != nil {
+		t.Fatalf("With returned %+v", err)
+	}
+
+	if base.BaseURL.String() != defaultBase {
+		t.Errorf("With modified the original BaseURL: %v", base.BaseURL)
+	}
+
+	if staging.BaseURL.String() != "http://localhost/staging" {
+		t.Errorf("With BaseURL = %v, expected %v", staging.BaseURL, "http://localhost/staging")
+	}
+
+	if staging.httpClient != base.httpClient {
+		t.Error("With should share the http client")
+	}
+
+	if staging.RateLimiter() != base.RateLimiter() {
+		t.Error("With should share the rate limiter")
+	}
+
+	if staging.UserAgent != base.UserAgent || staging.CredentialSource() != base.CredentialSource() {
+		t.Error("With should keep the original configuration")
+	}
+
+	if reflect.ValueOf(staging.Instance).Pointer() == reflect.ValueOf(base.Instance).Pointer() {
+		t.Error("With should bind new services to the copy")
+	}
+
+	if _, err := base.With(WithBaseURL(":")); err == nil {
+		t.Error("expected invalid option to fail")
+	}
+}
+
+func TestWithMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		if request.Header.Get("X-Test") != "inner" {
+			t.Errorf("X-Test header = %v, expected %v", request.Header.Get("X-Test"), "inner")
+		}
+		fmt.Fprint(writer, `{"account":{}}`)
+	})
+
+	var order []string
+	record := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				req.Header.Set("X-Test", name)
+				return next.Do(req)
+			})
+		}
+	}
+
+	var completed int32
+	c, err := New(
+		WithBaseURL(server.URL),
+		WithMiddleware(record("outer"), record("inner")),
+		WithRequestCompleted(func(*http.Request, *http.Response) { atomic.AddInt32(&completed, 1) }),
+	)
+	if err != nil {
+		t.Fatalf("New returned %+v", err)
+	}
+
+	if _, _, err := c.Account.Get(ctx); err != nil {
+		t.Fatalf("Account.Get returned %+v", err)
+	}
+
+	if !reflect.DeepEqual(order, []string{"outer", "inner"}) {
+		t.Errorf("middleware order = %v, expected %v", order, []string{"outer", "inner"})
+	}
+
+	if atomic.LoadInt32(&completed) != 1 {
+		t.Errorf("request completed callback called %d times, expected 1", completed)
+	}
+}
+
+func TestWithMiddleware_ShortCircuit(t *testing.T) {
+	errBlocked := errors.New("blocked")
+
+	c, err := New(WithMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, errBlocked
+		})
+	}))
+	if err != nil {
+		t.Fatalf("New returned %+v", err)
+	}
+
+	if _, _, err := c.Account.Get(ctx); !errors.Is(err, errBlocked) {
+		t.Errorf("Account.Get returned %v, expected %v", err, errBlocked)
+	}
+}
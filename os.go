@@ -10,7 +10,7 @@ import (
 // OSService is the interface to interact with the operating system endpoint on the Vultr API
 // Link : https://www.vultr.com/api/#tag/os
 type OSService interface {
-	List(ctx context.Context, options *ListOptions) ([]OS, *Meta, *http.Response, error)
+	List(ctx context.Context, options *ListOptions) ([]OS, *Meta, *Response, error)
 }
 
 // OSServiceHandler handles interaction with the operating system methods for the Vultr API
@@ -34,7 +34,7 @@ type osBase struct {
 var _ OSService = &OSServiceHandler{}
 
 // List retrieves a list of available operating systems.
-func (o *OSServiceHandler) List(ctx context.Context, options *ListOptions) ([]OS, *Meta, *http.Response, error) { //nolint:dupl
+func (o *OSServiceHandler) List(ctx context.Context, options *ListOptions) ([]OS, *Meta, *Response, error) { //nolint:dupl
 	uri := "/v2/os"
 	req, err := o.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -0,0 +1,121 @@
+//go:build ignore
+
+// gen writes services.go, wrapping every service of govultr.Client with tracing.
+package main
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/vultr/govultr/v3/internal/servicegen"
+)
+
+var tmpl = template.Must(template.New("services").Funcs(template.FuncMap{
+	"wrapper":    wrapperName,
+	"params":     params,
+	"results":    results,
+	"endResults": endResults,
+}).Parse(`// Code generated by gen.go; DO NOT EDIT.
+
+package otelgovultr
+
+import (
+	"context"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// wrap replaces the services of c with their traced counterparts
+func (i *instrumentation) wrap(c *govultr.Client) {
+{{- range .}}
+	c.{{.Field}} = &{{wrapper .}}{next: c.{{.Field}}, instrumentation: i}
+{{- end}}
+}
+{{range $s := .}}
+var _ govultr.{{$s.Interface}} = (*{{wrapper $s}})(nil)
+
+type {{wrapper $s}} struct {
+	next govultr.{{$s.Interface}}
+	*instrumentation
+}
+{{range $s.Methods}}
+func (w *{{wrapper $s}}) {{.Name}}{{.Signature}} {
+	ctx, span := w.start(ctx, "{{$s.Field}}.{{.Name}}"{{params .}})
+	{{results .}} := w.next.{{.Name}}({{.Args}})
+	w.end(span, err{{endResults .}})
+	return {{results .}}
+}
+{{end}}{{end}}`))
+
+// wrapperName lowers the leading initialism of the field name: SSHKey becomes sshKeyService
+func wrapperName(s servicegen.Service) string {
+	r := []rune(s.Field)
+	for i := range r {
+		if !unicode.IsUpper(r[i]) || (i > 0 && i+1 < len(r) && unicode.IsLower(r[i+1])) {
+			break
+		}
+		r[i] = unicode.ToLower(r[i])
+	}
+	return string(r) + "Service"
+}
+
+// params passes every parameter but the context to start
+func params(m servicegen.Method) string {
+	var b strings.Builder
+	for _, p := range m.Params[1:] {
+		b.WriteString(`, arg{"` + p.Name + `", ` + p.Name + `}`)
+	}
+	return b.String()
+}
+
+func results(m servicegen.Method) string {
+	names := make([]string, len(m.Results))
+	for i := range m.Results[:len(m.Results)-1] {
+		names[i] = "r" + string(rune('0'+i))
+	}
+	names[len(names)-1] = "err"
+	return strings.Join(names, ", ")
+}
+
+// endResults passes every result but the error to end
+func endResults(m servicegen.Method) string {
+	var b strings.Builder
+	for i := range m.Results[:len(m.Results)-1] {
+		b.WriteString(", r" + string(rune('0'+i)))
+	}
+	return b.String()
+}
+
+func main() {
+	services, err := servicegen.Load("..")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, s := range services {
+		for _, m := range s.Methods {
+			if !m.HasContext() || !m.ReturnsError() {
+				log.Fatalf("%s.%s must take a context and return an error to be traced", s.Field, m.Name)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, services); err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := servicegen.Format(buf.Bytes())
+	if err != nil {
+		os.WriteFile("services.go", src, 0o644)
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("services.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
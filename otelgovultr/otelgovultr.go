@@ -0,0 +1,322 @@
+// Package otelgovultr instruments a govultr.Client with OpenTelemetry.
+//
+// Every service method call is traced with a span named after the method, such as
+// govultr.Instance.Create, carrying the IDs, region and plan of the resources involved. Every
+// request sent to the Vultr API is measured with a request counter and latency and retry
+// histograms, broken down by service method.
+//
+//	client, err := otelgovultr.Instrument(vultrClient)
+package otelgovultr
+
+//go:generate go run gen.go
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/vultr/govultr/v3"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope of the tracer and meter
+const ScopeName = "github.com/vultr/govultr/v3/otelgovultr"
+
+// Attribute keys set on spans and metrics
+const (
+	OperationKey  = attribute.Key("vultr.operation")
+	ResourceIDKey = attribute.Key("vultr.resource.id")
+	RegionKey     = attribute.Key("vultr.region")
+	PlanKey       = attribute.Key("vultr.plan")
+
+	methodKey     = attribute.Key("http.request.method")
+	statusCodeKey = attribute.Key("http.response.status_code")
+	errorTypeKey  = attribute.Key("error.type")
+)
+
+// Option configures the instrumentation
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// WithTracerProvider sets the provider of the tracer. The global provider is used by default.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the provider of the meter. The global provider is used by default.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// Instrument returns a copy of client whose service calls are traced and whose requests are
+// measured. The original client is left untouched.
+func Instrument(client *govultr.Client, opts ...Option) (*govultr.Client, error) {
+	cfg := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	inst, err := newInstrumentation(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	instrumented, err := client.With(govultr.WithMiddleware(inst.measure))
+	if err != nil {
+		return nil, err
+	}
+
+	inst.wrap(instrumented)
+	return instrumented, nil
+}
+
+type instrumentation struct {
+	tracer   trace.Tracer
+	requests metric.Int64Counter
+	duration metric.Float64Histogram
+	retries  metric.Int64Histogram
+}
+
+func newInstrumentation(cfg *config) (*instrumentation, error) {
+	meter := cfg.meterProvider.Meter(ScopeName)
+
+	requests, err := meter.Int64Counter("vultr.client.requests",
+		metric.WithDescription("Number of requests sent to the Vultr API"),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := meter.Float64Histogram("vultr.client.request.duration",
+		metric.WithDescription("Duration of requests sent to the Vultr API, retries included"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	retries, err := meter.Int64Histogram("vultr.client.request.retries",
+		metric.WithDescription("Number of times requests sent to the Vultr API were retried"),
+		metric.WithUnit("{retry}"),
+		metric.WithExplicitBucketBoundaries(0, 1, 2, 3, 5, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	return &instrumentation{
+		tracer:   cfg.tracerProvider.Tracer(ScopeName),
+		requests: requests,
+		duration: duration,
+		retries:  retries,
+	}, nil
+}
+
+type operationKey struct{}
+
+// arg is a parameter of a service method, named as in the service interface
+type arg struct {
+	name  string
+	value interface{}
+}
+
+// start opens the span of a service method call
+func (i *instrumentation) start(ctx context.Context, operation string, args ...arg) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{OperationKey.String(operation)}
+	for _, a := range args {
+		attrs = append(attrs, argAttributes(a)...)
+	}
+
+	ctx = context.WithValue(ctx, operationKey{}, operation)
+	return i.tracer.Start(ctx, "govultr."+operation, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// end closes the span of a service method call with its results
+func (i *instrumentation) end(span trace.Span, err error, results ...interface{}) {
+	for _, r := range results {
+		if res, ok := r.(*govultr.Response); ok {
+			if res != nil && res.Response != nil {
+				span.SetAttributes(statusCodeKey.Int(res.StatusCode))
+			}
+			continue
+		}
+		span.SetAttributes(resultAttributes(r)...)
+	}
+
+	if err != nil {
+		var apiErr *govultr.APIError
+		if errors.As(err, &apiErr) {
+			span.SetAttributes(statusCodeKey.Int(apiErr.StatusCode))
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// measure is the middleware recording the metrics of every request
+func (i *instrumentation) measure(next govultr.Doer) govultr.Doer {
+	return govultr.DoerFunc(func(req *http.Request) (*http.Response, error) {
+		ctx := req.Context()
+
+		var observed govultr.Observation
+		res, err := govultr.Observe(func(o govultr.Observation) {
+			observed = o
+		})(next).Do(req)
+
+		attrs := []attribute.KeyValue{methodKey.String(observed.Method)}
+		if operation, ok := ctx.Value(operationKey{}).(string); ok {
+			attrs = append(attrs, OperationKey.String(operation))
+		}
+		if observed.StatusCode != 0 {
+			attrs = append(attrs, statusCodeKey.Int(observed.StatusCode))
+		}
+		if observed.Err != nil {
+			attrs = append(attrs, errorTypeKey.String(errorType(observed.Err)))
+		}
+
+		set := metric.WithAttributeSet(attribute.NewSet(attrs...))
+		i.requests.Add(ctx, 1, set)
+		i.duration.Record(ctx, observed.Duration.Seconds(), set)
+		if observed.Attempts > 0 {
+			i.retries.Record(ctx, int64(observed.Attempts-1), set)
+		}
+
+		return res, err
+	})
+}
+
+func errorType(err error) string {
+	var apiErr *govultr.APIError
+	switch {
+	case errors.As(err, &apiErr):
+		return http.StatusText(apiErr.StatusCode)
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	default:
+		return "_OTHER"
+	}
+}
+
+// argAttributes returns the attributes of a method parameter: the IDs passed as strings, lists of
+// IDs, and the region and plan of request structs. Other values, which may hold secrets, are left out.
+func argAttributes(a arg) []attribute.KeyValue {
+	switch v := a.value.(type) {
+	case string:
+		if key, ok := idKey(a.name); ok && v != "" {
+			return []attribute.KeyValue{key.String(v)}
+		}
+	case int:
+		if key, ok := idKey(a.name); ok {
+			return []attribute.KeyValue{key.Int(v)}
+		}
+	case []string:
+		if strings.HasSuffix(a.name, "List") && len(v) > 0 {
+			key, _ := idKey(strings.TrimSuffix(a.name, "List") + "IDs")
+			return []attribute.KeyValue{key.StringSlice(v)}
+		}
+	default:
+		return structAttributes(a.value, false)
+	}
+	return nil
+}
+
+// resultAttributes returns the ID, region and plan of a returned resource
+func resultAttributes(result interface{}) []attribute.KeyValue {
+	return structAttributes(result, true)
+}
+
+func structAttributes(value interface{}, withID bool) []attribute.KeyValue {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var attrs []attribute.KeyValue
+	for _, field := range []struct {
+		name string
+		key  attribute.Key
+	}{{"ID", ResourceIDKey}, {"Region", RegionKey}, {"Plan", PlanKey}} {
+		if field.key == ResourceIDKey && !withID {
+			continue
+		}
+
+		if s := stringField(v, field.name); s != "" {
+			attrs = append(attrs, field.key.String(s))
+		}
+	}
+	return attrs
+}
+
+// stringField returns the value of a string, or govultr.Optional[string], field of v
+func stringField(v reflect.Value, name string) string {
+	f := v.FieldByName(name)
+	if !f.IsValid() {
+		return ""
+	}
+
+	if f.Kind() == reflect.String {
+		return f.String()
+	}
+	if optional, ok := f.Interface().(govultr.Optional[string]); ok {
+		s, _ := optional.Get()
+		return s
+	}
+	return ""
+}
+
+// idKey maps an ID parameter to its attribute key: id becomes vultr.resource.id, instanceID
+// becomes vultr.instance.id and nodePoolIDs vultr.node_pool.ids. Domain names identify domains.
+func idKey(name string) (attribute.Key, bool) {
+	switch {
+	case name == "id":
+		return ResourceIDKey, true
+	case name == "domain":
+		return attribute.Key("vultr.domain.name"), true
+	case strings.HasSuffix(name, "IDs"):
+		return attribute.Key("vultr." + snakeCase(strings.TrimSuffix(name, "IDs")) + ".ids"), true
+	case strings.HasSuffix(name, "ID"):
+		return attribute.Key("vultr." + snakeCase(strings.TrimSuffix(name, "ID")) + ".id"), true
+	}
+	return "", false
+}
+
+// snakeCase turns a camel case name into snake case, e.g. nodePool into node_pool
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,204 @@
+package otelgovultr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vultr/govultr/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setup(t *testing.T, handler http.HandlerFunc) (*govultr.Client, *tracetest.InMemoryExporter, *sdkmetric.ManualReader) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	exporter := tracetest.NewInMemoryExporter()
+	reader := sdkmetric.NewManualReader()
+
+	client, err := govultr.New(govultr.WithBaseURL(server.URL), govultr.WithRetryWait(time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatalf("New returned %+v", err)
+	}
+
+	instrumented, err := Instrument(client,
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	if err != nil {
+		t.Fatalf("Instrument returned %+v", err)
+	}
+
+	return instrumented, exporter, reader
+}
+
+func attributes(kvs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	m := map[attribute.Key]attribute.Value{}
+	for _, kv := range kvs {
+		m[kv.Key] = kv.Value
+	}
+	return m
+}
+
+func TestInstrument_Spans(t *testing.T) {
+	client, exporter, _ := setup(t, func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"instance":{"id":"14b3e7d6-ffb5-4994-8502-57fcd9db3b33","region":"ewr","plan":"vc2-1c-1gb","default_password":"nreqnusibni"}}`)
+	})
+
+	_, _, err := client.Instance.Create(context.Background(), &govultr.InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 362})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	if _, err := client.Instance.AttachVPC(context.Background(), "14b3e7d6-ffb5-4994-8502-57fcd9db3b33", "vpc-1"); err != nil {
+		t.Fatalf("Instance.AttachVPC returned %+v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("recorded %d spans, expected 2", len(spans))
+	}
+
+	create := spans[0]
+	if create.Name != "govultr.Instance.Create" {
+		t.Errorf("span name = %v, expected %v", create.Name, "govultr.Instance.Create")
+	}
+
+	attrs := attributes(create.Attributes)
+	expected := map[attribute.Key]string{
+		OperationKey:  "Instance.Create",
+		ResourceIDKey: "14b3e7d6-ffb5-4994-8502-57fcd9db3b33",
+		RegionKey:     "ewr",
+		PlanKey:       "vc2-1c-1gb",
+	}
+	for key, value := range expected {
+		if attrs[key].AsString() != value {
+			t.Errorf("span attribute %s = %v, expected %v", key, attrs[key].AsString(), value)
+		}
+	}
+	if attrs["http.response.status_code"].AsInt64() != http.StatusOK {
+		t.Errorf("span status code = %v, expected %v", attrs["http.response.status_code"].AsInt64(), http.StatusOK)
+	}
+
+	attrs = attributes(spans[1].Attributes)
+	if attrs["vultr.instance.id"].AsString() != "14b3e7d6-ffb5-4994-8502-57fcd9db3b33" || attrs["vultr.vpc.id"].AsString() != "vpc-1" {
+		t.Errorf("AttachVPC span attributes = %v", spans[1].Attributes)
+	}
+}
+
+func TestInstrument_SpanError(t *testing.T) {
+	client, exporter, _ := setup(t, func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(writer, `{"error":"Invalid instance-id.","status":404}`)
+	})
+
+	if _, _, err := client.Instance.Get(context.Background(), "missing"); !govultr.IsNotFound(err) {
+		t.Fatalf("Instance.Get returned %+v, expected a not found error", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("recorded %d spans, expected 1", len(spans))
+	}
+
+	if spans[0].Status.Code != codes.Error {
+		t.Errorf("span status = %v, expected %v", spans[0].Status.Code, codes.Error)
+	}
+
+	attrs := attributes(spans[0].Attributes)
+	if attrs["http.response.status_code"].AsInt64() != http.StatusNotFound {
+		t.Errorf("span status code = %v, expected %v", attrs["http.response.status_code"].AsInt64(), http.StatusNotFound)
+	}
+}
+
+func TestInstrument_Metrics(t *testing.T) {
+	var calls int32
+	client, _, reader := setup(t, func(writer http.ResponseWriter, request *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(writer, `{"instance":{"id":"abc"}}`)
+	})
+
+	if _, _, err := client.Instance.Get(context.Background(), "abc"); err != nil {
+		t.Fatalf("Instance.Get returned %+v", err)
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect returned %+v", err)
+	}
+
+	metrics := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+
+	requests, ok := metrics["vultr.client.requests"].(metricdata.Sum[int64])
+	if !ok || len(requests.DataPoints) != 1 || requests.DataPoints[0].Value != 1 {
+		t.Fatalf("vultr.client.requests = %+v", metrics["vultr.client.requests"])
+	}
+
+	point := requests.DataPoints[0]
+	if op, _ := point.Attributes.Value(OperationKey); op.AsString() != "Instance.Get" {
+		t.Errorf("request operation = %v, expected %v", op.AsString(), "Instance.Get")
+	}
+	if status, _ := point.Attributes.Value("http.response.status_code"); status.AsInt64() != http.StatusOK {
+		t.Errorf("request status = %v, expected %v", status.AsInt64(), http.StatusOK)
+	}
+
+	duration, ok := metrics["vultr.client.request.duration"].(metricdata.Histogram[float64])
+	if !ok || len(duration.DataPoints) != 1 || duration.DataPoints[0].Count != 1 {
+		t.Errorf("vultr.client.request.duration = %+v", metrics["vultr.client.request.duration"])
+	}
+
+	retries, ok := metrics["vultr.client.request.retries"].(metricdata.Histogram[int64])
+	if !ok || len(retries.DataPoints) != 1 || retries.DataPoints[0].Sum != 1 {
+		t.Errorf("vultr.client.request.retries = %+v", metrics["vultr.client.request.retries"])
+	}
+}
+
+func TestInstrument_LeavesClientUntouched(t *testing.T) {
+	client := govultr.NewClient(nil)
+	instance := client.Instance
+
+	if _, err := Instrument(client); err != nil {
+		t.Fatalf("Instrument returned %+v", err)
+	}
+
+	if client.Instance != instance {
+		t.Error("Instrument modified the services of the original client")
+	}
+}
+
+func TestIDKey(t *testing.T) {
+	tests := map[string]attribute.Key{
+		"id":          ResourceIDKey,
+		"instanceID":  "vultr.instance.id",
+		"nodePoolID":  "vultr.node_pool.id",
+		"instanceIDs": "vultr.instance.ids",
+		"domain":      "vultr.domain.name",
+	}
+
+	for name, expected := range tests {
+		if key, ok := idKey(name); !ok || key != expected {
+			t.Errorf("idKey(%q) = %v, expected %v", name, key, expected)
+		}
+	}
+
+	if _, ok := idKey("description"); ok {
+		t.Error("idKey(description) reported an ID")
+	}
+}
@@ -0,0 +1,1684 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package otelgovultr
+
+import (
+	"context"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// wrap replaces the services of c with their traced counterparts
+func (i *instrumentation) wrap(c *govultr.Client) {
+	c.Account = &accountService{next: c.Account, instrumentation: i}
+	c.Application = &applicationService{next: c.Application, instrumentation: i}
+	c.Backup = &backupService{next: c.Backup, instrumentation: i}
+	c.BareMetalServer = &bareMetalServerService{next: c.BareMetalServer, instrumentation: i}
+	c.Billing = &billingService{next: c.Billing, instrumentation: i}
+	c.BlockStorage = &blockStorageService{next: c.BlockStorage, instrumentation: i}
+	c.Database = &databaseService{next: c.Database, instrumentation: i}
+	c.Domain = &domainService{next: c.Domain, instrumentation: i}
+	c.DomainRecord = &domainRecordService{next: c.DomainRecord, instrumentation: i}
+	c.FirewallGroup = &firewallGroupService{next: c.FirewallGroup, instrumentation: i}
+	c.FirewallRule = &firewallRuleService{next: c.FirewallRule, instrumentation: i}
+	c.Instance = &instanceService{next: c.Instance, instrumentation: i}
+	c.ISO = &isoService{next: c.ISO, instrumentation: i}
+	c.Kubernetes = &kubernetesService{next: c.Kubernetes, instrumentation: i}
+	c.LoadBalancer = &loadBalancerService{next: c.LoadBalancer, instrumentation: i}
+	c.Network = &networkService{next: c.Network, instrumentation: i}
+	c.ObjectStorage = &objectStorageService{next: c.ObjectStorage, instrumentation: i}
+	c.OS = &osService{next: c.OS, instrumentation: i}
+	c.Plan = &planService{next: c.Plan, instrumentation: i}
+	c.Region = &regionService{next: c.Region, instrumentation: i}
+	c.ReservedIP = &reservedIPService{next: c.ReservedIP, instrumentation: i}
+	c.Snapshot = &snapshotService{next: c.Snapshot, instrumentation: i}
+	c.SSHKey = &sshKeyService{next: c.SSHKey, instrumentation: i}
+	c.StartupScript = &startupScriptService{next: c.StartupScript, instrumentation: i}
+	c.User = &userService{next: c.User, instrumentation: i}
+	c.VPC = &vpcService{next: c.VPC, instrumentation: i}
+}
+
+var _ govultr.AccountService = (*accountService)(nil)
+
+type accountService struct {
+	next govultr.AccountService
+	*instrumentation
+}
+
+func (w *accountService) Get(ctx context.Context) (*govultr.Account, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Account.Get")
+	r0, r1, err := w.next.Get(ctx)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+var _ govultr.ApplicationService = (*applicationService)(nil)
+
+type applicationService struct {
+	next govultr.ApplicationService
+	*instrumentation
+}
+
+func (w *applicationService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Application, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Application.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.BackupService = (*backupService)(nil)
+
+type backupService struct {
+	next govultr.BackupService
+	*instrumentation
+}
+
+func (w *backupService) Get(ctx context.Context, backupID string) (*govultr.Backup, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Backup.Get", arg{"backupID", backupID})
+	r0, r1, err := w.next.Get(ctx, backupID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *backupService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Backup, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Backup.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.BareMetalServerService = (*bareMetalServerService)(nil)
+
+type bareMetalServerService struct {
+	next govultr.BareMetalServerService
+	*instrumentation
+}
+
+func (w *bareMetalServerService) Create(ctx context.Context, bmCreate *govultr.BareMetalCreate) (*govultr.BareMetalServer, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.Create", arg{"bmCreate", bmCreate})
+	r0, r1, err := w.next.Create(ctx, bmCreate)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *bareMetalServerService) Get(ctx context.Context, serverID string) (*govultr.BareMetalServer, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.Get", arg{"serverID", serverID})
+	r0, r1, err := w.next.Get(ctx, serverID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *bareMetalServerService) Update(ctx context.Context, serverID string, bmReq *govultr.BareMetalUpdate) (*govultr.BareMetalServer, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.Update", arg{"serverID", serverID}, arg{"bmReq", bmReq})
+	r0, r1, err := w.next.Update(ctx, serverID, bmReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *bareMetalServerService) Delete(ctx context.Context, serverID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.Delete", arg{"serverID", serverID})
+	r0, err := w.next.Delete(ctx, serverID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *bareMetalServerService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.BareMetalServer, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *bareMetalServerService) GetBandwidth(ctx context.Context, serverID string) (*govultr.Bandwidth, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.GetBandwidth", arg{"serverID", serverID})
+	r0, r1, err := w.next.GetBandwidth(ctx, serverID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *bareMetalServerService) GetUserData(ctx context.Context, serverID string) (*govultr.UserData, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.GetUserData", arg{"serverID", serverID})
+	r0, r1, err := w.next.GetUserData(ctx, serverID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *bareMetalServerService) GetVNCUrl(ctx context.Context, serverID string) (*govultr.VNCUrl, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.GetVNCUrl", arg{"serverID", serverID})
+	r0, r1, err := w.next.GetVNCUrl(ctx, serverID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *bareMetalServerService) ListIPv4s(ctx context.Context, serverID string, options *govultr.ListOptions) ([]govultr.IPv4, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.ListIPv4s", arg{"serverID", serverID}, arg{"options", options})
+	r0, r1, r2, err := w.next.ListIPv4s(ctx, serverID, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *bareMetalServerService) ListIPv6s(ctx context.Context, serverID string, options *govultr.ListOptions) ([]govultr.IPv6, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.ListIPv6s", arg{"serverID", serverID}, arg{"options", options})
+	r0, r1, r2, err := w.next.ListIPv6s(ctx, serverID, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *bareMetalServerService) Halt(ctx context.Context, serverID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.Halt", arg{"serverID", serverID})
+	r0, err := w.next.Halt(ctx, serverID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *bareMetalServerService) Reboot(ctx context.Context, serverID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.Reboot", arg{"serverID", serverID})
+	r0, err := w.next.Reboot(ctx, serverID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *bareMetalServerService) Start(ctx context.Context, serverID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.Start", arg{"serverID", serverID})
+	r0, err := w.next.Start(ctx, serverID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *bareMetalServerService) Reinstall(ctx context.Context, serverID string) (*govultr.BareMetalServer, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.Reinstall", arg{"serverID", serverID})
+	r0, r1, err := w.next.Reinstall(ctx, serverID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *bareMetalServerService) MassStart(ctx context.Context, serverList []string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.MassStart", arg{"serverList", serverList})
+	r0, err := w.next.MassStart(ctx, serverList)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *bareMetalServerService) MassHalt(ctx context.Context, serverList []string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.MassHalt", arg{"serverList", serverList})
+	r0, err := w.next.MassHalt(ctx, serverList)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *bareMetalServerService) MassReboot(ctx context.Context, serverList []string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.MassReboot", arg{"serverList", serverList})
+	r0, err := w.next.MassReboot(ctx, serverList)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *bareMetalServerService) GetUpgrades(ctx context.Context, serverID string) (*govultr.Upgrades, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "BareMetalServer.GetUpgrades", arg{"serverID", serverID})
+	r0, r1, err := w.next.GetUpgrades(ctx, serverID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+var _ govultr.BillingService = (*billingService)(nil)
+
+type billingService struct {
+	next govultr.BillingService
+	*instrumentation
+}
+
+func (w *billingService) ListHistory(ctx context.Context, options *govultr.ListOptions) ([]govultr.History, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Billing.ListHistory", arg{"options", options})
+	r0, r1, r2, err := w.next.ListHistory(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *billingService) ListInvoices(ctx context.Context, options *govultr.ListOptions) ([]govultr.Invoice, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Billing.ListInvoices", arg{"options", options})
+	r0, r1, r2, err := w.next.ListInvoices(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *billingService) GetInvoice(ctx context.Context, invoiceID string) (*govultr.Invoice, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Billing.GetInvoice", arg{"invoiceID", invoiceID})
+	r0, r1, err := w.next.GetInvoice(ctx, invoiceID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *billingService) ListInvoiceItems(ctx context.Context, invoiceID int, options *govultr.ListOptions) ([]govultr.InvoiceItem, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Billing.ListInvoiceItems", arg{"invoiceID", invoiceID}, arg{"options", options})
+	r0, r1, r2, err := w.next.ListInvoiceItems(ctx, invoiceID, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.BlockStorageService = (*blockStorageService)(nil)
+
+type blockStorageService struct {
+	next govultr.BlockStorageService
+	*instrumentation
+}
+
+func (w *blockStorageService) Create(ctx context.Context, blockReq *govultr.BlockStorageCreate) (*govultr.BlockStorage, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "BlockStorage.Create", arg{"blockReq", blockReq})
+	r0, r1, err := w.next.Create(ctx, blockReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *blockStorageService) Get(ctx context.Context, blockID string) (*govultr.BlockStorage, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "BlockStorage.Get", arg{"blockID", blockID})
+	r0, r1, err := w.next.Get(ctx, blockID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *blockStorageService) Update(ctx context.Context, blockID string, blockReq *govultr.BlockStorageUpdate) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "BlockStorage.Update", arg{"blockID", blockID}, arg{"blockReq", blockReq})
+	r0, err := w.next.Update(ctx, blockID, blockReq)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *blockStorageService) Delete(ctx context.Context, blockID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "BlockStorage.Delete", arg{"blockID", blockID})
+	r0, err := w.next.Delete(ctx, blockID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *blockStorageService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.BlockStorage, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "BlockStorage.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *blockStorageService) Attach(ctx context.Context, blockID string, attach *govultr.BlockStorageAttach) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "BlockStorage.Attach", arg{"blockID", blockID}, arg{"attach", attach})
+	r0, err := w.next.Attach(ctx, blockID, attach)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *blockStorageService) Detach(ctx context.Context, blockID string, detach *govultr.BlockStorageDetach) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "BlockStorage.Detach", arg{"blockID", blockID}, arg{"detach", detach})
+	r0, err := w.next.Detach(ctx, blockID, detach)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+var _ govultr.DatabaseService = (*databaseService)(nil)
+
+type databaseService struct {
+	next govultr.DatabaseService
+	*instrumentation
+}
+
+func (w *databaseService) ListPlans(ctx context.Context, options *govultr.DBPlanListOptions) ([]govultr.DatabasePlan, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.ListPlans", arg{"options", options})
+	r0, r1, r2, err := w.next.ListPlans(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *databaseService) List(ctx context.Context, options *govultr.DBListOptions) ([]govultr.Database, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *databaseService) Create(ctx context.Context, databaseReq *govultr.DatabaseCreateReq) (*govultr.Database, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.Create", arg{"databaseReq", databaseReq})
+	r0, r1, err := w.next.Create(ctx, databaseReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) Get(ctx context.Context, databaseID string) (*govultr.Database, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.Get", arg{"databaseID", databaseID})
+	r0, r1, err := w.next.Get(ctx, databaseID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) Update(ctx context.Context, databaseID string, databaseReq *govultr.DatabaseUpdateReq) (*govultr.Database, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.Update", arg{"databaseID", databaseID}, arg{"databaseReq", databaseReq})
+	r0, r1, err := w.next.Update(ctx, databaseID, databaseReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) Delete(ctx context.Context, databaseID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.Delete", arg{"databaseID", databaseID})
+	r0, err := w.next.Delete(ctx, databaseID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *databaseService) ListUsers(ctx context.Context, databaseID string, options *govultr.ListOptions) ([]govultr.DatabaseUser, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.ListUsers", arg{"databaseID", databaseID}, arg{"options", options})
+	r0, r1, r2, err := w.next.ListUsers(ctx, databaseID, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *databaseService) CreateUser(ctx context.Context, databaseID string, databaseUserReq *govultr.DatabaseUserCreateReq) (*govultr.DatabaseUser, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.CreateUser", arg{"databaseID", databaseID}, arg{"databaseUserReq", databaseUserReq})
+	r0, r1, err := w.next.CreateUser(ctx, databaseID, databaseUserReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) GetUser(ctx context.Context, databaseID string, username string) (*govultr.DatabaseUser, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.GetUser", arg{"databaseID", databaseID}, arg{"username", username})
+	r0, r1, err := w.next.GetUser(ctx, databaseID, username)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) UpdateUser(ctx context.Context, databaseID string, username string, databaseUserReq *govultr.DatabaseUserUpdateReq) (*govultr.DatabaseUser, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.UpdateUser", arg{"databaseID", databaseID}, arg{"username", username}, arg{"databaseUserReq", databaseUserReq})
+	r0, r1, err := w.next.UpdateUser(ctx, databaseID, username, databaseUserReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) DeleteUser(ctx context.Context, databaseID string, username string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.DeleteUser", arg{"databaseID", databaseID}, arg{"username", username})
+	r0, err := w.next.DeleteUser(ctx, databaseID, username)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *databaseService) ListDBs(ctx context.Context, databaseID string, options *govultr.ListOptions) ([]govultr.DatabaseDB, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.ListDBs", arg{"databaseID", databaseID}, arg{"options", options})
+	r0, r1, r2, err := w.next.ListDBs(ctx, databaseID, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *databaseService) CreateDB(ctx context.Context, databaseID string, databaseDBReq *govultr.DatabaseDBCreateReq) (*govultr.DatabaseDB, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.CreateDB", arg{"databaseID", databaseID}, arg{"databaseDBReq", databaseDBReq})
+	r0, r1, err := w.next.CreateDB(ctx, databaseID, databaseDBReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) GetDB(ctx context.Context, databaseID string, dbname string) (*govultr.DatabaseDB, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.GetDB", arg{"databaseID", databaseID}, arg{"dbname", dbname})
+	r0, r1, err := w.next.GetDB(ctx, databaseID, dbname)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) DeleteDB(ctx context.Context, databaseID string, dbname string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.DeleteDB", arg{"databaseID", databaseID}, arg{"dbname", dbname})
+	r0, err := w.next.DeleteDB(ctx, databaseID, dbname)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *databaseService) ListMaintenanceUpdates(ctx context.Context, databaseID string) ([]string, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.ListMaintenanceUpdates", arg{"databaseID", databaseID})
+	r0, r1, err := w.next.ListMaintenanceUpdates(ctx, databaseID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) StartMaintenance(ctx context.Context, databaseID string) (string, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.StartMaintenance", arg{"databaseID", databaseID})
+	r0, r1, err := w.next.StartMaintenance(ctx, databaseID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) ListServiceAlerts(ctx context.Context, databaseID string, databaseAlertsReq *govultr.DatabaseListAlertsReq) ([]govultr.DatabaseAlert, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.ListServiceAlerts", arg{"databaseID", databaseID}, arg{"databaseAlertsReq", databaseAlertsReq})
+	r0, r1, err := w.next.ListServiceAlerts(ctx, databaseID, databaseAlertsReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) GetMigrationStatus(ctx context.Context, databaseID string) (*govultr.DatabaseMigration, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.GetMigrationStatus", arg{"databaseID", databaseID})
+	r0, r1, err := w.next.GetMigrationStatus(ctx, databaseID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) StartMigration(ctx context.Context, databaseID string, databaseMigrationReq *govultr.DatabaseMigrationStartReq) (*govultr.DatabaseMigration, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.StartMigration", arg{"databaseID", databaseID}, arg{"databaseMigrationReq", databaseMigrationReq})
+	r0, r1, err := w.next.StartMigration(ctx, databaseID, databaseMigrationReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) DetachMigration(ctx context.Context, databaseID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.DetachMigration", arg{"databaseID", databaseID})
+	r0, err := w.next.DetachMigration(ctx, databaseID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *databaseService) AddReadOnlyReplica(ctx context.Context, databaseID string, databaseReplicaReq *govultr.DatabaseAddReplicaReq) (*govultr.Database, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.AddReadOnlyReplica", arg{"databaseID", databaseID}, arg{"databaseReplicaReq", databaseReplicaReq})
+	r0, r1, err := w.next.AddReadOnlyReplica(ctx, databaseID, databaseReplicaReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) GetBackupInformation(ctx context.Context, databaseID string) (*govultr.DatabaseBackups, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.GetBackupInformation", arg{"databaseID", databaseID})
+	r0, r1, err := w.next.GetBackupInformation(ctx, databaseID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) RestoreFromBackup(ctx context.Context, databaseID string, databaseRestoreReq *govultr.DatabaseBackupRestoreReq) (*govultr.Database, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.RestoreFromBackup", arg{"databaseID", databaseID}, arg{"databaseRestoreReq", databaseRestoreReq})
+	r0, r1, err := w.next.RestoreFromBackup(ctx, databaseID, databaseRestoreReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) Fork(ctx context.Context, databaseID string, databaseForkReq *govultr.DatabaseForkReq) (*govultr.Database, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.Fork", arg{"databaseID", databaseID}, arg{"databaseForkReq", databaseForkReq})
+	r0, r1, err := w.next.Fork(ctx, databaseID, databaseForkReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) ListConnectionPools(ctx context.Context, databaseID string, options *govultr.ListOptions) (*govultr.DatabaseConnections, []govultr.DatabaseConnectionPool, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.ListConnectionPools", arg{"databaseID", databaseID}, arg{"options", options})
+	r0, r1, r2, r3, err := w.next.ListConnectionPools(ctx, databaseID, options)
+	w.end(span, err, r0, r1, r2, r3)
+	return r0, r1, r2, r3, err
+}
+
+func (w *databaseService) CreateConnectionPool(ctx context.Context, databaseID string, databaseConnectionPoolReq *govultr.DatabaseConnectionPoolCreateReq) (*govultr.DatabaseConnectionPool, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.CreateConnectionPool", arg{"databaseID", databaseID}, arg{"databaseConnectionPoolReq", databaseConnectionPoolReq})
+	r0, r1, err := w.next.CreateConnectionPool(ctx, databaseID, databaseConnectionPoolReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) GetConnectionPool(ctx context.Context, databaseID string, poolName string) (*govultr.DatabaseConnectionPool, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.GetConnectionPool", arg{"databaseID", databaseID}, arg{"poolName", poolName})
+	r0, r1, err := w.next.GetConnectionPool(ctx, databaseID, poolName)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) UpdateConnectionPool(ctx context.Context, databaseID string, poolName string, databaseConnectionPoolReq *govultr.DatabaseConnectionPoolUpdateReq) (*govultr.DatabaseConnectionPool, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.UpdateConnectionPool", arg{"databaseID", databaseID}, arg{"poolName", poolName}, arg{"databaseConnectionPoolReq", databaseConnectionPoolReq})
+	r0, r1, err := w.next.UpdateConnectionPool(ctx, databaseID, poolName, databaseConnectionPoolReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) DeleteConnectionPool(ctx context.Context, databaseID string, poolName string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.DeleteConnectionPool", arg{"databaseID", databaseID}, arg{"poolName", poolName})
+	r0, err := w.next.DeleteConnectionPool(ctx, databaseID, poolName)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *databaseService) ListAdvancedOptions(ctx context.Context, databaseID string) (*govultr.DatabaseAdvancedOptions, []govultr.AvailableOption, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.ListAdvancedOptions", arg{"databaseID", databaseID})
+	r0, r1, r2, err := w.next.ListAdvancedOptions(ctx, databaseID)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *databaseService) UpdateAdvancedOptions(ctx context.Context, databaseID string, databaseAdvancedOptionsReq *govultr.DatabaseAdvancedOptions) (*govultr.DatabaseAdvancedOptions, []govultr.AvailableOption, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.UpdateAdvancedOptions", arg{"databaseID", databaseID}, arg{"databaseAdvancedOptionsReq", databaseAdvancedOptionsReq})
+	r0, r1, r2, err := w.next.UpdateAdvancedOptions(ctx, databaseID, databaseAdvancedOptionsReq)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *databaseService) ListAvailableVersions(ctx context.Context, databaseID string) ([]string, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.ListAvailableVersions", arg{"databaseID", databaseID})
+	r0, r1, err := w.next.ListAvailableVersions(ctx, databaseID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *databaseService) StartVersionUpgrade(ctx context.Context, databaseID string, databaseVersionUpgradeReq *govultr.DatabaseVersionUpgradeReq) (string, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Database.StartVersionUpgrade", arg{"databaseID", databaseID}, arg{"databaseVersionUpgradeReq", databaseVersionUpgradeReq})
+	r0, r1, err := w.next.StartVersionUpgrade(ctx, databaseID, databaseVersionUpgradeReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+var _ govultr.DomainService = (*domainService)(nil)
+
+type domainService struct {
+	next govultr.DomainService
+	*instrumentation
+}
+
+func (w *domainService) Create(ctx context.Context, domainReq *govultr.DomainReq) (*govultr.Domain, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Domain.Create", arg{"domainReq", domainReq})
+	r0, r1, err := w.next.Create(ctx, domainReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *domainService) Get(ctx context.Context, domain string) (*govultr.Domain, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Domain.Get", arg{"domain", domain})
+	r0, r1, err := w.next.Get(ctx, domain)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *domainService) Update(ctx context.Context, domain string, dnsSec string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Domain.Update", arg{"domain", domain}, arg{"dnsSec", dnsSec})
+	r0, err := w.next.Update(ctx, domain, dnsSec)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *domainService) Delete(ctx context.Context, domain string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Domain.Delete", arg{"domain", domain})
+	r0, err := w.next.Delete(ctx, domain)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *domainService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Domain, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Domain.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *domainService) GetSoa(ctx context.Context, domain string) (*govultr.Soa, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Domain.GetSoa", arg{"domain", domain})
+	r0, r1, err := w.next.GetSoa(ctx, domain)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *domainService) UpdateSoa(ctx context.Context, domain string, soaReq *govultr.Soa) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Domain.UpdateSoa", arg{"domain", domain}, arg{"soaReq", soaReq})
+	r0, err := w.next.UpdateSoa(ctx, domain, soaReq)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *domainService) GetDNSSec(ctx context.Context, domain string) ([]string, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Domain.GetDNSSec", arg{"domain", domain})
+	r0, r1, err := w.next.GetDNSSec(ctx, domain)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+var _ govultr.DomainRecordService = (*domainRecordService)(nil)
+
+type domainRecordService struct {
+	next govultr.DomainRecordService
+	*instrumentation
+}
+
+func (w *domainRecordService) Create(ctx context.Context, domain string, domainRecordReq *govultr.DomainRecordReq) (*govultr.DomainRecord, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "DomainRecord.Create", arg{"domain", domain}, arg{"domainRecordReq", domainRecordReq})
+	r0, r1, err := w.next.Create(ctx, domain, domainRecordReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *domainRecordService) Get(ctx context.Context, domain string, recordID string) (*govultr.DomainRecord, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "DomainRecord.Get", arg{"domain", domain}, arg{"recordID", recordID})
+	r0, r1, err := w.next.Get(ctx, domain, recordID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *domainRecordService) Update(ctx context.Context, domain string, recordID string, domainRecordReq *govultr.DomainRecordReq) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "DomainRecord.Update", arg{"domain", domain}, arg{"recordID", recordID}, arg{"domainRecordReq", domainRecordReq})
+	r0, err := w.next.Update(ctx, domain, recordID, domainRecordReq)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *domainRecordService) Delete(ctx context.Context, domain string, recordID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "DomainRecord.Delete", arg{"domain", domain}, arg{"recordID", recordID})
+	r0, err := w.next.Delete(ctx, domain, recordID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *domainRecordService) List(ctx context.Context, domain string, options *govultr.ListOptions) ([]govultr.DomainRecord, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "DomainRecord.List", arg{"domain", domain}, arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, domain, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.FirewallGroupService = (*firewallGroupService)(nil)
+
+type firewallGroupService struct {
+	next govultr.FirewallGroupService
+	*instrumentation
+}
+
+func (w *firewallGroupService) Create(ctx context.Context, fwGroupReq *govultr.FirewallGroupReq) (*govultr.FirewallGroup, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "FirewallGroup.Create", arg{"fwGroupReq", fwGroupReq})
+	r0, r1, err := w.next.Create(ctx, fwGroupReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *firewallGroupService) Get(ctx context.Context, groupID string) (*govultr.FirewallGroup, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "FirewallGroup.Get", arg{"groupID", groupID})
+	r0, r1, err := w.next.Get(ctx, groupID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *firewallGroupService) Update(ctx context.Context, fwGroupID string, fwGroupReq *govultr.FirewallGroupReq) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "FirewallGroup.Update", arg{"fwGroupID", fwGroupID}, arg{"fwGroupReq", fwGroupReq})
+	r0, err := w.next.Update(ctx, fwGroupID, fwGroupReq)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *firewallGroupService) Delete(ctx context.Context, fwGroupID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "FirewallGroup.Delete", arg{"fwGroupID", fwGroupID})
+	r0, err := w.next.Delete(ctx, fwGroupID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *firewallGroupService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.FirewallGroup, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "FirewallGroup.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.FireWallRuleService = (*firewallRuleService)(nil)
+
+type firewallRuleService struct {
+	next govultr.FireWallRuleService
+	*instrumentation
+}
+
+func (w *firewallRuleService) Create(ctx context.Context, fwGroupID string, fwRuleReq *govultr.FirewallRuleReq) (*govultr.FirewallRule, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "FirewallRule.Create", arg{"fwGroupID", fwGroupID}, arg{"fwRuleReq", fwRuleReq})
+	r0, r1, err := w.next.Create(ctx, fwGroupID, fwRuleReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *firewallRuleService) Get(ctx context.Context, fwGroupID string, fwRuleID int) (*govultr.FirewallRule, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "FirewallRule.Get", arg{"fwGroupID", fwGroupID}, arg{"fwRuleID", fwRuleID})
+	r0, r1, err := w.next.Get(ctx, fwGroupID, fwRuleID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *firewallRuleService) Delete(ctx context.Context, fwGroupID string, fwRuleID int) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "FirewallRule.Delete", arg{"fwGroupID", fwGroupID}, arg{"fwRuleID", fwRuleID})
+	r0, err := w.next.Delete(ctx, fwGroupID, fwRuleID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *firewallRuleService) List(ctx context.Context, fwGroupID string, options *govultr.ListOptions) ([]govultr.FirewallRule, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "FirewallRule.List", arg{"fwGroupID", fwGroupID}, arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, fwGroupID, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.InstanceService = (*instanceService)(nil)
+
+type instanceService struct {
+	next govultr.InstanceService
+	*instrumentation
+}
+
+func (w *instanceService) Create(ctx context.Context, instanceReq *govultr.InstanceCreateReq) (*govultr.Instance, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.Create", arg{"instanceReq", instanceReq})
+	r0, r1, err := w.next.Create(ctx, instanceReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *instanceService) Get(ctx context.Context, instanceID string) (*govultr.Instance, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.Get", arg{"instanceID", instanceID})
+	r0, r1, err := w.next.Get(ctx, instanceID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *instanceService) Update(ctx context.Context, instanceID string, instanceReq *govultr.InstanceUpdateReq) (*govultr.Instance, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.Update", arg{"instanceID", instanceID}, arg{"instanceReq", instanceReq})
+	r0, r1, err := w.next.Update(ctx, instanceID, instanceReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *instanceService) Delete(ctx context.Context, instanceID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.Delete", arg{"instanceID", instanceID})
+	r0, err := w.next.Delete(ctx, instanceID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Instance, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *instanceService) Start(ctx context.Context, instanceID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.Start", arg{"instanceID", instanceID})
+	r0, err := w.next.Start(ctx, instanceID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) Halt(ctx context.Context, instanceID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.Halt", arg{"instanceID", instanceID})
+	r0, err := w.next.Halt(ctx, instanceID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) Reboot(ctx context.Context, instanceID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.Reboot", arg{"instanceID", instanceID})
+	r0, err := w.next.Reboot(ctx, instanceID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) Reinstall(ctx context.Context, instanceID string, reinstallReq *govultr.ReinstallReq) (*govultr.Instance, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.Reinstall", arg{"instanceID", instanceID}, arg{"reinstallReq", reinstallReq})
+	r0, r1, err := w.next.Reinstall(ctx, instanceID, reinstallReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *instanceService) MassStart(ctx context.Context, instanceList []string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.MassStart", arg{"instanceList", instanceList})
+	r0, err := w.next.MassStart(ctx, instanceList)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) MassHalt(ctx context.Context, instanceList []string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.MassHalt", arg{"instanceList", instanceList})
+	r0, err := w.next.MassHalt(ctx, instanceList)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) MassReboot(ctx context.Context, instanceList []string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.MassReboot", arg{"instanceList", instanceList})
+	r0, err := w.next.MassReboot(ctx, instanceList)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) Restore(ctx context.Context, instanceID string, restoreReq *govultr.RestoreReq) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.Restore", arg{"instanceID", instanceID}, arg{"restoreReq", restoreReq})
+	r0, err := w.next.Restore(ctx, instanceID, restoreReq)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) GetBandwidth(ctx context.Context, instanceID string) (*govultr.Bandwidth, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.GetBandwidth", arg{"instanceID", instanceID})
+	r0, r1, err := w.next.GetBandwidth(ctx, instanceID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *instanceService) GetNeighbors(ctx context.Context, instanceID string) (*govultr.Neighbors, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.GetNeighbors", arg{"instanceID", instanceID})
+	r0, r1, err := w.next.GetNeighbors(ctx, instanceID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *instanceService) ListPrivateNetworks(ctx context.Context, instanceID string, options *govultr.ListOptions) ([]govultr.PrivateNetwork, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.ListPrivateNetworks", arg{"instanceID", instanceID}, arg{"options", options})
+	r0, r1, r2, err := w.next.ListPrivateNetworks(ctx, instanceID, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *instanceService) AttachPrivateNetwork(ctx context.Context, instanceID string, networkID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.AttachPrivateNetwork", arg{"instanceID", instanceID}, arg{"networkID", networkID})
+	r0, err := w.next.AttachPrivateNetwork(ctx, instanceID, networkID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) DetachPrivateNetwork(ctx context.Context, instanceID string, networkID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.DetachPrivateNetwork", arg{"instanceID", instanceID}, arg{"networkID", networkID})
+	r0, err := w.next.DetachPrivateNetwork(ctx, instanceID, networkID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) ListVPCInfo(ctx context.Context, instanceID string, options *govultr.ListOptions) ([]govultr.VPCInfo, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.ListVPCInfo", arg{"instanceID", instanceID}, arg{"options", options})
+	r0, r1, r2, err := w.next.ListVPCInfo(ctx, instanceID, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *instanceService) AttachVPC(ctx context.Context, instanceID string, vpcID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.AttachVPC", arg{"instanceID", instanceID}, arg{"vpcID", vpcID})
+	r0, err := w.next.AttachVPC(ctx, instanceID, vpcID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) DetachVPC(ctx context.Context, instanceID string, vpcID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.DetachVPC", arg{"instanceID", instanceID}, arg{"vpcID", vpcID})
+	r0, err := w.next.DetachVPC(ctx, instanceID, vpcID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) ISOStatus(ctx context.Context, instanceID string) (*govultr.Iso, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.ISOStatus", arg{"instanceID", instanceID})
+	r0, r1, err := w.next.ISOStatus(ctx, instanceID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *instanceService) AttachISO(ctx context.Context, instanceID string, isoID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.AttachISO", arg{"instanceID", instanceID}, arg{"isoID", isoID})
+	r0, err := w.next.AttachISO(ctx, instanceID, isoID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) DetachISO(ctx context.Context, instanceID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.DetachISO", arg{"instanceID", instanceID})
+	r0, err := w.next.DetachISO(ctx, instanceID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) GetBackupSchedule(ctx context.Context, instanceID string) (*govultr.BackupSchedule, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.GetBackupSchedule", arg{"instanceID", instanceID})
+	r0, r1, err := w.next.GetBackupSchedule(ctx, instanceID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *instanceService) SetBackupSchedule(ctx context.Context, instanceID string, backup *govultr.BackupScheduleReq) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.SetBackupSchedule", arg{"instanceID", instanceID}, arg{"backup", backup})
+	r0, err := w.next.SetBackupSchedule(ctx, instanceID, backup)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) CreateIPv4(ctx context.Context, instanceID string, reboot *bool) (*govultr.IPv4, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.CreateIPv4", arg{"instanceID", instanceID}, arg{"reboot", reboot})
+	r0, r1, err := w.next.CreateIPv4(ctx, instanceID, reboot)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *instanceService) ListIPv4(ctx context.Context, instanceID string, option *govultr.ListOptions) ([]govultr.IPv4, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.ListIPv4", arg{"instanceID", instanceID}, arg{"option", option})
+	r0, r1, r2, err := w.next.ListIPv4(ctx, instanceID, option)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *instanceService) DeleteIPv4(ctx context.Context, instanceID string, ip string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.DeleteIPv4", arg{"instanceID", instanceID}, arg{"ip", ip})
+	r0, err := w.next.DeleteIPv4(ctx, instanceID, ip)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) ListIPv6(ctx context.Context, instanceID string, option *govultr.ListOptions) ([]govultr.IPv6, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.ListIPv6", arg{"instanceID", instanceID}, arg{"option", option})
+	r0, r1, r2, err := w.next.ListIPv6(ctx, instanceID, option)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *instanceService) CreateReverseIPv6(ctx context.Context, instanceID string, reverseReq *govultr.ReverseIP) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.CreateReverseIPv6", arg{"instanceID", instanceID}, arg{"reverseReq", reverseReq})
+	r0, err := w.next.CreateReverseIPv6(ctx, instanceID, reverseReq)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) ListReverseIPv6(ctx context.Context, instanceID string) ([]govultr.ReverseIP, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.ListReverseIPv6", arg{"instanceID", instanceID})
+	r0, r1, err := w.next.ListReverseIPv6(ctx, instanceID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *instanceService) DeleteReverseIPv6(ctx context.Context, instanceID string, ip string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.DeleteReverseIPv6", arg{"instanceID", instanceID}, arg{"ip", ip})
+	r0, err := w.next.DeleteReverseIPv6(ctx, instanceID, ip)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) CreateReverseIPv4(ctx context.Context, instanceID string, reverseReq *govultr.ReverseIP) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.CreateReverseIPv4", arg{"instanceID", instanceID}, arg{"reverseReq", reverseReq})
+	r0, err := w.next.CreateReverseIPv4(ctx, instanceID, reverseReq)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) DefaultReverseIPv4(ctx context.Context, instanceID string, ip string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.DefaultReverseIPv4", arg{"instanceID", instanceID}, arg{"ip", ip})
+	r0, err := w.next.DefaultReverseIPv4(ctx, instanceID, ip)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *instanceService) GetUserData(ctx context.Context, instanceID string) (*govultr.UserData, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.GetUserData", arg{"instanceID", instanceID})
+	r0, r1, err := w.next.GetUserData(ctx, instanceID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *instanceService) GetUpgrades(ctx context.Context, instanceID string) (*govultr.Upgrades, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Instance.GetUpgrades", arg{"instanceID", instanceID})
+	r0, r1, err := w.next.GetUpgrades(ctx, instanceID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+var _ govultr.ISOService = (*isoService)(nil)
+
+type isoService struct {
+	next govultr.ISOService
+	*instrumentation
+}
+
+func (w *isoService) Create(ctx context.Context, isoReq *govultr.ISOReq) (*govultr.ISO, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "ISO.Create", arg{"isoReq", isoReq})
+	r0, r1, err := w.next.Create(ctx, isoReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *isoService) Get(ctx context.Context, isoID string) (*govultr.ISO, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "ISO.Get", arg{"isoID", isoID})
+	r0, r1, err := w.next.Get(ctx, isoID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *isoService) Delete(ctx context.Context, isoID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "ISO.Delete", arg{"isoID", isoID})
+	r0, err := w.next.Delete(ctx, isoID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *isoService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.ISO, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "ISO.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *isoService) ListPublic(ctx context.Context, options *govultr.ListOptions) ([]govultr.PublicISO, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "ISO.ListPublic", arg{"options", options})
+	r0, r1, r2, err := w.next.ListPublic(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.KubernetesService = (*kubernetesService)(nil)
+
+type kubernetesService struct {
+	next govultr.KubernetesService
+	*instrumentation
+}
+
+func (w *kubernetesService) CreateCluster(ctx context.Context, createReq *govultr.ClusterReq) (*govultr.Cluster, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.CreateCluster", arg{"createReq", createReq})
+	r0, r1, err := w.next.CreateCluster(ctx, createReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *kubernetesService) GetCluster(ctx context.Context, id string) (*govultr.Cluster, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.GetCluster", arg{"id", id})
+	r0, r1, err := w.next.GetCluster(ctx, id)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *kubernetesService) ListClusters(ctx context.Context, options *govultr.ListOptions) ([]govultr.Cluster, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.ListClusters", arg{"options", options})
+	r0, r1, r2, err := w.next.ListClusters(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *kubernetesService) UpdateCluster(ctx context.Context, vkeID string, updateReq *govultr.ClusterReqUpdate) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.UpdateCluster", arg{"vkeID", vkeID}, arg{"updateReq", updateReq})
+	r0, err := w.next.UpdateCluster(ctx, vkeID, updateReq)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *kubernetesService) DeleteCluster(ctx context.Context, id string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.DeleteCluster", arg{"id", id})
+	r0, err := w.next.DeleteCluster(ctx, id)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *kubernetesService) DeleteClusterWithResources(ctx context.Context, id string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.DeleteClusterWithResources", arg{"id", id})
+	r0, err := w.next.DeleteClusterWithResources(ctx, id)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *kubernetesService) CreateNodePool(ctx context.Context, vkeID string, nodePoolReq *govultr.NodePoolReq) (*govultr.NodePool, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.CreateNodePool", arg{"vkeID", vkeID}, arg{"nodePoolReq", nodePoolReq})
+	r0, r1, err := w.next.CreateNodePool(ctx, vkeID, nodePoolReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *kubernetesService) ListNodePools(ctx context.Context, vkeID string, options *govultr.ListOptions) ([]govultr.NodePool, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.ListNodePools", arg{"vkeID", vkeID}, arg{"options", options})
+	r0, r1, r2, err := w.next.ListNodePools(ctx, vkeID, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *kubernetesService) GetNodePool(ctx context.Context, vkeID string, nodePoolID string) (*govultr.NodePool, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.GetNodePool", arg{"vkeID", vkeID}, arg{"nodePoolID", nodePoolID})
+	r0, r1, err := w.next.GetNodePool(ctx, vkeID, nodePoolID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *kubernetesService) UpdateNodePool(ctx context.Context, vkeID string, nodePoolID string, updateReq *govultr.NodePoolReqUpdate) (*govultr.NodePool, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.UpdateNodePool", arg{"vkeID", vkeID}, arg{"nodePoolID", nodePoolID}, arg{"updateReq", updateReq})
+	r0, r1, err := w.next.UpdateNodePool(ctx, vkeID, nodePoolID, updateReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *kubernetesService) DeleteNodePool(ctx context.Context, vkeID string, nodePoolID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.DeleteNodePool", arg{"vkeID", vkeID}, arg{"nodePoolID", nodePoolID})
+	r0, err := w.next.DeleteNodePool(ctx, vkeID, nodePoolID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *kubernetesService) DeleteNodePoolInstance(ctx context.Context, vkeID string, nodePoolID string, nodeID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.DeleteNodePoolInstance", arg{"vkeID", vkeID}, arg{"nodePoolID", nodePoolID}, arg{"nodeID", nodeID})
+	r0, err := w.next.DeleteNodePoolInstance(ctx, vkeID, nodePoolID, nodeID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *kubernetesService) RecycleNodePoolInstance(ctx context.Context, vkeID string, nodePoolID string, nodeID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.RecycleNodePoolInstance", arg{"vkeID", vkeID}, arg{"nodePoolID", nodePoolID}, arg{"nodeID", nodeID})
+	r0, err := w.next.RecycleNodePoolInstance(ctx, vkeID, nodePoolID, nodeID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *kubernetesService) GetKubeConfig(ctx context.Context, vkeID string) (*govultr.KubeConfig, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.GetKubeConfig", arg{"vkeID", vkeID})
+	r0, r1, err := w.next.GetKubeConfig(ctx, vkeID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *kubernetesService) GetVersions(ctx context.Context) (*govultr.Versions, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.GetVersions")
+	r0, r1, err := w.next.GetVersions(ctx)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *kubernetesService) GetUpgrades(ctx context.Context, vkeID string) ([]string, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.GetUpgrades", arg{"vkeID", vkeID})
+	r0, r1, err := w.next.GetUpgrades(ctx, vkeID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *kubernetesService) Upgrade(ctx context.Context, vkeID string, body *govultr.ClusterUpgradeReq) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Kubernetes.Upgrade", arg{"vkeID", vkeID}, arg{"body", body})
+	r0, err := w.next.Upgrade(ctx, vkeID, body)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+var _ govultr.LoadBalancerService = (*loadBalancerService)(nil)
+
+type loadBalancerService struct {
+	next govultr.LoadBalancerService
+	*instrumentation
+}
+
+func (w *loadBalancerService) Create(ctx context.Context, createReq *govultr.LoadBalancerReq) (*govultr.LoadBalancer, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "LoadBalancer.Create", arg{"createReq", createReq})
+	r0, r1, err := w.next.Create(ctx, createReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *loadBalancerService) Get(ctx context.Context, lbID string) (*govultr.LoadBalancer, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "LoadBalancer.Get", arg{"lbID", lbID})
+	r0, r1, err := w.next.Get(ctx, lbID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *loadBalancerService) Update(ctx context.Context, lbID string, updateReq *govultr.LoadBalancerReq) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "LoadBalancer.Update", arg{"lbID", lbID}, arg{"updateReq", updateReq})
+	r0, err := w.next.Update(ctx, lbID, updateReq)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *loadBalancerService) Delete(ctx context.Context, lbID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "LoadBalancer.Delete", arg{"lbID", lbID})
+	r0, err := w.next.Delete(ctx, lbID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *loadBalancerService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.LoadBalancer, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "LoadBalancer.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *loadBalancerService) CreateForwardingRule(ctx context.Context, lbID string, rule *govultr.ForwardingRule) (*govultr.ForwardingRule, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "LoadBalancer.CreateForwardingRule", arg{"lbID", lbID}, arg{"rule", rule})
+	r0, r1, err := w.next.CreateForwardingRule(ctx, lbID, rule)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *loadBalancerService) GetForwardingRule(ctx context.Context, lbID string, ruleID string) (*govultr.ForwardingRule, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "LoadBalancer.GetForwardingRule", arg{"lbID", lbID}, arg{"ruleID", ruleID})
+	r0, r1, err := w.next.GetForwardingRule(ctx, lbID, ruleID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *loadBalancerService) DeleteForwardingRule(ctx context.Context, lbID string, RuleID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "LoadBalancer.DeleteForwardingRule", arg{"lbID", lbID}, arg{"RuleID", RuleID})
+	r0, err := w.next.DeleteForwardingRule(ctx, lbID, RuleID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *loadBalancerService) ListForwardingRules(ctx context.Context, lbID string, options *govultr.ListOptions) ([]govultr.ForwardingRule, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "LoadBalancer.ListForwardingRules", arg{"lbID", lbID}, arg{"options", options})
+	r0, r1, r2, err := w.next.ListForwardingRules(ctx, lbID, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *loadBalancerService) ListFirewallRules(ctx context.Context, lbID string, options *govultr.ListOptions) ([]govultr.LBFirewallRule, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "LoadBalancer.ListFirewallRules", arg{"lbID", lbID}, arg{"options", options})
+	r0, r1, r2, err := w.next.ListFirewallRules(ctx, lbID, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *loadBalancerService) GetFirewallRule(ctx context.Context, lbID string, ruleID string) (*govultr.LBFirewallRule, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "LoadBalancer.GetFirewallRule", arg{"lbID", lbID}, arg{"ruleID", ruleID})
+	r0, r1, err := w.next.GetFirewallRule(ctx, lbID, ruleID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+var _ govultr.NetworkService = (*networkService)(nil)
+
+type networkService struct {
+	next govultr.NetworkService
+	*instrumentation
+}
+
+func (w *networkService) Create(ctx context.Context, createReq *govultr.NetworkReq) (*govultr.Network, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Network.Create", arg{"createReq", createReq})
+	r0, r1, err := w.next.Create(ctx, createReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *networkService) Get(ctx context.Context, networkID string) (*govultr.Network, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Network.Get", arg{"networkID", networkID})
+	r0, r1, err := w.next.Get(ctx, networkID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *networkService) Update(ctx context.Context, networkID string, description string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Network.Update", arg{"networkID", networkID}, arg{"description", description})
+	r0, err := w.next.Update(ctx, networkID, description)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *networkService) Delete(ctx context.Context, networkID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Network.Delete", arg{"networkID", networkID})
+	r0, err := w.next.Delete(ctx, networkID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *networkService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Network, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Network.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.ObjectStorageService = (*objectStorageService)(nil)
+
+type objectStorageService struct {
+	next govultr.ObjectStorageService
+	*instrumentation
+}
+
+func (w *objectStorageService) Create(ctx context.Context, clusterID int, label string) (*govultr.ObjectStorage, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "ObjectStorage.Create", arg{"clusterID", clusterID}, arg{"label", label})
+	r0, r1, err := w.next.Create(ctx, clusterID, label)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *objectStorageService) Get(ctx context.Context, id string) (*govultr.ObjectStorage, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "ObjectStorage.Get", arg{"id", id})
+	r0, r1, err := w.next.Get(ctx, id)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *objectStorageService) Update(ctx context.Context, id string, label string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "ObjectStorage.Update", arg{"id", id}, arg{"label", label})
+	r0, err := w.next.Update(ctx, id, label)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *objectStorageService) Delete(ctx context.Context, id string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "ObjectStorage.Delete", arg{"id", id})
+	r0, err := w.next.Delete(ctx, id)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *objectStorageService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.ObjectStorage, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "ObjectStorage.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *objectStorageService) ListCluster(ctx context.Context, options *govultr.ListOptions) ([]govultr.ObjectStorageCluster, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "ObjectStorage.ListCluster", arg{"options", options})
+	r0, r1, r2, err := w.next.ListCluster(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *objectStorageService) RegenerateKeys(ctx context.Context, id string) (*govultr.S3Keys, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "ObjectStorage.RegenerateKeys", arg{"id", id})
+	r0, r1, err := w.next.RegenerateKeys(ctx, id)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+var _ govultr.OSService = (*osService)(nil)
+
+type osService struct {
+	next govultr.OSService
+	*instrumentation
+}
+
+func (w *osService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.OS, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "OS.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.PlanService = (*planService)(nil)
+
+type planService struct {
+	next govultr.PlanService
+	*instrumentation
+}
+
+func (w *planService) List(ctx context.Context, planType string, options *govultr.ListOptions) ([]govultr.Plan, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Plan.List", arg{"planType", planType}, arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, planType, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *planService) ListBareMetal(ctx context.Context, options *govultr.ListOptions) ([]govultr.BareMetalPlan, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Plan.ListBareMetal", arg{"options", options})
+	r0, r1, r2, err := w.next.ListBareMetal(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.RegionService = (*regionService)(nil)
+
+type regionService struct {
+	next govultr.RegionService
+	*instrumentation
+}
+
+func (w *regionService) Availability(ctx context.Context, regionID string, planType string) (*govultr.PlanAvailability, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Region.Availability", arg{"regionID", regionID}, arg{"planType", planType})
+	r0, r1, err := w.next.Availability(ctx, regionID, planType)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *regionService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Region, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Region.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.ReservedIPService = (*reservedIPService)(nil)
+
+type reservedIPService struct {
+	next govultr.ReservedIPService
+	*instrumentation
+}
+
+func (w *reservedIPService) Create(ctx context.Context, ripCreate *govultr.ReservedIPReq) (*govultr.ReservedIP, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "ReservedIP.Create", arg{"ripCreate", ripCreate})
+	r0, r1, err := w.next.Create(ctx, ripCreate)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *reservedIPService) Update(ctx context.Context, id string, ripUpdate *govultr.ReservedIPUpdateReq) (*govultr.ReservedIP, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "ReservedIP.Update", arg{"id", id}, arg{"ripUpdate", ripUpdate})
+	r0, r1, err := w.next.Update(ctx, id, ripUpdate)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *reservedIPService) Get(ctx context.Context, id string) (*govultr.ReservedIP, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "ReservedIP.Get", arg{"id", id})
+	r0, r1, err := w.next.Get(ctx, id)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *reservedIPService) Delete(ctx context.Context, id string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "ReservedIP.Delete", arg{"id", id})
+	r0, err := w.next.Delete(ctx, id)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *reservedIPService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.ReservedIP, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "ReservedIP.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+func (w *reservedIPService) Convert(ctx context.Context, ripConvert *govultr.ReservedIPConvertReq) (*govultr.ReservedIP, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "ReservedIP.Convert", arg{"ripConvert", ripConvert})
+	r0, r1, err := w.next.Convert(ctx, ripConvert)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *reservedIPService) Attach(ctx context.Context, id string, instance string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "ReservedIP.Attach", arg{"id", id}, arg{"instance", instance})
+	r0, err := w.next.Attach(ctx, id, instance)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *reservedIPService) Detach(ctx context.Context, id string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "ReservedIP.Detach", arg{"id", id})
+	r0, err := w.next.Detach(ctx, id)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+var _ govultr.SnapshotService = (*snapshotService)(nil)
+
+type snapshotService struct {
+	next govultr.SnapshotService
+	*instrumentation
+}
+
+func (w *snapshotService) Create(ctx context.Context, snapshotReq *govultr.SnapshotReq) (*govultr.Snapshot, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Snapshot.Create", arg{"snapshotReq", snapshotReq})
+	r0, r1, err := w.next.Create(ctx, snapshotReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *snapshotService) CreateFromURL(ctx context.Context, snapshotURLReq *govultr.SnapshotURLReq) (*govultr.Snapshot, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Snapshot.CreateFromURL", arg{"snapshotURLReq", snapshotURLReq})
+	r0, r1, err := w.next.CreateFromURL(ctx, snapshotURLReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *snapshotService) Get(ctx context.Context, snapshotID string) (*govultr.Snapshot, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Snapshot.Get", arg{"snapshotID", snapshotID})
+	r0, r1, err := w.next.Get(ctx, snapshotID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *snapshotService) Delete(ctx context.Context, snapshotID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "Snapshot.Delete", arg{"snapshotID", snapshotID})
+	r0, err := w.next.Delete(ctx, snapshotID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *snapshotService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.Snapshot, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "Snapshot.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.SSHKeyService = (*sshKeyService)(nil)
+
+type sshKeyService struct {
+	next govultr.SSHKeyService
+	*instrumentation
+}
+
+func (w *sshKeyService) Create(ctx context.Context, sshKeyReq *govultr.SSHKeyReq) (*govultr.SSHKey, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "SSHKey.Create", arg{"sshKeyReq", sshKeyReq})
+	r0, r1, err := w.next.Create(ctx, sshKeyReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *sshKeyService) Get(ctx context.Context, sshKeyID string) (*govultr.SSHKey, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "SSHKey.Get", arg{"sshKeyID", sshKeyID})
+	r0, r1, err := w.next.Get(ctx, sshKeyID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *sshKeyService) Update(ctx context.Context, sshKeyID string, sshKeyReq *govultr.SSHKeyReq) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "SSHKey.Update", arg{"sshKeyID", sshKeyID}, arg{"sshKeyReq", sshKeyReq})
+	r0, err := w.next.Update(ctx, sshKeyID, sshKeyReq)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *sshKeyService) Delete(ctx context.Context, sshKeyID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "SSHKey.Delete", arg{"sshKeyID", sshKeyID})
+	r0, err := w.next.Delete(ctx, sshKeyID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *sshKeyService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.SSHKey, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "SSHKey.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.StartupScriptService = (*startupScriptService)(nil)
+
+type startupScriptService struct {
+	next govultr.StartupScriptService
+	*instrumentation
+}
+
+func (w *startupScriptService) Create(ctx context.Context, req *govultr.StartupScriptReq) (*govultr.StartupScript, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "StartupScript.Create", arg{"req", req})
+	r0, r1, err := w.next.Create(ctx, req)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *startupScriptService) Get(ctx context.Context, scriptID string) (*govultr.StartupScript, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "StartupScript.Get", arg{"scriptID", scriptID})
+	r0, r1, err := w.next.Get(ctx, scriptID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *startupScriptService) Update(ctx context.Context, scriptID string, scriptReq *govultr.StartupScriptReq) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "StartupScript.Update", arg{"scriptID", scriptID}, arg{"scriptReq", scriptReq})
+	r0, err := w.next.Update(ctx, scriptID, scriptReq)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *startupScriptService) Delete(ctx context.Context, scriptID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "StartupScript.Delete", arg{"scriptID", scriptID})
+	r0, err := w.next.Delete(ctx, scriptID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *startupScriptService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.StartupScript, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "StartupScript.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.UserService = (*userService)(nil)
+
+type userService struct {
+	next govultr.UserService
+	*instrumentation
+}
+
+func (w *userService) Create(ctx context.Context, userCreate *govultr.UserReq) (*govultr.User, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "User.Create", arg{"userCreate", userCreate})
+	r0, r1, err := w.next.Create(ctx, userCreate)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *userService) Get(ctx context.Context, userID string) (*govultr.User, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "User.Get", arg{"userID", userID})
+	r0, r1, err := w.next.Get(ctx, userID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *userService) Update(ctx context.Context, userID string, userReq *govultr.UserReq) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "User.Update", arg{"userID", userID}, arg{"userReq", userReq})
+	r0, err := w.next.Update(ctx, userID, userReq)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *userService) Delete(ctx context.Context, userID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "User.Delete", arg{"userID", userID})
+	r0, err := w.next.Delete(ctx, userID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *userService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.User, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "User.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
+
+var _ govultr.VPCService = (*vpcService)(nil)
+
+type vpcService struct {
+	next govultr.VPCService
+	*instrumentation
+}
+
+func (w *vpcService) Create(ctx context.Context, createReq *govultr.VPCReq) (*govultr.VPC, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "VPC.Create", arg{"createReq", createReq})
+	r0, r1, err := w.next.Create(ctx, createReq)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *vpcService) Get(ctx context.Context, vpcID string) (*govultr.VPC, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "VPC.Get", arg{"vpcID", vpcID})
+	r0, r1, err := w.next.Get(ctx, vpcID)
+	w.end(span, err, r0, r1)
+	return r0, r1, err
+}
+
+func (w *vpcService) Update(ctx context.Context, vpcID string, description string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "VPC.Update", arg{"vpcID", vpcID}, arg{"description", description})
+	r0, err := w.next.Update(ctx, vpcID, description)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *vpcService) Delete(ctx context.Context, vpcID string) (*govultr.Response, error) {
+	ctx, span := w.start(ctx, "VPC.Delete", arg{"vpcID", vpcID})
+	r0, err := w.next.Delete(ctx, vpcID)
+	w.end(span, err, r0)
+	return r0, err
+}
+
+func (w *vpcService) List(ctx context.Context, options *govultr.ListOptions) ([]govultr.VPC, *govultr.Meta, *govultr.Response, error) {
+	ctx, span := w.start(ctx, "VPC.List", arg{"options", options})
+	r0, r1, r2, err := w.next.List(ctx, options)
+	w.end(span, err, r0, r1, r2)
+	return r0, r1, r2, err
+}
@@ -0,0 +1,124 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"iter"
+)
+
+// ListFunc is the signature shared by the paginated List methods. Methods listing the children
+// of a resource, such as DomainRecordService.List, are adapted with ScopedList.
+type ListFunc[T any] func(ctx context.Context, options *ListOptions) ([]T, *Meta, *Response, error)
+
+// ScopedList adapts a List method taking the ID of a parent resource, such as
+// DomainRecordService.List or InstanceService.ListVPCInfo, to the ListFunc listing the children
+// of parentID
+func ScopedList[T, ID any](list func(ctx context.Context, parentID ID, options *ListOptions) ([]T, *Meta, *Response, error), parentID ID) ListFunc[T] { //nolint:lll
+	return func(ctx context.Context, options *ListOptions) ([]T, *Meta, *Response, error) {
+		return list(ctx, parentID, options)
+	}
+}
+
+// errCursorLoop is returned when the API hands back the cursor that was just requested
+var errCursorLoop = errors.New("pagination cursor did not advance")
+
+// Pager walks through the pages of a List call by feeding Meta.Links.Next back into
+// ListOptions.Cursor until the API returns an empty cursor.
+type Pager[T any] struct {
+	list    ListFunc[T]
+	options ListOptions
+	meta    *Meta
+	done    bool
+}
+
+// NewPager returns a Pager for the given List method. The options are copied, so the caller's
+// ListOptions are never modified. A nil options starts from the first page with the default PerPage.
+func NewPager[T any](list ListFunc[T], options *ListOptions) *Pager[T] {
+	p := &Pager[T]{list: list}
+	if options != nil {
+		p.options = *options
+	}
+	return p
+}
+
+// More reports whether there are pages left to fetch
+func (p *Pager[T]) More() bool {
+	return !p.done
+}
+
+// Meta returns the pagination information of the last page fetched
+func (p *Pager[T]) Meta() *Meta {
+	return p.meta
+}
+
+// Next fetches the next page. It returns nil once every page has been fetched.
+func (p *Pager[T]) Next(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	items, meta, _, err := p.list(ctx, &p.options)
+	if err != nil {
+		return nil, err
+	}
+
+	p.meta = meta
+	if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+		p.done = true
+		return items, nil
+	}
+
+	if meta.Links.Next == p.options.Cursor {
+		p.done = true
+		return items, errCursorLoop
+	}
+
+	p.options.Cursor = meta.Links.Next
+	return items, nil
+}
+
+// All returns an iterator over every item of the remaining pages. Iteration stops after the
+// first error, which is yielded along with the zero value of T.
+func (p *Pager[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.More() {
+			items, err := p.Next(ctx)
+			for i := range items {
+				if !yield(items[i], nil) {
+					return
+				}
+			}
+
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over every item returned by a List method, across all pages
+func All[T any](ctx context.Context, list ListFunc[T], options *ListOptions) iter.Seq2[T, error] {
+	return NewPager(list, options).All(ctx)
+}
+
+// ListAll fetches every page of a List method and returns the combined items
+func ListAll[T any](ctx context.Context, list ListFunc[T], options *ListOptions) ([]T, error) {
+	var all []T
+
+	pager := NewPager(list, options)
+	for pager.More() {
+		items, err := pager.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+	}
+
+	return all, nil
+}
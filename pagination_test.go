@@ -0,0 +1,161 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// paginatedInstances serves three pages of instances, one per cursor
+func paginatedInstances(t *testing.T) {
+	pages := map[string]string{
+		"":       `{"instances":[{"id":"1"},{"id":"2"}],"meta":{"total":5,"links":{"next":"page-2","prev":""}}}`,
+		"page-2": `{"instances":[{"id":"3"},{"id":"4"}],"meta":{"total":5,"links":{"next":"page-3","prev":"page-1"}}}`,
+		"page-3": `{"instances":[{"id":"5"}],"meta":{"total":5,"links":{"next":"","prev":"page-2"}}}`,
+	}
+
+	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
+		if perPage := request.URL.Query().Get("per_page"); perPage != "2" {
+			t.Errorf("per_page = %v, expected %v", perPage, 2)
+		}
+		fmt.Fprint(writer, pages[request.URL.Query().Get("cursor")])
+	})
+}
+
+func TestListAll(t *testing.T) {
+	setup()
+	defer teardown()
+	paginatedInstances(t)
+
+	options := &ListOptions{PerPage: 2}
+	instances, err := ListAll(ctx, client.Instance.List, options)
+	if err != nil {
+		t.Fatalf("ListAll returned %+v", err)
+	}
+
+	var ids []string
+	for i := range instances {
+		ids = append(ids, instances[i].ID)
+	}
+
+	expected := []string{"1", "2", "3", "4", "5"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("ListAll returned %v, expected %v", ids, expected)
+	}
+
+	if options.Cursor != "" {
+		t.Errorf("ListAll modified the caller's options: %+v", options)
+	}
+}
+
+func TestPager_Next(t *testing.T) {
+	setup()
+	defer teardown()
+	paginatedInstances(t)
+
+	pager := NewPager(client.Instance.List, &ListOptions{PerPage: 2})
+
+	var pages int
+	for pager.More() {
+		if _, err := pager.Next(ctx); err != nil {
+			t.Fatalf("Pager.Next returned %+v", err)
+		}
+		pages++
+	}
+
+	if pages != 3 {
+		t.Errorf("Pager fetched %d pages, expected 3", pages)
+	}
+
+	if pager.Meta().Total != 5 {
+		t.Errorf("Pager.Meta().Total = %d, expected 5", pager.Meta().Total)
+	}
+
+	if items, err := pager.Next(ctx); items != nil || err != nil {
+		t.Errorf("Pager.Next after the last page returned %v, %v", items, err)
+	}
+}
+
+func TestAll_Break(t *testing.T) {
+	setup()
+	defer teardown()
+	paginatedInstances(t)
+
+	var ids []string
+	for instance, err := range All(ctx, client.Instance.List, &ListOptions{PerPage: 2}) {
+		if err != nil {
+			t.Fatalf("All returned %+v", err)
+		}
+		ids = append(ids, instance.ID)
+		if len(ids) == 3 {
+			break
+		}
+	}
+
+	if !reflect.DeepEqual(ids, []string{"1", "2", "3"}) {
+		t.Errorf("All returned %v, expected %v", ids, []string{"1", "2", "3"})
+	}
+}
+
+func TestAll_ContextCanceled(t *testing.T) {
+	cancelCtx, cancel := context.WithCancel(ctx)
+
+	list := func(ctx context.Context, options *ListOptions) ([]int, *Meta, *Response, error) {
+		cancel()
+		return []int{1}, &Meta{Links: &Links{Next: options.Cursor + "x"}}, nil, nil
+	}
+
+	var got []int
+	var lastErr error
+	for v, err := range All(cancelCtx, list, nil) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		got = append(got, v)
+	}
+
+	if !errors.Is(lastErr, context.Canceled) {
+		t.Errorf("All returned %v, expected %v", lastErr, context.Canceled)
+	}
+
+	if !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("All returned %v, expected %v", got, []int{1})
+	}
+}
+
+func TestListAll_CursorLoop(t *testing.T) {
+	list := func(ctx context.Context, options *ListOptions) ([]int, *Meta, *Response, error) {
+		return []int{1}, &Meta{Links: &Links{Next: "same"}}, nil, nil
+	}
+
+	if _, err := ListAll(ctx, list, &ListOptions{Cursor: "same"}); !errors.Is(err, errCursorLoop) {
+		t.Errorf("ListAll returned %v, expected %v", err, errCursorLoop)
+	}
+}
+
+func TestScopedList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/domains/vultr.com/records", func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Query().Get("cursor") == "" {
+			fmt.Fprint(writer, `{"records":[{"id":"a"}],"meta":{"total":2,"links":{"next":"next","prev":""}}}`)
+			return
+		}
+		fmt.Fprint(writer, `{"records":[{"id":"b"}],"meta":{"total":2,"links":{"next":"","prev":""}}}`)
+	})
+
+	records, err := ListAll(ctx, ScopedList(client.DomainRecord.List, "vultr.com"), nil)
+	if err != nil {
+		t.Fatalf("ListAll returned %+v", err)
+	}
+
+	expected := []DomainRecord{{ID: "a"}, {ID: "b"}}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("ListAll returned %+v, expected %+v", records, expected)
+	}
+}
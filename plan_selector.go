@@ -0,0 +1,242 @@
+package govultr
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// PlanKind is the kind of resource a plan is for
+type PlanKind string
+
+// Plan kinds
+const (
+	PlanKindInstance  PlanKind = "instance"
+	PlanKindBareMetal PlanKind = "bare-metal"
+	PlanKindDatabase  PlanKind = "database"
+)
+
+// PlanConstraints are the requirements a plan must meet to be selected. Zero values are not
+// constraining.
+type PlanConstraints struct {
+	// MinVCPUs is the minimum number of vCPUs, or of CPU threads for bare metal plans
+	MinVCPUs int
+	// MinRAM is the minimum memory in MB
+	MinRAM int
+	// MinDisk is the minimum disk size in GB
+	MinDisk int
+	// MinGPUVRAM is the minimum GPU memory in GB, only cloud GPU plans have some
+	MinGPUVRAM int
+	// GPUType is the GPU model, such as NVIDIA_A100, compared case insensitively
+	GPUType string
+	// Type is the plan type, such as vc2, vhf or vcg for instances, or the plan type of bare metal
+	// and database plans
+	Type string
+	// MaxMonthlyCost is the maximum monthly cost in USD
+	MaxMonthlyCost float32
+	// Regions are the region IDs the plan may be deployed in, any region when empty. A plan is
+	// selected as long as it is available in one of them.
+	Regions []string
+	// Engine is the engine database plans must support
+	Engine DatabaseEngine
+	// Nodes is the number of nodes of database plans
+	Nodes int
+}
+
+// PlanCandidate is a plan meeting the constraints given to a PlanSelector
+type PlanCandidate struct {
+	ID          string
+	Kind        PlanKind
+	VCPUs       int
+	RAM         int
+	Disk        int
+	GPUVRAM     int
+	GPUType     string
+	MonthlyCost float32
+	// Regions are the regions, among those requested, where the plan is available. When no region
+	// was requested they are the locations of the plan.
+	Regions []string
+
+	// The plan itself, only the one matching Kind is set
+	Plan          *Plan
+	BareMetalPlan *BareMetalPlan
+	DatabasePlan  *DatabasePlan
+}
+
+// PlanSelector finds the plans meeting resource constraints, cheapest first. Cloud and bare
+// metal plans are checked against RegionService.Availability, since the locations listed by
+// plans can be stale. Database plans are listed for the requested engine and regions.
+type PlanSelector struct {
+	client *Client
+}
+
+// NewPlanSelector returns a PlanSelector using the services of client
+func NewPlanSelector(client *Client) *PlanSelector {
+	return &PlanSelector{client: client}
+}
+
+// Plans returns the instance plans meeting c, cheapest first
+func (s *PlanSelector) Plans(ctx context.Context, c PlanConstraints) ([]PlanCandidate, error) {
+	plans, err := ListAll(ctx, ScopedList(s.client.Plan.List, c.Type), &ListOptions{PerPage: 500})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]PlanCandidate, 0, len(plans))
+	for i := range plans {
+		p := &plans[i]
+		candidates = append(candidates, PlanCandidate{
+			ID: p.ID, Kind: PlanKindInstance, VCPUs: p.VCPUCount, RAM: p.RAM, Disk: p.Disk, GPUVRAM: p.GPUVRAM,
+			GPUType: p.GPUType, MonthlyCost: p.MonthlyCost, Regions: p.Locations, Plan: p,
+		})
+	}
+
+	return s.available(ctx, c, filterPlans(candidates, c))
+}
+
+// BareMetalPlans returns the bare metal plans meeting c, cheapest first
+func (s *PlanSelector) BareMetalPlans(ctx context.Context, c PlanConstraints) ([]PlanCandidate, error) {
+	if c.GPUType != "" || c.MinGPUVRAM > 0 {
+		return nil, nil
+	}
+
+	plans, err := ListAll(ctx, s.client.Plan.ListBareMetal, &ListOptions{PerPage: 500})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]PlanCandidate, 0, len(plans))
+	for i := range plans {
+		p := &plans[i]
+		candidates = append(candidates, PlanCandidate{
+			ID: p.ID, Kind: PlanKindBareMetal, VCPUs: p.CPUThreads, RAM: p.RAM, Disk: p.Disk,
+			MonthlyCost: p.MonthlyCost, Regions: p.Locations, BareMetalPlan: p,
+		})
+	}
+
+	return s.available(ctx, c, filterPlans(candidates, c))
+}
+
+// DatabasePlans returns the managed database plans meeting c, cheapest first
+func (s *PlanSelector) DatabasePlans(ctx context.Context, c PlanConstraints) ([]PlanCandidate, error) {
+	if c.Engine != "" && !c.Engine.IsValid() {
+		return nil, fmt.Errorf("%q is not a known database engine", c.Engine)
+	}
+	if c.GPUType != "" || c.MinGPUVRAM > 0 {
+		return nil, nil
+	}
+
+	options := &DBPlanListOptions{Engine: string(c.Engine), Nodes: c.Nodes}
+	if len(c.Regions) == 1 {
+		options.Region = c.Regions[0]
+	}
+
+	plans, _, _, err := s.client.Database.ListPlans(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]PlanCandidate, 0, len(plans))
+	for i := range plans {
+		p := &plans[i]
+		if c.Nodes > 0 && p.NumberOfNodes != c.Nodes {
+			continue
+		}
+
+		candidate := PlanCandidate{
+			ID: p.ID, Kind: PlanKindDatabase, VCPUs: p.VCPUCount, RAM: p.RAM, Disk: p.Disk,
+			MonthlyCost: float32(p.MonthlyCost), Regions: p.Locations, DatabasePlan: p,
+		}
+		if len(c.Regions) > 0 {
+			candidate.Regions = intersect(c.Regions, p.Locations)
+			if len(candidate.Regions) == 0 {
+				continue
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	return rankPlans(filterPlans(candidates, c)), nil
+}
+
+// available keeps the candidates available in one of the requested regions, and ranks them
+func (s *PlanSelector) available(ctx context.Context, c PlanConstraints, candidates []PlanCandidate) ([]PlanCandidate, error) {
+	if len(c.Regions) == 0 || len(candidates) == 0 {
+		return rankPlans(candidates), nil
+	}
+
+	availableIn := make(map[string][]string)
+	for _, region := range c.Regions {
+		availability, _, err := s.client.Region.Availability(ctx, region, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range availability.AvailablePlans {
+			availableIn[id] = append(availableIn[id], region)
+		}
+	}
+
+	available := candidates[:0]
+	for _, candidate := range candidates {
+		if regions := availableIn[candidate.ID]; len(regions) > 0 {
+			candidate.Regions = regions
+			available = append(available, candidate)
+		}
+	}
+	return rankPlans(available), nil
+}
+
+// filterPlans keeps the candidates meeting the resource constraints of c
+func filterPlans(candidates []PlanCandidate, c PlanConstraints) []PlanCandidate {
+	return slices.DeleteFunc(candidates, func(p PlanCandidate) bool {
+		switch {
+		case p.VCPUs < c.MinVCPUs, p.RAM < c.MinRAM, p.Disk < c.MinDisk, p.GPUVRAM < c.MinGPUVRAM:
+			return true
+		case c.GPUType != "" && !strings.EqualFold(p.GPUType, c.GPUType):
+			return true
+		case c.MaxMonthlyCost > 0 && p.MonthlyCost > c.MaxMonthlyCost:
+			return true
+		case c.Type != "" && !strings.EqualFold(planType(p), c.Type):
+			return true
+		}
+		return false
+	})
+}
+
+func planType(p PlanCandidate) string {
+	switch {
+	case p.BareMetalPlan != nil:
+		return p.BareMetalPlan.Type
+	case p.DatabasePlan != nil:
+		return p.DatabasePlan.Type
+	}
+	return p.Plan.Type
+}
+
+// rankPlans sorts candidates by monthly cost, then by the smallest resources, so the first one
+// is the cheapest plan meeting the constraints
+func rankPlans(candidates []PlanCandidate) []PlanCandidate {
+	slices.SortStableFunc(candidates, func(a, b PlanCandidate) int {
+		return cmp.Or(
+			cmp.Compare(a.MonthlyCost, b.MonthlyCost),
+			cmp.Compare(a.VCPUs, b.VCPUs),
+			cmp.Compare(a.RAM, b.RAM),
+			cmp.Compare(a.Disk, b.Disk),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+	return candidates
+}
+
+// intersect returns the items of a that are also in b
+func intersect(a, b []string) []string {
+	var both []string
+	for _, item := range a {
+		if slices.Contains(b, item) {
+			both = append(both, item)
+		}
+	}
+	return both
+}
@@ -0,0 +1,126 @@
+package govultr
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func setupPlanSelector(t *testing.T) {
+	mux.HandleFunc("/v2/plans", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"plans":[
+			{"id":"vc2-1c-1gb","vcpu_count":1,"ram":1024,"disk":25,"monthly_cost":5,"type":"vc2","locations":["ewr","ord"]},
+			{"id":"vc2-2c-4gb","vcpu_count":2,"ram":4096,"disk":80,"monthly_cost":20,"type":"vc2","locations":["ewr","ord"]},
+			{"id":"vhf-2c-4gb","vcpu_count":2,"ram":4096,"disk":128,"monthly_cost":24,"type":"vhf","locations":["ewr"]},
+			{"id":"vc2-4c-8gb","vcpu_count":4,"ram":8192,"disk":160,"monthly_cost":40,"type":"vc2","locations":["ewr"]},
+			{"id":"vcg-a100-1c-6g-4vram","vcpu_count":1,"ram":6144,"disk":70,"monthly_cost":90,"type":"vcg",
+				"gpu_vram_gb":4,"gpu_type":"NVIDIA_A100","locations":["ewr"]}
+		],"meta":{"total":5}}`)
+	})
+	mux.HandleFunc("/v2/plans-metal", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"plans_metal":[
+			{"id":"vbm-8c-132gb","cpu_count":8,"cpu_threads":16,"ram":131072,"disk":240,"monthly_cost":350,"type":"SSD","locations":["ewr"]},
+			{"id":"vbm-4c-32gb","cpu_count":4,"cpu_threads":8,"ram":32768,"disk":240,"monthly_cost":120,"type":"SSD","locations":["ewr"]}
+		],"meta":{"total":2}}`)
+	})
+	mux.HandleFunc("/v2/regions/ewr/availability", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"available_plans":["vc2-1c-1gb","vc2-4c-8gb","vbm-8c-132gb"]}`)
+	})
+	mux.HandleFunc("/v2/regions/ord/availability", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"available_plans":["vc2-1c-1gb","vc2-2c-4gb"]}`)
+	})
+	mux.HandleFunc("/v2/databases/plans", func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Query().Get("engine") != "pg" {
+			t.Errorf("database plans requested for engine %q, expected pg", request.URL.Query().Get("engine"))
+		}
+		fmt.Fprint(writer, `{"plans":[
+			{"id":"vultr-dbaas-startup-cc-2-80-4","number_of_nodes":1,"type":"dedicated","vcpu_count":2,"ram":4096,"disk":80,"monthly_cost":60,"locations":["ewr","ord"]},
+			{"id":"vultr-dbaas-hobbyist-cc-1-25-1","number_of_nodes":1,"type":"shared","vcpu_count":1,"ram":1024,"disk":25,"monthly_cost":15,"locations":["ewr"]},
+			{"id":"vultr-dbaas-business-cc-2-80-4","number_of_nodes":2,"type":"dedicated","vcpu_count":2,"ram":4096,"disk":80,"monthly_cost":120,"locations":["ord"]}
+		]}`)
+	})
+}
+
+func candidateIDs(candidates []PlanCandidate) []string {
+	ids := make([]string, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.ID
+	}
+	return ids
+}
+
+func TestPlanSelector_Plans(t *testing.T) {
+	setup()
+	defer teardown()
+	setupPlanSelector(t)
+
+	selector := NewPlanSelector(client)
+
+	tests := []struct {
+		name        string
+		constraints PlanConstraints
+		expected    []string
+	}{
+		{"any", PlanConstraints{MinVCPUs: 2}, []string{"vc2-2c-4gb", "vhf-2c-4gb", "vc2-4c-8gb"}},
+		{"type and cost", PlanConstraints{MinRAM: 4096, Type: "vc2", MaxMonthlyCost: 30}, []string{"vc2-2c-4gb"}},
+		{"gpu", PlanConstraints{GPUType: "nvidia_a100", MinGPUVRAM: 4}, []string{"vcg-a100-1c-6g-4vram"}},
+		{"sold out in the region", PlanConstraints{MinVCPUs: 2, Regions: []string{"ewr"}}, []string{"vc2-4c-8gb"}},
+		{"regions", PlanConstraints{Regions: []string{"ewr", "ord"}}, []string{"vc2-1c-1gb", "vc2-2c-4gb", "vc2-4c-8gb"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			candidates, err := selector.Plans(ctx, test.constraints)
+			if err != nil {
+				t.Fatalf("PlanSelector.Plans returned %+v", err)
+			}
+			if ids := candidateIDs(candidates); !reflect.DeepEqual(ids, test.expected) {
+				t.Errorf("PlanSelector.Plans returned %v, expected %v", ids, test.expected)
+			}
+		})
+	}
+
+	candidates, _ := selector.Plans(ctx, PlanConstraints{Regions: []string{"ewr", "ord"}})
+	if regions := candidates[0].Regions; !reflect.DeepEqual(regions, []string{"ewr", "ord"}) || candidates[0].Plan == nil {
+		t.Errorf("PlanSelector.Plans returned %+v, expected a plan available in ewr and ord", candidates[0])
+	}
+}
+
+func TestPlanSelector_BareMetalPlans(t *testing.T) {
+	setup()
+	defer teardown()
+	setupPlanSelector(t)
+
+	candidates, err := NewPlanSelector(client).BareMetalPlans(ctx, PlanConstraints{MinVCPUs: 8, Regions: []string{"ewr"}})
+	if err != nil {
+		t.Fatalf("PlanSelector.BareMetalPlans returned %+v", err)
+	}
+
+	if ids := candidateIDs(candidates); !reflect.DeepEqual(ids, []string{"vbm-8c-132gb"}) {
+		t.Errorf("PlanSelector.BareMetalPlans returned %v, expected [vbm-8c-132gb]", ids)
+	}
+	if candidates[0].Kind != PlanKindBareMetal || candidates[0].BareMetalPlan == nil || candidates[0].VCPUs != 16 {
+		t.Errorf("PlanSelector.BareMetalPlans returned %+v", candidates[0])
+	}
+}
+
+func TestPlanSelector_DatabasePlans(t *testing.T) {
+	setup()
+	defer teardown()
+	setupPlanSelector(t)
+
+	selector := NewPlanSelector(client)
+	candidates, err := selector.DatabasePlans(ctx, PlanConstraints{Engine: DatabaseEnginePG, Nodes: 1, Regions: []string{"ord"}})
+	if err != nil {
+		t.Fatalf("PlanSelector.DatabasePlans returned %+v", err)
+	}
+
+	if ids := candidateIDs(candidates); !reflect.DeepEqual(ids, []string{"vultr-dbaas-startup-cc-2-80-4"}) {
+		t.Errorf("PlanSelector.DatabasePlans returned %v, expected [vultr-dbaas-startup-cc-2-80-4]", ids)
+	}
+
+	if _, err := selector.DatabasePlans(ctx, PlanConstraints{Engine: "mongodb"}); err == nil {
+		t.Errorf("PlanSelector.DatabasePlans accepted an unknown engine")
+	}
+}
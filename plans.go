@@ -10,8 +10,8 @@ import (
 // PlanService is the interface to interact with the Plans endpoints on the Vultr API
 // Link : https://www.vultr.com/api/#tag/plans
 type PlanService interface {
-	List(ctx context.Context, planType string, options *ListOptions) ([]Plan, *Meta, *http.Response, error)
-	ListBareMetal(ctx context.Context, options *ListOptions) ([]BareMetalPlan, *Meta, *http.Response, error)
+	List(ctx context.Context, planType string, options *ListOptions) ([]Plan, *Meta, *Response, error)
+	ListBareMetal(ctx context.Context, options *ListOptions) ([]BareMetalPlan, *Meta, *Response, error)
 }
 
 // PlanServiceHandler handles interaction with the Plans methods for the Vultr API
@@ -61,7 +61,7 @@ type bareMetalPlansBase struct {
 
 // List retrieves a list of all active plans.
 // planType is optional - pass an empty string to get all plans
-func (p *PlanServiceHandler) List(ctx context.Context, planType string, options *ListOptions) ([]Plan, *Meta, *http.Response, error) {
+func (p *PlanServiceHandler) List(ctx context.Context, planType string, options *ListOptions) ([]Plan, *Meta, *Response, error) {
 	uri := "/v2/plans"
 
 	req, err := p.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -90,7 +90,7 @@ func (p *PlanServiceHandler) List(ctx context.Context, planType string, options
 }
 
 // ListBareMetal all active bare metal plans.
-func (p *PlanServiceHandler) ListBareMetal(ctx context.Context, options *ListOptions) ([]BareMetalPlan, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (p *PlanServiceHandler) ListBareMetal(ctx context.Context, options *ListOptions) ([]BareMetalPlan, *Meta, *Response, error) { //nolint:dupl,lll
 	uri := "/v2/plans-metal"
 
 	req, err := p.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -108,7 +108,7 @@ func (p *PlanServiceHandler) ListBareMetal(ctx context.Context, options *ListOpt
 	bmPlans := new(bareMetalPlansBase)
 	resp, err := p.client.DoWithContext(ctx, req, bmPlans)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, resp, err
 	}
 
 	return bmPlans.Plans, bmPlans.Meta, resp, nil
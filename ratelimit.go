@@ -0,0 +1,223 @@
+package govultr
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// Vultr allows 30 requests per second per source IP
+	defaultRequestRate  = 30
+	defaultRequestBurst = 10
+
+	// rate limit reset values above this are unix timestamps rather than a number of seconds
+	rateLimitEpochThreshold = 1e9
+)
+
+// RateLimiter is a token bucket throttling the requests sent by a Client. A single RateLimiter
+// can be shared between clients, in which case they draw from the same bucket.
+type RateLimiter struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	stats       RateLimitStats
+}
+
+// RateLimitStats reports how much the requests of a RateLimiter have been throttled
+type RateLimitStats struct {
+	// Requests is the number of requests that went through the limiter
+	Requests int64
+	// Throttled is the number of requests that had to wait for a token
+	Throttled int64
+	// TotalWait is the time spent waiting by all throttled requests
+	TotalWait time.Duration
+	// Pauses is the number of times the bucket was paused by a 429 or 503 response
+	Pauses int64
+}
+
+// RateLimitEvent describes a request that was held back by the rate limiter
+type RateLimitEvent struct {
+	Method string
+	Path   string
+	Wait   time.Duration
+}
+
+// RateLimitObserver is called every time a request waits for the rate limiter
+type RateLimitObserver func(RateLimitEvent)
+
+// NewRateLimiter returns a token bucket allowing rps requests per second with bursts of up to
+// burst requests. A rate of zero or less only enforces the pauses requested by the API.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until the request may be sent, or the context is done. It returns the time waited.
+func (l *RateLimiter) Wait(ctx context.Context) (time.Duration, error) {
+	wait := l.reserve(time.Now())
+	if wait <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return 0, ctx.Err()
+	case <-timer.C:
+		l.record(wait)
+		return wait, nil
+	}
+}
+
+// PauseUntil holds back every request until t, for example when the API asked to retry later
+func (l *RateLimiter) PauseUntil(t time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if t.After(l.pausedUntil) {
+		l.pausedUntil = t
+		l.stats.Pauses++
+	}
+}
+
+// Stats returns a snapshot of the limiter counters
+func (l *RateLimiter) Stats() RateLimitStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stats
+}
+
+// reserve takes a token and returns how long to wait before it can be used
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.stats.Requests++
+
+	var wait time.Duration
+	if l.rate > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		l.last = now
+		l.tokens--
+
+		if l.tokens < 0 {
+			wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+		}
+	}
+
+	if pause := l.pausedUntil.Sub(now); pause > wait {
+		wait = pause
+	}
+
+	return wait
+}
+
+// cancel gives back the token of a request that stopped waiting
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+1)
+	}
+}
+
+func (l *RateLimiter) record(wait time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.stats.Throttled++
+	l.stats.TotalWait += wait
+}
+
+// rateLimitTransport makes every attempt, retries included, go through the limiter
+type rateLimitTransport struct {
+	base     http.RoundTripper
+	limiter  *RateLimiter
+	observer RateLimitObserver
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	wait, err := t.limiter.Wait(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	if wait > 0 && t.observer != nil {
+		t.observer(RateLimitEvent{Method: req.Method, Path: req.URL.Path, Wait: wait})
+	}
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+
+	if until, ok := rateLimitPause(res, time.Now()); ok {
+		t.limiter.PauseUntil(until)
+	}
+
+	return res, nil
+}
+
+// rateLimitPause reads Retry-After on 429 and 503 responses, and the rate limit reset headers
+// once the remaining budget is exhausted.
+func rateLimitPause(res *http.Response, now time.Time) (time.Time, bool) {
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusServiceUnavailable {
+		if v := res.Header.Get("Retry-After"); v != "" {
+			if seconds, err := strconv.Atoi(v); err == nil {
+				return now.Add(time.Duration(seconds) * time.Second), true
+			}
+			if date, err := http.ParseTime(v); err == nil {
+				return date, true
+			}
+		}
+
+		if until, ok := rateLimitReset(res.Header, now); ok {
+			return until, true
+		}
+
+		return time.Time{}, false
+	}
+
+	for _, prefix := range []string{"X-RateLimit-", "RateLimit-"} {
+		if res.Header.Get(prefix+"Remaining") == "0" {
+			return rateLimitReset(res.Header, now)
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func rateLimitReset(header http.Header, now time.Time) (time.Time, bool) {
+	for _, name := range []string{"X-RateLimit-Reset", "RateLimit-Reset"} {
+		v, err := strconv.ParseFloat(header.Get(name), 64)
+		if err != nil || v <= 0 {
+			continue
+		}
+
+		if v > rateLimitEpochThreshold {
+			return time.Unix(int64(v), 0), true
+		}
+		return now.Add(time.Duration(v * float64(time.Second))), true
+	}
+
+	return time.Time{}, false
+}
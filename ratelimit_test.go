@@ -0,0 +1,138 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Reserve(t *testing.T) {
+	l := NewRateLimiter(10, 2)
+	now := l.last
+
+	for i := 0; i < 2; i++ {
+		if wait := l.reserve(now); wait != 0 {
+			t.Errorf("burst request %d waited %v, expected none", i, wait)
+		}
+	}
+
+	if wait := l.reserve(now); wait != 100*time.Millisecond {
+		t.Errorf("throttled request waited %v, expected %v", wait, 100*time.Millisecond)
+	}
+
+	if wait := l.reserve(now.Add(time.Second)); wait != 0 {
+		t.Errorf("request after refill waited %v, expected none", wait)
+	}
+
+	l.PauseUntil(now.Add(3 * time.Second))
+	if wait := l.reserve(now.Add(time.Second)); wait != 2*time.Second {
+		t.Errorf("paused request waited %v, expected %v", wait, 2*time.Second)
+	}
+
+	if stats := l.Stats(); stats.Requests != 5 || stats.Pauses != 1 {
+		t.Errorf("Stats = %+v, expected 5 requests and 1 pause", stats)
+	}
+}
+
+func TestRateLimiter_WaitCanceled(t *testing.T) {
+	l := NewRateLimiter(0, 1)
+	l.PauseUntil(time.Now().Add(time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait returned %v, expected %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRateLimitPause(t *testing.T) {
+	now := time.Date(2023, 7, 24, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		status int
+		header http.Header
+		until  time.Time
+		ok     bool
+	}{
+		{"retry after seconds", 429, http.Header{"Retry-After": {"2"}}, now.Add(2 * time.Second), true},
+		{"retry after date", 503, http.Header{"Retry-After": {"Mon, 24 Jul 2023 12:00:05 GMT"}}, now.Add(5 * time.Second), true},
+		{"reset seconds", 429, http.Header{"X-Ratelimit-Reset": {"0.5"}}, now.Add(500 * time.Millisecond), true},
+		{"reset epoch", 429, http.Header{"Ratelimit-Reset": {fmt.Sprint(now.Add(time.Minute).Unix())}}, now.Add(time.Minute), true},
+		{"remaining exhausted", 200, http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"1"}}, now.Add(time.Second), true},
+		{"remaining budget", 200, http.Header{"X-Ratelimit-Remaining": {"5"}, "X-Ratelimit-Reset": {"1"}}, time.Time{}, false},
+		{"no headers", 429, http.Header{}, time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			until, ok := rateLimitPause(&http.Response{StatusCode: tt.status, Header: tt.header}, now)
+			if ok != tt.ok || !until.Equal(tt.until) {
+				t.Errorf("rateLimitPause = %v, %v, expected %v, %v", until, ok, tt.until, tt.ok)
+			}
+		})
+	}
+}
+
+func TestClient_RateLimitRetryAfter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		calls++
+		if calls == 1 {
+			writer.Header().Set("RateLimit-Reset", "0.2")
+			writer.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(writer, `{"account":{}}`)
+	})
+
+	var mu sync.Mutex
+	var events []RateLimitEvent
+	c, err := New(
+		WithBaseURL(server.URL),
+		WithRetryWait(0, 0),
+		WithRateLimitObserver(func(e RateLimitEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, e)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("New returned %+v", err)
+	}
+
+	if _, _, err := c.Account.Get(ctx); err != nil {
+		t.Fatalf("Account.Get returned %+v", err)
+	}
+
+	if len(events) != 1 || events[0].Path != "/v2/account" || events[0].Wait <= 0 {
+		t.Fatalf("rate limit events = %+v, expected one wait on /v2/account", events)
+	}
+
+	if stats := c.RateLimiter().Stats(); stats.Pauses != 1 || stats.Throttled != 1 {
+		t.Errorf("Stats = %+v, expected 1 pause and 1 throttled request", stats)
+	}
+}
+
+func TestClient_WithoutRateLimiter(t *testing.T) {
+	c, err := New(WithRateLimiter(nil))
+	if err != nil {
+		t.Fatalf("New returned %+v", err)
+	}
+
+	if c.RateLimiter() != nil {
+		t.Error("expected rate limiting to be disabled")
+	}
+
+	if c.client.HTTPClient != c.httpClient {
+		t.Error("expected the http client to be used as is")
+	}
+}
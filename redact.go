@@ -0,0 +1,78 @@
+package govultr
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// RedactedValue replaces the value of secret fields in logged and recorded bodies
+const RedactedValue = "[REDACTED]"
+
+// secretFields are the JSON fields of the Vultr API holding credentials: Instance.DefaultPassword,
+// Database.Password, S3Keys, User.APIKey, KubeConfig.KubeConfig and SSL.PrivateKey among others.
+var secretFields = map[string]bool{
+	"default_password": true,
+	"password":         true,
+	"s3_access_key":    true,
+	"s3_secret_key":    true,
+	"api_key":          true,
+	"kube_config":      true,
+	"private_key":      true,
+}
+
+// IsSecretField reports whether the JSON field name holds a secret redacted by RedactJSON
+func IsSecretField(name string) bool {
+	return secretFields[name]
+}
+
+// RedactJSON returns a copy of body with the value of every secret field replaced by RedactedValue,
+// at any depth. Bodies that are not valid JSON are returned unchanged.
+func RedactJSON(body []byte) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return body
+	}
+
+	if !redactValue(v) {
+		return body
+	}
+
+	redacted, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+
+	return redacted
+}
+
+// redactValue replaces secrets in place and reports whether anything was replaced
+func redactValue(v interface{}) bool {
+	redacted := false
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			if secretFields[k] {
+				if s, ok := child.(string); !ok || s != "" {
+					t[k] = RedactedValue
+					redacted = true
+				}
+				continue
+			}
+			redacted = redactValue(child) || redacted
+		}
+	case []interface{}:
+		for _, child := range t {
+			redacted = redactValue(child) || redacted
+		}
+	}
+
+	return redacted
+}
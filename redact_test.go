@@ -0,0 +1,43 @@
+package govultr
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRedactJSON(t *testing.T) {
+	body := []byte(`{"instance":{"id":"abc","default_password":"hunter2","tags":["a"]},"users":[{"username":"vultradmin","password":"pw"}],"s3_keys":{"s3_access_key":"AK","s3_secret_key":"SK"},"private_key":""}`)
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(RedactJSON(body), &got); err != nil {
+		t.Fatalf("RedactJSON returned invalid JSON: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"instance":    map[string]interface{}{"id": "abc", "default_password": RedactedValue, "tags": []interface{}{"a"}},
+		"users":       []interface{}{map[string]interface{}{"username": "vultradmin", "password": RedactedValue}},
+		"s3_keys":     map[string]interface{}{"s3_access_key": RedactedValue, "s3_secret_key": RedactedValue},
+		"private_key": "",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("RedactJSON returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestRedactJSON_Unchanged(t *testing.T) {
+	for _, body := range []string{"", "not json", `{"id":"abc","size":10.50}`, `{"error":"invalid"`} {
+		if got := string(RedactJSON([]byte(body))); got != body {
+			t.Errorf("RedactJSON(%q) returned %q, expected the body unchanged", body, got)
+		}
+	}
+}
+
+func TestIsSecretField(t *testing.T) {
+	if !IsSecretField("api_key") {
+		t.Error("IsSecretField(api_key) returned false")
+	}
+	if IsSecretField("label") {
+		t.Error("IsSecretField(label) returned true")
+	}
+}
@@ -11,8 +11,8 @@ import (
 // RegionService is the interface to interact with Region endpoints on the Vultr API
 // Link : https://www.vultr.com/api/#tag/region
 type RegionService interface {
-	Availability(ctx context.Context, regionID string, planType string) (*PlanAvailability, *http.Response, error)
-	List(ctx context.Context, options *ListOptions) ([]Region, *Meta, *http.Response, error)
+	Availability(ctx context.Context, regionID string, planType string) (*PlanAvailability, *Response, error)
+	List(ctx context.Context, options *ListOptions) ([]Region, *Meta, *Response, error)
 }
 
 var _ RegionService = &RegionServiceHandler{}
@@ -42,7 +42,7 @@ type PlanAvailability struct {
 }
 
 // List returns all available regions
-func (r *RegionServiceHandler) List(ctx context.Context, options *ListOptions) ([]Region, *Meta, *http.Response, error) {
+func (r *RegionServiceHandler) List(ctx context.Context, options *ListOptions) ([]Region, *Meta, *Response, error) {
 	uri := "/v2/regions"
 
 	req, err := r.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -67,7 +67,7 @@ func (r *RegionServiceHandler) List(ctx context.Context, options *ListOptions) (
 }
 
 // Availability retrieves a list of the plan IDs currently available for a given location.
-func (r *RegionServiceHandler) Availability(ctx context.Context, regionID, planType string) (*PlanAvailability, *http.Response, error) {
+func (r *RegionServiceHandler) Availability(ctx context.Context, regionID, planType string) (*PlanAvailability, *Response, error) {
 	uri := fmt.Sprintf("/v2/regions/%s/availability", regionID)
 
 	req, err := r.client.NewRequest(ctx, http.MethodGet, uri, nil)
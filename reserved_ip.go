@@ -13,15 +13,15 @@ const ripPath = "/v2/reserved-ips"
 // ReservedIPService is the interface to interact with the reserved IP endpoints on the Vultr API
 // Link : https://www.vultr.com/api/#tag/reserved-ip
 type ReservedIPService interface {
-	Create(ctx context.Context, ripCreate *ReservedIPReq) (*ReservedIP, *http.Response, error)
-	Update(ctx context.Context, id string, ripUpdate *ReservedIPUpdateReq) (*ReservedIP, *http.Response, error)
-	Get(ctx context.Context, id string) (*ReservedIP, *http.Response, error)
-	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, options *ListOptions) ([]ReservedIP, *Meta, *http.Response, error)
+	Create(ctx context.Context, ripCreate *ReservedIPReq) (*ReservedIP, *Response, error)
+	Update(ctx context.Context, id string, ripUpdate *ReservedIPUpdateReq) (*ReservedIP, *Response, error)
+	Get(ctx context.Context, id string) (*ReservedIP, *Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+	List(ctx context.Context, options *ListOptions) ([]ReservedIP, *Meta, *Response, error)
 
-	Convert(ctx context.Context, ripConvert *ReservedIPConvertReq) (*ReservedIP, *http.Response, error)
-	Attach(ctx context.Context, id, instance string) error
-	Detach(ctx context.Context, id string) error
+	Convert(ctx context.Context, ripConvert *ReservedIPConvertReq) (*ReservedIP, *Response, error)
+	Attach(ctx context.Context, id, instance string) (*Response, error)
+	Detach(ctx context.Context, id string) (*Response, error)
 }
 
 // ReservedIPServiceHandler handles interaction with the reserved IP methods for the Vultr API
@@ -33,7 +33,7 @@ type ReservedIPServiceHandler struct {
 type ReservedIP struct {
 	ID         string `json:"id"`
 	Region     string `json:"region"`
-	IPType     string `json:"ip_type"`
+	IPType     IPType `json:"ip_type"`
 	Subnet     string `json:"subnet"`
 	SubnetSize int    `json:"subnet_size"`
 	Label      string `json:"label"`
@@ -43,15 +43,25 @@ type ReservedIP struct {
 // ReservedIPReq represents the parameters for creating a new Reserved IP on Vultr
 type ReservedIPReq struct {
 	Region     string `json:"region,omitempty"`
-	IPType     string `json:"ip_type,omitempty"`
+	IPType     IPType `json:"ip_type,omitempty"`
 	IPAddress  string `json:"ip_address,omitempty"`
 	Label      string `json:"label,omitempty"`
 	InstanceID string `json:"instance_id,omitempty"`
 }
 
+// Validate checks that the region and IP type are given
+func (r ReservedIPReq) Validate() error {
+	v := new(validation)
+	v.required("region", r.Region)
+	if !r.IPType.IsValid() {
+		v.add("ip_type", "%q is not a known IP type", r.IPType)
+	}
+	return v.err()
+}
+
 // ReservedIPUpdateReq represents the parameters for updating a Reserved IP on Vultr
 type ReservedIPUpdateReq struct {
-	Label *string `json:"label"`
+	Label Optional[string] `json:"label,omitzero"`
 }
 
 type reservedIPsBase struct {
@@ -70,14 +80,14 @@ type ReservedIPConvertReq struct {
 }
 
 // Create adds the specified reserved IP to your Vultr account
-func (r *ReservedIPServiceHandler) Create(ctx context.Context, ripCreate *ReservedIPReq) (*ReservedIP, *http.Response, error) {
+func (r *ReservedIPServiceHandler) Create(ctx context.Context, ripCreate *ReservedIPReq) (*ReservedIP, *Response, error) {
 	req, err := r.client.NewRequest(ctx, http.MethodPost, ripPath, ripCreate)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	rip := new(reservedIPBase)
-	resp, err := r.client.DoWithContext(ctx, req, rip)
+	resp, err := doCreate(ctx, r.client, req, rip, &rip.ReservedIP, r.createMatch(ripCreate))
 	if err != nil {
 		return nil, resp, err
 	}
@@ -85,8 +95,25 @@ func (r *ReservedIPServiceHandler) Create(ctx context.Context, ripCreate *Reserv
 	return rip.ReservedIP, resp, nil
 }
 
+// createMatch recognizes the reserved IPs with the label, region and IP type of ripCreate.
+// Reserved IPs carry no creation date, so an older reserved IP with the same label that no create
+// of the client returned is also a candidate: give reserved IPs unique labels.
+func (r *ReservedIPServiceHandler) createMatch(ripCreate *ReservedIPReq) *createMatch[ReservedIP] {
+	if ripCreate == nil || ripCreate.Label == "" {
+		return nil
+	}
+
+	return &createMatch[ReservedIP]{
+		list: r.List,
+		match: func(rip *ReservedIP) bool {
+			return rip.Label == ripCreate.Label && rip.Region == ripCreate.Region && rip.IPType == ripCreate.IPType
+		},
+		id: func(rip *ReservedIP) string { return rip.ID },
+	}
+}
+
 // Update updates label on the Reserved IP
-func (r *ReservedIPServiceHandler) Update(ctx context.Context, id string, ripUpdate *ReservedIPUpdateReq) (*ReservedIP, *http.Response, error) { //nolint:lll
+func (r *ReservedIPServiceHandler) Update(ctx context.Context, id string, ripUpdate *ReservedIPUpdateReq) (*ReservedIP, *Response, error) { //nolint:lll
 	uri := fmt.Sprintf("%s/%s", ripPath, id)
 	req, err := r.client.NewRequest(ctx, http.MethodPatch, uri, ripUpdate)
 	if err != nil {
@@ -103,7 +130,7 @@ func (r *ReservedIPServiceHandler) Update(ctx context.Context, id string, ripUpd
 }
 
 // Get gets the reserved IP associated with provided ID
-func (r *ReservedIPServiceHandler) Get(ctx context.Context, id string) (*ReservedIP, *http.Response, error) {
+func (r *ReservedIPServiceHandler) Get(ctx context.Context, id string) (*ReservedIP, *Response, error) {
 	uri := fmt.Sprintf("%s/%s", ripPath, id)
 	req, err := r.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -120,19 +147,18 @@ func (r *ReservedIPServiceHandler) Get(ctx context.Context, id string) (*Reserve
 }
 
 // Delete removes the specified reserved IP from your Vultr account
-func (r *ReservedIPServiceHandler) Delete(ctx context.Context, id string) error {
+func (r *ReservedIPServiceHandler) Delete(ctx context.Context, id string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s", ripPath, id)
 	req, err := r.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = r.client.DoWithContext(ctx, req, nil)
-	return err
+	return r.client.DoWithContext(ctx, req, nil)
 }
 
 // List lists all the reserved IPs associated with your Vultr account
-func (r *ReservedIPServiceHandler) List(ctx context.Context, options *ListOptions) ([]ReservedIP, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (r *ReservedIPServiceHandler) List(ctx context.Context, options *ListOptions) ([]ReservedIP, *Meta, *Response, error) { //nolint:dupl,lll
 	req, err := r.client.NewRequest(ctx, http.MethodGet, ripPath, nil)
 	if err != nil {
 		return nil, nil, nil, err
@@ -155,7 +181,7 @@ func (r *ReservedIPServiceHandler) List(ctx context.Context, options *ListOption
 }
 
 // Convert an existing IP on a subscription to a reserved IP.
-func (r *ReservedIPServiceHandler) Convert(ctx context.Context, ripConvert *ReservedIPConvertReq) (*ReservedIP, *http.Response, error) {
+func (r *ReservedIPServiceHandler) Convert(ctx context.Context, ripConvert *ReservedIPConvertReq) (*ReservedIP, *Response, error) {
 	uri := fmt.Sprintf("%s/convert", ripPath)
 	req, err := r.client.NewRequest(ctx, http.MethodPost, uri, ripConvert)
 
@@ -173,24 +199,22 @@ func (r *ReservedIPServiceHandler) Convert(ctx context.Context, ripConvert *Rese
 }
 
 // Attach a reserved IP to an existing subscription
-func (r *ReservedIPServiceHandler) Attach(ctx context.Context, id, instance string) error {
+func (r *ReservedIPServiceHandler) Attach(ctx context.Context, id, instance string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/attach", ripPath, id)
 	reqBody := RequestBody{"instance_id": instance}
 	req, err := r.client.NewRequest(ctx, http.MethodPost, uri, reqBody)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = r.client.DoWithContext(ctx, req, nil)
-	return err
+	return r.client.DoWithContext(ctx, req, nil)
 }
 
 // Detach a reserved IP from an existing subscription.
-func (r *ReservedIPServiceHandler) Detach(ctx context.Context, id string) error {
+func (r *ReservedIPServiceHandler) Detach(ctx context.Context, id string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s/detach", ripPath, id)
 	req, err := r.client.NewRequest(ctx, http.MethodPost, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = r.client.DoWithContext(ctx, req, nil)
-	return err
+	return r.client.DoWithContext(ctx, req, nil)
 }
@@ -16,7 +16,7 @@ func TestReservedIPServiceHandler_Attach(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	if err := client.ReservedIP.Attach(ctx, "12345", "1234"); err != nil {
+	if _, err := client.ReservedIP.Attach(ctx, "12345", "1234"); err != nil {
 		t.Errorf("ReservedIP.Attach returned %+v, expected %+v", err, nil)
 	}
 }
@@ -120,7 +120,7 @@ func TestReservedIPServiceHandler_Update(t *testing.T) {
 	defer teardown()
 
 	options := &ReservedIPUpdateReq{
-		Label: StringToStringPtr("my first reserved ip updated"),
+		Label: NewOptional("my first reserved ip updated"),
 	}
 
 	mux.HandleFunc("/v2/reserved-ips/12345", func(writer http.ResponseWriter, request *http.Request) {
@@ -169,7 +169,7 @@ func TestReservedIPServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.ReservedIP.Delete(ctx, "12345")
+	_, err := client.ReservedIP.Delete(ctx, "12345")
 
 	if err != nil {
 		t.Errorf("ReservedIP.Delete returned %+v, expected %+v", err, nil)
@@ -184,7 +184,7 @@ func TestReservedIPServiceHandler_Detach(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.ReservedIP.Detach(ctx, "12345")
+	_, err := client.ReservedIP.Detach(ctx, "12345")
 
 	if err != nil {
 		t.Errorf("ReservedIP.Detach returned %+v, expected %+v", err, nil)
@@ -0,0 +1,112 @@
+package govultr
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// requestIDHeaders are the headers the API may use to identify a request
+var requestIDHeaders = []string{"X-Request-Id", "X-Vultr-Request-Id"}
+
+// Response wraps the http.Response of an API call with what the client learnt while sending it.
+// It is returned by every service method, including those without a result such as
+// InstanceService.Start, and is nil when the request was never sent.
+type Response struct {
+	*http.Response
+
+	// Meta is the pagination information of list responses, nil for other responses
+	Meta *Meta
+
+	// RateLimitRemaining is the number of requests left in the current rate limit window, -1 when
+	// the API did not say
+	RateLimitRemaining int
+
+	// RequestID identifies the request for Vultr support, empty when the API did not return one
+	RequestID string
+
+	// Retries is the number of times the request was retried before this response
+	Retries int
+
+	// Elapsed is the time spent sending the request, retries and rate limiting included
+	Elapsed time.Duration
+
+	// Cached reports whether the response was served by the Cache installed with WithCache
+	Cached bool
+
+	// Reconciled reports whether a create whose response was lost was found to have succeeded.
+	// The response is then the one of the list call that found the resource.
+	Reconciled bool
+}
+
+// newResponse wraps res, meta is the pagination information decoded from its body
+func newResponse(res *http.Response, meta *Meta, stats *requestStats, elapsed time.Duration) *Response {
+	response := &Response{
+		Response:           res,
+		Meta:               meta,
+		RateLimitRemaining: rateLimitRemaining(res.Header),
+		RequestID:          requestID(res.Header),
+		Elapsed:            elapsed,
+	}
+
+	if stats != nil {
+		response.Cached = stats.cached
+		if stats.attempts > 1 {
+			response.Retries = stats.attempts - 1
+		}
+	}
+
+	return response
+}
+
+var metaType = reflect.TypeOf((*Meta)(nil))
+
+// metaOf returns the Meta field of data once a response was decoded into it. The list responses
+// are decoded into structs with a Meta field, other responses have none and return nil.
+func metaOf(data interface{}) *Meta {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	field := v.Elem().FieldByName("Meta")
+	if !field.IsValid() || field.Type() != metaType {
+		return nil
+	}
+	return field.Interface().(*Meta)
+}
+
+// NextCursor returns the cursor of the next page of a list response, empty on the last page
+func (r *Response) NextCursor() string {
+	if r == nil || r.Meta == nil || r.Meta.Links == nil {
+		return ""
+	}
+	return r.Meta.Links.Next
+}
+
+// PrevCursor returns the cursor of the previous page of a list response, empty on the first page
+func (r *Response) PrevCursor() string {
+	if r == nil || r.Meta == nil || r.Meta.Links == nil {
+		return ""
+	}
+	return r.Meta.Links.Prev
+}
+
+func rateLimitRemaining(header http.Header) int {
+	for _, name := range []string{"X-RateLimit-Remaining", "RateLimit-Remaining"} {
+		if remaining, err := strconv.Atoi(header.Get(name)); err == nil {
+			return remaining
+		}
+	}
+	return -1
+}
+
+func requestID(header http.Header) string {
+	for _, name := range requestIDHeaders {
+		if id := header.Get(name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
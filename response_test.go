@@ -0,0 +1,133 @@
+package govultr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
+		calls++
+		if calls == 1 {
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writer.Header().Set("X-RateLimit-Remaining", "27")
+		writer.Header().Set("X-Request-Id", "4a3fb2d4")
+		fmt.Fprint(writer, `{"instances":[],"meta":{"total":8,"links":{"next":"bmV4dA==","prev":""}}}`)
+	})
+
+	c, _ := client.With(WithRetryWait(time.Millisecond, time.Millisecond))
+	_, _, res, err := c.Instance.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("Instance.List returned %+v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("Response.StatusCode = %d, expected %d", res.StatusCode, http.StatusOK)
+	}
+	if res.Meta == nil || res.Meta.Total != 8 || res.NextCursor() != "bmV4dA==" || res.PrevCursor() != "" {
+		t.Errorf("Response.Meta = %+v, expected a total of 8 and a next cursor", res.Meta)
+	}
+	if res.RateLimitRemaining != 27 {
+		t.Errorf("Response.RateLimitRemaining = %d, expected 27", res.RateLimitRemaining)
+	}
+	if res.RequestID != "4a3fb2d4" {
+		t.Errorf("Response.RequestID = %q, expected 4a3fb2d4", res.RequestID)
+	}
+	if res.Retries != 1 {
+		t.Errorf("Response.Retries = %d, expected 1", res.Retries)
+	}
+	if res.Elapsed <= 0 {
+		t.Errorf("Response.Elapsed = %v, expected a positive duration", res.Elapsed)
+	}
+}
+
+func TestResponse_WithoutResult(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances/14b3e7d6/start", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNoContent)
+	})
+
+	res, err := client.Instance.Start(ctx, "14b3e7d6")
+	if err != nil {
+		t.Fatalf("Instance.Start returned %+v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("Response.StatusCode = %d, expected %d", res.StatusCode, http.StatusNoContent)
+	}
+	if res.Meta != nil || res.NextCursor() != "" {
+		t.Errorf("Response.Meta = %+v, expected nil", res.Meta)
+	}
+	if res.RateLimitRemaining != -1 {
+		t.Errorf("Response.RateLimitRemaining = %d, expected -1", res.RateLimitRemaining)
+	}
+	if res.Retries != 0 {
+		t.Errorf("Response.Retries = %d, expected 0", res.Retries)
+	}
+}
+
+func TestAPIError_RequestID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/domains/example.com", func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("X-Request-Id", "9c1e07aa")
+		http.Error(writer, `{"error":"invalid dns_sec","status":400}`, http.StatusBadRequest)
+	})
+
+	_, err := client.Domain.Update(ctx, "example.com", "on")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Domain.Update returned %+v, expected an *APIError", err)
+	}
+	if apiErr.RequestID != "9c1e07aa" {
+		t.Errorf("APIError.RequestID = %q, expected 9c1e07aa", apiErr.RequestID)
+	}
+}
+
+func TestResponse_OnError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/databases/", func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("X-Request-Id", "5d2e9f10")
+		http.Error(writer, `{"error":"database not found","status":404}`, http.StatusNotFound)
+	})
+
+	if _, res, err := client.Database.Get(ctx, "missing"); err == nil || res == nil || res.RequestID != "5d2e9f10" {
+		t.Errorf("Database.Get returned %+v, %v, expected the response of the failed request", res, err)
+	}
+	if _, _, res, err := client.Database.ListUsers(ctx, "missing", nil); err == nil || res == nil || res.StatusCode != http.StatusNotFound {
+		t.Errorf("Database.ListUsers returned %+v, %v, expected the response of the failed request", res, err)
+	}
+}
+
+func TestResponse_MetaOnlyFromLists(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances/14b3e7d6", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"instance":{"id":"14b3e7d6","label":"meta","tags":["\"meta\""]}}`)
+	})
+
+	instance, res, err := client.Instance.Get(ctx, "14b3e7d6")
+	if err != nil {
+		t.Fatalf("Instance.Get returned %+v", err)
+	}
+
+	if instance.Label != "meta" || res.Meta != nil {
+		t.Errorf("Instance.Get returned %+v with Meta %+v, expected no Meta", instance, res.Meta)
+	}
+}
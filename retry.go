@@ -0,0 +1,265 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	// reconcileWindow is how long the IDs returned by creates are remembered, so that
+	// reconciliation does not attribute them to another create
+	reconcileWindow = 5 * time.Minute
+	// defaultCreateSkew is how much earlier than the request a reconciled resource may be dated by
+	// default, to allow for clock skew and the second precision of date_created
+	defaultCreateSkew = 10 * time.Second
+)
+
+// RetryPolicy decides which requests are retried after a connection error or a 5xx response.
+// Requests rejected with a 429 are retried by every policy but RetryNone, since the API did not
+// process them.
+type RetryPolicy int
+
+const (
+	// RetryIdempotent retries GET, PUT and DELETE requests. Creates are only retried once the
+	// service confirmed that no resource with the requested label or tags was created since the
+	// request was first sent. This is the default policy.
+	RetryIdempotent RetryPolicy = iota
+	// RetryAll retries every request. A create whose response was lost may create the resource twice.
+	RetryAll
+	// RetryNone never retries
+	RetryNone
+)
+
+type retryPolicyKey struct{}
+
+type reconcileKey struct{}
+
+// errReconciled stops the retries of a create that was found to have succeeded
+var errReconciled = errors.New("request reconciled with an existing resource")
+
+// ErrAmbiguousCreate is matched by the *AmbiguousCreateError of a create whose response was lost,
+// when several resources it may have created were found
+var ErrAmbiguousCreate = errors.New("several resources match the create")
+
+// AmbiguousCreateError is returned by a create whose response was lost when several resources
+// match it, so that it may have created more than one. The request is neither reconciled nor
+// retried. IDs lists the candidates, so that the extra ones can be cleaned up.
+type AmbiguousCreateError struct {
+	IDs []string
+	// Err is the error of the request
+	Err error
+}
+
+// Error returns the error of the request and the IDs of the candidates
+func (e *AmbiguousCreateError) Error() string {
+	return fmt.Sprintf("%v: resources %s match the create", e.Err, strings.Join(e.IDs, ", "))
+}
+
+// Unwrap returns ErrAmbiguousCreate and the error of the request
+func (e *AmbiguousCreateError) Unwrap() []error {
+	return []error{ErrAmbiguousCreate, e.Err}
+}
+
+// reconcileFunc looks for the resource created by a request whose response was lost
+type reconcileFunc func(ctx context.Context) (bool, error)
+
+// ContextWithRetryPolicy overrides the retry policy of the client for requests made with the
+// returned context.
+func ContextWithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// checkRetry is the retryablehttp.CheckRetry of the client
+func (c *Client) checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	retry, checkErr := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	if !retry || checkErr != nil {
+		return retry, checkErr
+	}
+
+	policy := c.retryPolicyFor(ctx)
+	switch {
+	case policy == RetryNone:
+		return false, nil
+	case policy == RetryAll:
+		return true, nil
+	case resp != nil && resp.StatusCode == http.StatusTooManyRequests:
+		return true, nil
+	}
+
+	switch requestMethod(ctx) {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true, nil
+	}
+
+	reconcile, ok := ctx.Value(reconcileKey{}).(reconcileFunc)
+	if !ok {
+		return false, nil
+	}
+
+	found, err := reconcile(ctx)
+	switch {
+	case err != nil:
+		return false, nil
+	case found:
+		return false, errReconciled
+	default:
+		return true, nil
+	}
+}
+
+func (c *Client) retryPolicyFor(ctx context.Context) RetryPolicy {
+	if p, ok := ctx.Value(retryPolicyKey{}).(RetryPolicy); ok {
+		return p
+	}
+	return c.retryPolicy
+}
+
+type requestMethodKey struct{}
+
+func requestMethod(ctx context.Context) string {
+	method, _ := ctx.Value(requestMethodKey{}).(string)
+	return method
+}
+
+// createMatch tells how to recognize the resource created by a create request whose response
+// was lost
+type createMatch[T any] struct {
+	list    ListFunc[T]
+	options *ListOptions
+	// match reports whether an item has the attributes requested by the create
+	match func(*T) bool
+	id    func(*T) string
+	// dateCreated is nil for the resources without a creation date, which leaves older matching
+	// resources among the candidates
+	dateCreated func(*T) Timestamp
+}
+
+// doCreate sends a create request and decodes the created resource into data, which stores it in
+// *result. After a lost response, RetryIdempotent only retries the request when m finds no
+// resource it may have created: one matching it, dated after the request was first sent, and not
+// returned by another create of the client. The response of the list call that found it is then
+// returned with Reconciled set, and an *AmbiguousCreateError when several were found. A nil m
+// means the request carries nothing to identify the resource by, and is then never retried by
+// RetryIdempotent.
+func doCreate[T any](ctx context.Context, c *Client, r *http.Request, data interface{}, result **T, m *createMatch[T]) (*Response, error) { //nolint:lll
+	if m == nil {
+		return c.DoWithContext(ctx, r, data)
+	}
+
+	since := time.Now().Add(-c.createSkew)
+	var lookup *Response
+	var ambiguous []string
+	reconcile := func(ctx context.Context) (bool, error) {
+		candidates, resp, err := m.find(ctx, c.created, since)
+		switch {
+		case err != nil:
+			return false, err
+		case len(candidates) == 1:
+			*result, lookup = candidates[0], resp
+			return true, nil
+		case len(candidates) > 1:
+			for _, candidate := range candidates {
+				ambiguous = append(ambiguous, m.id(candidate))
+			}
+			return false, ErrAmbiguousCreate
+		}
+		return false, nil
+	}
+
+	resp, err := c.DoWithContext(context.WithValue(ctx, reconcileKey{}, reconcileFunc(reconcile)), r, data)
+	switch {
+	case errors.Is(err, errReconciled):
+		lookup.Reconciled = true
+		resp, err = lookup, nil
+	case err != nil && ambiguous != nil:
+		err = &AmbiguousCreateError{IDs: ambiguous, Err: err}
+	}
+	if err == nil && *result != nil {
+		c.created.add(m.id(*result))
+	}
+
+	return resp, err
+}
+
+// find pages through the list for the resources matching the create that are dated since since
+// and were not returned by another create, and returns them with the response of the last page
+func (m *createMatch[T]) find(ctx context.Context, created *createdIDs, since time.Time) ([]*T, *Response, error) {
+	var last *Response
+	list := func(ctx context.Context, options *ListOptions) ([]T, *Meta, *Response, error) {
+		items, meta, resp, err := m.list(ctx, options)
+		last = resp
+		return items, meta, resp, err
+	}
+
+	var candidates []*T
+	for item, err := range All(ctx, list, m.options) {
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if m.dateCreated != nil && m.dateCreated(&item).Time.Before(since) {
+			continue
+		}
+		if m.match(&item) && !created.contains(m.id(&item)) {
+			candidates = append(candidates, &item)
+		}
+	}
+
+	return candidates, last, nil
+}
+
+// createdIDs are the IDs recently returned by the creates of a client
+type createdIDs struct {
+	mu  sync.Mutex
+	ids map[string]time.Time
+}
+
+func newCreatedIDs() *createdIDs {
+	return &createdIDs{ids: make(map[string]time.Time)}
+}
+
+// add remembers id for the reconcile window
+func (s *createdIDs) add(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for known, at := range s.ids {
+		if now.Sub(at) > reconcileWindow {
+			delete(s.ids, known)
+		}
+	}
+	s.ids[id] = now
+}
+
+func (s *createdIDs) contains(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.ids[id]
+	return ok
+}
+
+// hasTags reports whether every wanted tag is in tags
+func hasTags(tags, wanted []string) bool {
+	for _, w := range wanted {
+		found := false
+		for _, t := range tags {
+			if t == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,309 @@
+package govultr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRetryTestClient(t *testing.T, opts ...Option) *Client {
+	t.Helper()
+	c, err := New(append([]Option{WithBaseURL(server.URL), WithRetryWait(0, 0)}, opts...)...)
+	if err != nil {
+		t.Fatalf("New returned %+v", err)
+	}
+	return c
+}
+
+func TestRetryPolicy_CreateWithoutLabel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var creates int32
+	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
+		atomic.AddInt32(&creates, 1)
+		writer.WriteHeader(http.StatusInternalServerError)
+	})
+
+	c := newRetryTestClient(t)
+	_, _, err := c.Instance.Create(ctx, &InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 362})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("Instance.Create returned %v, expected a 500 APIError", err)
+	}
+
+	if creates != 1 {
+		t.Errorf("create was sent %d times, expected 1", creates)
+	}
+}
+
+func TestRetryPolicy_CreateReconciled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var creates int32
+	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method == http.MethodPost {
+			atomic.AddInt32(&creates, 1)
+			writer.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		if label := request.URL.Query().Get("label"); label != "web" {
+			t.Errorf("reconcile listed label %q, expected %q", label, "web")
+		}
+		fmt.Fprintf(writer, `{"instances":[
+			{"id":"old","label":"web","region":"ewr","tags":["prod"],"date_created":"2020-10-10T01:56:20+00:00"},
+			{"id":"new","label":"web","region":"ewr","tags":["prod"],"date_created":%q}
+		],"meta":{"total":2,"links":{"next":"","prev":""}}}`, time.Now().UTC().Format(time.RFC3339))
+	})
+
+	c := newRetryTestClient(t)
+	instance, resp, err := c.Instance.Create(ctx, &InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 387, Label: "web", Tags: []string{"prod"}})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	if instance == nil || instance.ID != "new" {
+		t.Errorf("Instance.Create returned %+v, expected the reconciled instance", instance)
+	}
+
+	if resp == nil || !resp.Reconciled || resp.Request.Method != http.MethodGet {
+		t.Errorf("Instance.Create returned response %+v, expected the reconciled list response", resp)
+	}
+
+	if creates != 1 {
+		t.Errorf("create was sent %d times, expected 1", creates)
+	}
+}
+
+func TestRetryPolicy_CreateReconciledWithSibling(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var instances []string
+	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		now := time.Now().UTC().Format(time.RFC3339)
+		if request.Method == http.MethodPost {
+			id := fmt.Sprintf("i-%d", len(instances)+1)
+			instances = append(instances, fmt.Sprintf(`{"id":%q,"region":"ewr","tags":["batch"],"date_created":%q}`, id, now))
+			if id == "i-3" {
+				// the create succeeded but its response was lost
+				writer.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			writer.WriteHeader(http.StatusAccepted)
+			fmt.Fprintf(writer, `{"instance":%s}`, instances[len(instances)-1])
+			return
+		}
+
+		fmt.Fprintf(writer, `{"instances":[%s],"meta":{"total":%d,"links":{"next":"","prev":""}}}`,
+			strings.Join(instances, ","), len(instances))
+	})
+
+	// an instance with the same tag created a minute ago by an earlier run
+	instances = append(instances, fmt.Sprintf(`{"id":"i-1","region":"ewr","tags":["batch"],"date_created":%q}`,
+		time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)))
+
+	c := newRetryTestClient(t)
+	req := &InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 387, Tags: []string{"batch"}}
+
+	var ids []string
+	for range 2 {
+		instance, _, err := c.Instance.Create(ctx, req)
+		if err != nil {
+			t.Fatalf("Instance.Create returned %+v", err)
+		}
+		ids = append(ids, instance.ID)
+	}
+
+	if !reflect.DeepEqual(ids, []string{"i-2", "i-3"}) || len(instances) != 3 {
+		t.Errorf("Instance.Create returned %v after %d creates, expected [i-2 i-3] after 2", ids, len(instances)-1)
+	}
+}
+
+func TestRetryPolicy_CreateAmbiguous(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var creates int32
+	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method == http.MethodPost {
+			atomic.AddInt32(&creates, 1)
+			writer.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		now := time.Now().UTC().Format(time.RFC3339)
+		fmt.Fprintf(writer, `{"instances":[
+			{"id":"a","region":"ewr","tags":["batch"],"date_created":%q},
+			{"id":"b","region":"ewr","tags":["batch"],"date_created":%q}
+		],"meta":{"total":2,"links":{"next":"","prev":""}}}`, now, now)
+	})
+
+	c := newRetryTestClient(t)
+	instance, _, err := c.Instance.Create(ctx, &InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 387, Tags: []string{"batch"}})
+	if instance != nil || !errors.Is(err, ErrAmbiguousCreate) {
+		t.Errorf("Instance.Create returned %+v, %v, expected ErrAmbiguousCreate", instance, err)
+	}
+
+	var ambiguous *AmbiguousCreateError
+	if !errors.As(err, &ambiguous) || !reflect.DeepEqual(ambiguous.IDs, []string{"a", "b"}) {
+		t.Errorf("Instance.Create returned %v, expected the candidates a and b", err)
+	}
+	if creates != 1 {
+		t.Errorf("create was sent %d times, expected 1", creates)
+	}
+}
+
+func TestRetryPolicy_CreateRetriedWhenMissing(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var creates int32
+	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method == http.MethodGet {
+			fmt.Fprint(writer, `{"instances":[],"meta":{"total":0,"links":{"next":"","prev":""}}}`)
+			return
+		}
+
+		if atomic.AddInt32(&creates, 1) == 1 {
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writer.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(writer, `{"instance":{"id":"created","label":"web"}}`)
+	})
+
+	c := newRetryTestClient(t)
+	instance, _, err := c.Instance.Create(ctx, &InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 387, Label: "web"})
+	if err != nil {
+		t.Fatalf("Instance.Create returned %+v", err)
+	}
+
+	if instance.ID != "created" {
+		t.Errorf("Instance.Create returned %+v, expected the created instance", instance)
+	}
+
+	if creates != 2 {
+		t.Errorf("create was sent %d times, expected 2", creates)
+	}
+}
+
+func TestRetryPolicy_ReservedIPReconciled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var creates int32
+	mux.HandleFunc("/v2/reserved-ips", func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method == http.MethodPost {
+			atomic.AddInt32(&creates, 1)
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(writer, `{"reserved_ips":[
+			{"id":"other","region":"ewr","ip_type":"v4","label":"db"},
+			{"id":"created","region":"ewr","ip_type":"v4","label":"lb"},
+			{"id":"v6","region":"ewr","ip_type":"v6","label":"lb"}
+		],"meta":{"total":3,"links":{"next":"","prev":""}}}`)
+	})
+
+	c := newRetryTestClient(t)
+	rip, resp, err := c.ReservedIP.Create(ctx, &ReservedIPReq{Region: "ewr", IPType: IPTypeV4, Label: "lb"})
+	if err != nil {
+		t.Fatalf("ReservedIP.Create returned %+v", err)
+	}
+
+	if rip.ID != "created" || !resp.Reconciled {
+		t.Errorf("ReservedIP.Create returned %+v, %+v, expected the reconciled reserved IP", rip, resp)
+	}
+	if creates != 1 {
+		t.Errorf("create was sent %d times, expected 1", creates)
+	}
+}
+
+func TestWithCreateSkew(t *testing.T) {
+	if _, err := New(WithCreateSkew(-time.Second)); err == nil {
+		t.Error("expected a negative create skew to be rejected")
+	}
+
+	c, err := New(WithCreateSkew(time.Minute))
+	if err != nil {
+		t.Fatalf("New returned %+v", err)
+	}
+	if derived, _ := c.With(); derived.createSkew != time.Minute {
+		t.Errorf("derived client has a create skew of %v, expected 1m0s", derived.createSkew)
+	}
+}
+
+func TestRetryPolicy_Override(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/v2/account", func(writer http.ResponseWriter, request *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		writer.WriteHeader(http.StatusInternalServerError)
+	})
+
+	c := newRetryTestClient(t)
+	if _, _, err := c.Account.Get(ContextWithRetryPolicy(ctx, RetryNone)); err == nil {
+		t.Fatal("expected Account.Get to fail")
+	}
+	if calls != 1 {
+		t.Errorf("RetryNone sent %d requests, expected 1", calls)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	req, _ := c.NewRequest(ctx, http.MethodPost, "/v2/account", nil)
+	if _, err := c.DoWithContext(ContextWithRetryPolicy(ctx, RetryAll), req, nil); err == nil {
+		t.Fatal("expected the POST to fail")
+	}
+	if calls != retryLimit+1 {
+		t.Errorf("RetryAll sent %d requests, expected %d", calls, retryLimit+1)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	c = newRetryTestClient(t, WithRetryPolicy(RetryNone))
+	if _, _, err := c.Account.Get(ctx); err == nil {
+		t.Fatal("expected Account.Get to fail")
+	}
+	if calls != 1 {
+		t.Errorf("WithRetryPolicy(RetryNone) sent %d requests, expected 1", calls)
+	}
+}
+
+func TestRetryPolicy_RateLimitedPost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/v2/ssh-keys", func(writer http.ResponseWriter, request *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			writer.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		writer.WriteHeader(http.StatusCreated)
+		fmt.Fprint(writer, `{"ssh_key":{"id":"key"}}`)
+	})
+
+	c := newRetryTestClient(t)
+	if _, _, err := c.SSHKey.Create(ctx, &SSHKeyReq{Name: "key", SSHKey: "ssh-ed25519 AAAA"}); err != nil {
+		t.Fatalf("SSHKey.Create returned %+v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("rate limited create was sent %d times, expected 2", calls)
+	}
+}
@@ -11,11 +11,11 @@ import (
 // SnapshotService is the interface to interact with Snapshot endpoints on the Vultr API
 // Link : https://www.vultr.com/api/#tag/snapshot
 type SnapshotService interface {
-	Create(ctx context.Context, snapshotReq *SnapshotReq) (*Snapshot, *http.Response, error)
-	CreateFromURL(ctx context.Context, snapshotURLReq *SnapshotURLReq) (*Snapshot, *http.Response, error)
-	Get(ctx context.Context, snapshotID string) (*Snapshot, *http.Response, error)
-	Delete(ctx context.Context, snapshotID string) error
-	List(ctx context.Context, options *ListOptions) ([]Snapshot, *Meta, *http.Response, error)
+	Create(ctx context.Context, snapshotReq *SnapshotReq) (*Snapshot, *Response, error)
+	CreateFromURL(ctx context.Context, snapshotURLReq *SnapshotURLReq) (*Snapshot, *Response, error)
+	Get(ctx context.Context, snapshotID string) (*Snapshot, *Response, error)
+	Delete(ctx context.Context, snapshotID string) (*Response, error)
+	List(ctx context.Context, options *ListOptions) ([]Snapshot, *Meta, *Response, error)
 }
 
 // SnapshotServiceHandler handles interaction with the snapshot methods for the Vultr API
@@ -25,14 +25,14 @@ type SnapshotServiceHandler struct {
 
 // Snapshot represents a Vultr snapshot
 type Snapshot struct {
-	ID             string `json:"id"`
-	DateCreated    string `json:"date_created"`
-	Description    string `json:"description"`
-	Size           int    `json:"size"`
-	CompressedSize int    `json:"compressed_size"`
-	Status         string `json:"status"`
-	OsID           int    `json:"os_id"`
-	AppID          int    `json:"app_id"`
+	ID             string    `json:"id"`
+	DateCreated    Timestamp `json:"date_created"`
+	Description    string    `json:"description"`
+	Size           int       `json:"size"`
+	CompressedSize int       `json:"compressed_size"`
+	Status         string    `json:"status"`
+	OsID           int       `json:"os_id"`
+	AppID          int       `json:"app_id"`
 }
 
 // SnapshotReq struct is used to create snapshots.
@@ -41,12 +41,27 @@ type SnapshotReq struct {
 	Description string `json:"description,omitempty"`
 }
 
+// Validate checks that the instance ID is given
+func (r SnapshotReq) Validate() error {
+	v := new(validation)
+	v.required("instance_id", r.InstanceID)
+	return v.err()
+}
+
 // SnapshotURLReq struct is used to create snapshots from a URL.
 type SnapshotURLReq struct {
 	URL         string `json:"url"`
 	Description string `json:"description,omitempty"`
 }
 
+// Validate checks that the URL is given and is an http or https URL
+func (r SnapshotURLReq) Validate() error {
+	v := new(validation)
+	v.required("url", r.URL)
+	v.url("url", r.URL)
+	return v.err()
+}
+
 type snapshotsBase struct {
 	Snapshots []Snapshot `json:"snapshots"`
 	Meta      *Meta      `json:"meta"`
@@ -57,7 +72,7 @@ type snapshotBase struct {
 }
 
 // Create makes a snapshot of a provided server
-func (s *SnapshotServiceHandler) Create(ctx context.Context, snapshotReq *SnapshotReq) (*Snapshot, *http.Response, error) {
+func (s *SnapshotServiceHandler) Create(ctx context.Context, snapshotReq *SnapshotReq) (*Snapshot, *Response, error) {
 	uri := "/v2/snapshots"
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, uri, snapshotReq)
@@ -75,7 +90,7 @@ func (s *SnapshotServiceHandler) Create(ctx context.Context, snapshotReq *Snapsh
 }
 
 // CreateFromURL will create a snapshot based on an image iso from a URL you provide
-func (s *SnapshotServiceHandler) CreateFromURL(ctx context.Context, snapshotURLReq *SnapshotURLReq) (*Snapshot, *http.Response, error) {
+func (s *SnapshotServiceHandler) CreateFromURL(ctx context.Context, snapshotURLReq *SnapshotURLReq) (*Snapshot, *Response, error) {
 	uri := "/v2/snapshots/create-from-url"
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, uri, snapshotURLReq)
@@ -93,7 +108,7 @@ func (s *SnapshotServiceHandler) CreateFromURL(ctx context.Context, snapshotURLR
 }
 
 // Get a specific snapshot
-func (s *SnapshotServiceHandler) Get(ctx context.Context, snapshotID string) (*Snapshot, *http.Response, error) {
+func (s *SnapshotServiceHandler) Get(ctx context.Context, snapshotID string) (*Snapshot, *Response, error) {
 	uri := fmt.Sprintf("/v2/snapshots/%s", snapshotID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -111,20 +126,19 @@ func (s *SnapshotServiceHandler) Get(ctx context.Context, snapshotID string) (*S
 }
 
 // Delete a snapshot.
-func (s *SnapshotServiceHandler) Delete(ctx context.Context, snapshotID string) error {
+func (s *SnapshotServiceHandler) Delete(ctx context.Context, snapshotID string) (*Response, error) {
 	uri := fmt.Sprintf("/v2/snapshots/%s", snapshotID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = s.client.DoWithContext(ctx, req, nil)
-	return err
+	return s.client.DoWithContext(ctx, req, nil)
 }
 
 // List all available snapshots.
-func (s *SnapshotServiceHandler) List(ctx context.Context, options *ListOptions) ([]Snapshot, *Meta, *http.Response, error) { //nolint:dupl
+func (s *SnapshotServiceHandler) List(ctx context.Context, options *ListOptions) ([]Snapshot, *Meta, *Response, error) { //nolint:dupl
 	uri := "/v2/snapshots"
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -28,7 +28,7 @@ func TestSnapshotServiceHandler_Create(t *testing.T) {
 
 	expected := &Snapshot{
 		ID:             "5359435d28b9a",
-		DateCreated:    "2014-04-18 12:40:40",
+		DateCreated:    timestamp("2014-04-18 12:40:40"),
 		Description:    "Test snapshot",
 		Size:           42949672960,
 		CompressedSize: 1078864689,
@@ -58,7 +58,7 @@ func TestSnapshotServiceHandler_CreateFromURL(t *testing.T) {
 
 	expected := &Snapshot{
 		ID:             "5359435d28b9a",
-		DateCreated:    "2014-04-18 12:40:40",
+		DateCreated:    timestamp("2014-04-18 12:40:40"),
 		Description:    "Test snapshot",
 		Size:           42949672960,
 		CompressedSize: 1078864689,
@@ -88,7 +88,7 @@ func TestSnapshotServiceHandler_Get(t *testing.T) {
 
 	expected := &Snapshot{
 		ID:             "5359435d28b9a",
-		DateCreated:    "2014-04-18 12:40:40",
+		DateCreated:    timestamp("2014-04-18 12:40:40"),
 		Description:    "Test snapshot",
 		Size:           42949672960,
 		CompressedSize: 1078864689,
@@ -110,7 +110,7 @@ func TestSnapshotServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.Snapshot.Delete(ctx, "7a05cbf361d98")
+	_, err := client.Snapshot.Delete(ctx, "7a05cbf361d98")
 
 	if err != nil {
 		t.Errorf("Snapshot.Delete returned %+v, expected %+v", err, nil)
@@ -134,7 +134,7 @@ func TestSnapshotServiceHandler_List(t *testing.T) {
 	expectedSnap := []Snapshot{
 		{
 			ID:             "885ee0f4f263c",
-			DateCreated:    "2014-04-18 12:40:40",
+			DateCreated:    timestamp("2014-04-18 12:40:40"),
 			Description:    "Test snapshot",
 			Size:           42949672960,
 			CompressedSize: 1078864689,
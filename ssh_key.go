@@ -11,11 +11,11 @@ import (
 // SSHKeyService is the interface to interact with the SSH Key endpoints on the Vultr API
 // Link : https://www.vultr.com/api/#tag/ssh
 type SSHKeyService interface { //nolint:dupl
-	Create(ctx context.Context, sshKeyReq *SSHKeyReq) (*SSHKey, *http.Response, error)
-	Get(ctx context.Context, sshKeyID string) (*SSHKey, *http.Response, error)
-	Update(ctx context.Context, sshKeyID string, sshKeyReq *SSHKeyReq) error
-	Delete(ctx context.Context, sshKeyID string) error
-	List(ctx context.Context, options *ListOptions) ([]SSHKey, *Meta, *http.Response, error)
+	Create(ctx context.Context, sshKeyReq *SSHKeyReq) (*SSHKey, *Response, error)
+	Get(ctx context.Context, sshKeyID string) (*SSHKey, *Response, error)
+	Update(ctx context.Context, sshKeyID string, sshKeyReq *SSHKeyReq) (*Response, error)
+	Delete(ctx context.Context, sshKeyID string) (*Response, error)
+	List(ctx context.Context, options *ListOptions) ([]SSHKey, *Meta, *Response, error)
 }
 
 // SSHKeyServiceHandler handles interaction with the SSH Key methods for the Vultr API
@@ -25,10 +25,10 @@ type SSHKeyServiceHandler struct {
 
 // SSHKey represents an SSH Key on Vultr
 type SSHKey struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	SSHKey      string `json:"ssh_key"`
-	DateCreated string `json:"date_created"`
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	SSHKey      string    `json:"ssh_key"`
+	DateCreated Timestamp `json:"date_created"`
 }
 
 // SSHKeyReq is the ssh key struct for create and update calls
@@ -37,6 +37,18 @@ type SSHKeyReq struct {
 	SSHKey string `json:"ssh_key,omitempty"`
 }
 
+// Validate checks that the name and key of a new SSH key are given
+func (r SSHKeyReq) Validate() error {
+	v := new(validation)
+	v.required("name", r.Name, "ssh_key", r.SSHKey)
+	return v.err()
+}
+
+// validateUpdate accepts any update, the name and key can each be left unchanged
+func (r SSHKeyReq) validateUpdate() error {
+	return nil
+}
+
 type sshKeysBase struct {
 	SSHKeys []SSHKey `json:"ssh_keys"`
 	Meta    *Meta    `json:"meta"`
@@ -47,7 +59,7 @@ type sshKeyBase struct {
 }
 
 // Create a ssh key
-func (s *SSHKeyServiceHandler) Create(ctx context.Context, sshKeyReq *SSHKeyReq) (*SSHKey, *http.Response, error) {
+func (s *SSHKeyServiceHandler) Create(ctx context.Context, sshKeyReq *SSHKeyReq) (*SSHKey, *Response, error) {
 	uri := "/v2/ssh-keys"
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, uri, sshKeyReq)
@@ -65,7 +77,7 @@ func (s *SSHKeyServiceHandler) Create(ctx context.Context, sshKeyReq *SSHKeyReq)
 }
 
 // Get a specific ssh key.
-func (s *SSHKeyServiceHandler) Get(ctx context.Context, sshKeyID string) (*SSHKey, *http.Response, error) {
+func (s *SSHKeyServiceHandler) Get(ctx context.Context, sshKeyID string) (*SSHKey, *Response, error) {
 	uri := fmt.Sprintf("/v2/ssh-keys/%s", sshKeyID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -83,32 +95,30 @@ func (s *SSHKeyServiceHandler) Get(ctx context.Context, sshKeyID string) (*SSHKe
 }
 
 // Update will update the given SSH Key. Empty strings will be ignored.
-func (s *SSHKeyServiceHandler) Update(ctx context.Context, sshKeyID string, sshKeyReq *SSHKeyReq) error {
+func (s *SSHKeyServiceHandler) Update(ctx context.Context, sshKeyID string, sshKeyReq *SSHKeyReq) (*Response, error) {
 	uri := fmt.Sprintf("/v2/ssh-keys/%s", sshKeyID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, uri, sshKeyReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = s.client.DoWithContext(ctx, req, nil)
-	return err
+	return s.client.DoWithContext(ctx, req, nil)
 }
 
 // Delete a specific ssh-key.
-func (s *SSHKeyServiceHandler) Delete(ctx context.Context, sshKeyID string) error {
+func (s *SSHKeyServiceHandler) Delete(ctx context.Context, sshKeyID string) (*Response, error) {
 	uri := fmt.Sprintf("/v2/ssh-keys/%s", sshKeyID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = s.client.DoWithContext(ctx, req, nil)
-	return err
+	return s.client.DoWithContext(ctx, req, nil)
 }
 
 // List all available SSH Keys.
-func (s *SSHKeyServiceHandler) List(ctx context.Context, options *ListOptions) ([]SSHKey, *Meta, *http.Response, error) { //nolint:dupl
+func (s *SSHKeyServiceHandler) List(ctx context.Context, options *ListOptions) ([]SSHKey, *Meta, *Response, error) { //nolint:dupl
 	uri := "/v2/ssh-keys"
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, uri, nil)
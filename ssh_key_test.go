@@ -30,7 +30,7 @@ func TestSSHKeyServiceHandler_Create(t *testing.T) {
 		ID:          "5f05d5a71fe28",
 		Name:        "api-test-ssh",
 		SSHKey:      "ssh-rsa AF+LbfYYw== test@admin.com",
-		DateCreated: "2020-07-08 14:18:15",
+		DateCreated: timestamp("2020-07-08 14:18:15"),
 	}
 
 	if !reflect.DeepEqual(key, expected) {
@@ -56,7 +56,7 @@ func TestSSHKeyServiceHandler_Get(t *testing.T) {
 		ID:          "5f05d5a71fe28",
 		Name:        "api-test-ssh",
 		SSHKey:      "ssh-rsa AF+LbfYYw== test@admin.com",
-		DateCreated: "2020-07-08 14:18:15",
+		DateCreated: timestamp("2020-07-08 14:18:15"),
 	}
 
 	if !reflect.DeepEqual(key, expected) {
@@ -77,7 +77,7 @@ func TestSSHKeyServiceHandler_Update(t *testing.T) {
 		SSHKey: "ssh-rsa CCCCB3NzaC1yc your_username@hostname",
 	}
 
-	err := client.SSHKey.Update(ctx, "abc123", sshKey)
+	_, err := client.SSHKey.Update(ctx, "abc123", sshKey)
 
 	if err != nil {
 		t.Errorf("SSHKey.Update returned error: %+v", err)
@@ -92,7 +92,7 @@ func TestSSHKeyServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.SSHKey.Delete(ctx, "abc123")
+	_, err := client.SSHKey.Delete(ctx, "abc123")
 
 	if err != nil {
 		t.Errorf("SSHKey.Delete returned %+v, expected %+v", err, nil)
@@ -118,7 +118,7 @@ func TestSSHKeyServiceHandler_List(t *testing.T) {
 			ID:          "5ed139d1890db",
 			Name:        "api-test-ssh",
 			SSHKey:      "ssh-rsa AAAAB3NzaC1ycYYw== test@admin.com",
-			DateCreated: "2020-05-29 16:35:29",
+			DateCreated: timestamp("2020-05-29 16:35:29"),
 		},
 	}
 
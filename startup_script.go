@@ -13,11 +13,11 @@ const scriptPath = "/v2/startup-scripts"
 // StartupScriptService is the interface to interact with the startup script endpoints on the Vultr API
 // Link : https://www.vultr.com/api/#tag/startup
 type StartupScriptService interface { //nolint:dupl
-	Create(ctx context.Context, req *StartupScriptReq) (*StartupScript, *http.Response, error)
-	Get(ctx context.Context, scriptID string) (*StartupScript, *http.Response, error)
-	Update(ctx context.Context, scriptID string, scriptReq *StartupScriptReq) error
-	Delete(ctx context.Context, scriptID string) error
-	List(ctx context.Context, options *ListOptions) ([]StartupScript, *Meta, *http.Response, error)
+	Create(ctx context.Context, req *StartupScriptReq) (*StartupScript, *Response, error)
+	Get(ctx context.Context, scriptID string) (*StartupScript, *Response, error)
+	Update(ctx context.Context, scriptID string, scriptReq *StartupScriptReq) (*Response, error)
+	Delete(ctx context.Context, scriptID string) (*Response, error)
+	List(ctx context.Context, options *ListOptions) ([]StartupScript, *Meta, *Response, error)
 }
 
 // StartupScriptServiceHandler handles interaction with the startup script methods for the Vultr API
@@ -27,12 +27,12 @@ type StartupScriptServiceHandler struct {
 
 // StartupScript represents an startup script on Vultr
 type StartupScript struct {
-	ID           string `json:"id"`
-	DateCreated  string `json:"date_created"`
-	DateModified string `json:"date_modified"`
-	Name         string `json:"name"`
-	Type         string `json:"type"`
-	Script       string `json:"script"`
+	ID           string    `json:"id"`
+	DateCreated  Timestamp `json:"date_created"`
+	DateModified Timestamp `json:"date_modified"`
+	Name         string    `json:"name"`
+	Type         string    `json:"type"`
+	Script       string    `json:"script"`
 }
 
 // StartupScriptReq is the user struct for create and update calls
@@ -42,6 +42,28 @@ type StartupScriptReq struct {
 	Script string `json:"script"`
 }
 
+// Validate checks that the name and script of a new startup script are given, and that the type,
+// when given, is boot or pxe
+func (r StartupScriptReq) Validate() error {
+	v := new(validation)
+	v.required("name", r.Name, "script", r.Script)
+	r.validate(v)
+	return v.err()
+}
+
+// validateUpdate checks the type, the other fields can be left unchanged
+func (r StartupScriptReq) validateUpdate() error {
+	v := new(validation)
+	r.validate(v)
+	return v.err()
+}
+
+func (r StartupScriptReq) validate(v *validation) {
+	if r.Type != "" && r.Type != "boot" && r.Type != "pxe" {
+		v.add("type", "%q is neither boot nor pxe", r.Type)
+	}
+}
+
 type startupScriptsBase struct {
 	StartupScripts []StartupScript `json:"startup_scripts"`
 	Meta           *Meta           `json:"meta"`
@@ -54,7 +76,7 @@ type startupScriptBase struct {
 var _ StartupScriptService = &StartupScriptServiceHandler{}
 
 // Create a startup script
-func (s *StartupScriptServiceHandler) Create(ctx context.Context, scriptReq *StartupScriptReq) (*StartupScript, *http.Response, error) {
+func (s *StartupScriptServiceHandler) Create(ctx context.Context, scriptReq *StartupScriptReq) (*StartupScript, *Response, error) {
 	req, err := s.client.NewRequest(ctx, http.MethodPost, scriptPath, scriptReq)
 	if err != nil {
 		return nil, nil, err
@@ -70,7 +92,7 @@ func (s *StartupScriptServiceHandler) Create(ctx context.Context, scriptReq *Sta
 }
 
 // Get a single startup script
-func (s *StartupScriptServiceHandler) Get(ctx context.Context, scriptID string) (*StartupScript, *http.Response, error) {
+func (s *StartupScriptServiceHandler) Get(ctx context.Context, scriptID string) (*StartupScript, *Response, error) {
 	uri := fmt.Sprintf("%s/%s", scriptPath, scriptID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -88,33 +110,31 @@ func (s *StartupScriptServiceHandler) Get(ctx context.Context, scriptID string)
 }
 
 // Update will update the given startup script. Empty strings will be ignored.
-func (s *StartupScriptServiceHandler) Update(ctx context.Context, scriptID string, scriptReq *StartupScriptReq) error {
+func (s *StartupScriptServiceHandler) Update(ctx context.Context, scriptID string, scriptReq *StartupScriptReq) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s", scriptPath, scriptID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, uri, scriptReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = s.client.DoWithContext(ctx, req, nil)
-	return err
+	return s.client.DoWithContext(ctx, req, nil)
 }
 
 // Delete the specified startup script from your account.
-func (s *StartupScriptServiceHandler) Delete(ctx context.Context, scriptID string) error {
+func (s *StartupScriptServiceHandler) Delete(ctx context.Context, scriptID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s", scriptPath, scriptID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = s.client.DoWithContext(ctx, req, nil)
-	return err
+	return s.client.DoWithContext(ctx, req, nil)
 }
 
 // List all the startup scripts associated with your Vultr account
-func (s *StartupScriptServiceHandler) List(ctx context.Context, options *ListOptions) ([]StartupScript, *Meta, *http.Response, error) { //nolint:dupl,lll
+func (s *StartupScriptServiceHandler) List(ctx context.Context, options *ListOptions) ([]StartupScript, *Meta, *Response, error) { //nolint:dupl,lll
 	req, err := s.client.NewRequest(ctx, http.MethodGet, scriptPath, nil)
 	if err != nil {
 		return nil, nil, nil, err
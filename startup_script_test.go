@@ -30,8 +30,8 @@ func TestStartupScriptServiceHandler_Create(t *testing.T) {
 
 	expected := &StartupScript{
 		ID:           "14356",
-		DateCreated:  "2020-07-07 18:52:56",
-		DateModified: "2020-07-07 18:59:54",
+		DateCreated:  timestamp("2020-07-07 18:52:56"),
+		DateModified: timestamp("2020-07-07 18:59:54"),
 		Name:         "govultr",
 		Type:         "boot",
 		Script:       "dGVzdGFwaXVwZGF0ZQ==",
@@ -59,8 +59,8 @@ func TestStartupScriptServiceHandler_GET(t *testing.T) {
 
 	expectedScript := &StartupScript{
 		ID:           "14350",
-		DateCreated:  "2020-06-08 17:58:10",
-		DateModified: "2020-06-08 17:59:54",
+		DateCreated:  timestamp("2020-06-08 17:58:10"),
+		DateModified: timestamp("2020-06-08 17:59:54"),
 		Name:         "govultr",
 		Type:         "pxe",
 		Script:       "dGVzdA==",
@@ -85,7 +85,7 @@ func TestStartupScriptServiceHandler_Update(t *testing.T) {
 		Script: "dGVzdA==",
 	}
 
-	err := client.StartupScript.Update(ctx, "1234", script)
+	_, err := client.StartupScript.Update(ctx, "1234", script)
 
 	if err != nil {
 		t.Errorf("StartupScript.Update returned error: %+v", err)
@@ -100,7 +100,7 @@ func TestStartupScriptServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.StartupScript.Delete(ctx, "1234")
+	_, err := client.StartupScript.Delete(ctx, "1234")
 
 	if err != nil {
 		t.Errorf("StartupScript.Delete returned %+v, expected %+v", err, nil)
@@ -125,8 +125,8 @@ func TestStartupScriptServiceHandler_List(t *testing.T) {
 	expectedScript := []StartupScript{
 		{
 			ID:           "14350",
-			DateCreated:  "2020-06-08 17:58:10",
-			DateModified: "2020-06-08 17:59:54",
+			DateCreated:  timestamp("2020-06-08 17:58:10"),
+			DateModified: timestamp("2020-06-08 17:59:54"),
 			Name:         "govultr",
 			Type:         "pxe",
 			Script:       "dGVzdA==",
@@ -0,0 +1,145 @@
+package govultr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+type streamKey struct{}
+
+// streaming reports whether the request was made by StreamList, whose response body is decoded
+// as it is read rather than buffered
+func streaming(ctx context.Context) bool {
+	stream, _ := ctx.Value(streamKey{}).(bool)
+	return stream
+}
+
+// StreamList sends a request to a list endpoint and decodes the items of the list one at a time,
+// calling fn with each of them, instead of buffering the whole response. The pagination
+// information is decoded into Response.Meta. An error returned by fn stops the decoding and is
+// returned as is.
+//
+// The items are read from the first array of the response, so any list endpoint can be
+// streamed, for instance a page of 500 instances:
+//
+//	req, err := client.NewRequest(ctx, http.MethodGet, "/v2/instances?per_page=500", nil)
+//	...
+//	res, err := govultr.StreamList(ctx, client, req, func(instance govultr.Instance) error {
+//		fmt.Println(instance.ID)
+//		return nil
+//	})
+//	...
+//	cursor := res.NextCursor()
+func StreamList[T any](ctx context.Context, c *Client, r *http.Request, fn func(T) error) (*Response, error) {
+	ctx = withRequestStats(context.WithValue(ctx, streamKey{}, true))
+	start := time.Now()
+
+	res, err := c.doer.Do(r.WithContext(ctx))
+	if err != nil {
+		if res != nil {
+			return newResponse(res, nil, requestStatsFrom(ctx), time.Since(start)), err
+		}
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, errors.New("middleware returned neither a response nor an error")
+	}
+
+	meta, err := decodeList(res.Body, fn)
+	res.Body.Close() //nolint:errcheck,gosec
+	res.Body = http.NoBody
+
+	return newResponse(res, meta, requestStatsFrom(ctx), time.Since(start)), err
+}
+
+// decodeList decodes a list response, calling fn with every item of its first array, and returns
+// its meta field
+func decodeList[T any](body io.Reader, fn func(T) error) (*Meta, error) {
+	dec := json.NewDecoder(body)
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, err
+	}
+
+	var meta *Meta
+	decoded := false
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return meta, err
+		}
+
+		if key == "meta" {
+			if err := dec.Decode(&meta); err != nil {
+				return meta, err
+			}
+			continue
+		}
+
+		token, err := dec.Token()
+		if err != nil {
+			return meta, err
+		}
+
+		if token != json.Delim('[') || decoded {
+			if err := skipValue(dec, token); err != nil {
+				return meta, err
+			}
+			continue
+		}
+
+		decoded = true
+		var item, zero T
+		for dec.More() {
+			item = zero
+			if err := dec.Decode(&item); err != nil {
+				return meta, err
+			}
+			if err := fn(item); err != nil {
+				return meta, err
+			}
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return meta, err
+		}
+	}
+
+	return meta, expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("unexpected %v in list response, expected %v", token, delim)
+	}
+	return nil
+}
+
+// skipValue skips the rest of the value starting with token
+func skipValue(dec *json.Decoder, token json.Token) error {
+	depth := 0
+	for {
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+
+		var err error
+		if token, err = dec.Token(); err != nil {
+			return err
+		}
+	}
+}
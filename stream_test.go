@@ -0,0 +1,174 @@
+package govultr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStreamList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Query().Get("per_page") != "2" {
+			t.Errorf("request query = %s, expected per_page=2", request.URL.RawQuery)
+		}
+		fmt.Fprint(writer, `{"instances":[{"id":"a","tags":["web"]},{"id":"b"}],"meta":{"total":3,"links":{"next":"bmV4dA==","prev":""}}}`)
+	})
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "/v2/instances?per_page=2", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	res, err := StreamList(ctx, client, req, func(instance Instance) error {
+		ids = append(ids, instance.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamList returned %+v", err)
+	}
+
+	if !reflect.DeepEqual(ids, []string{"a", "b"}) {
+		t.Errorf("StreamList decoded %v, expected [a b]", ids)
+	}
+	if res.StatusCode != http.StatusOK || res.Meta == nil || res.Meta.Total != 3 || res.NextCursor() != "bmV4dA==" {
+		t.Errorf("StreamList returned %+v with meta %+v", res, res.Meta)
+	}
+}
+
+func TestStreamList_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
+		fmt.Fprint(writer, `{"instances":[{"id":"a"},{"id":"b"}],"meta":{"total":2}}`)
+	})
+	mux.HandleFunc("/v2/bare-metals", func(writer http.ResponseWriter, request *http.Request) {
+		http.Error(writer, `{"error":"unauthorized","status":401}`, http.StatusUnauthorized)
+	})
+
+	stop := errors.New("stop")
+	calls := 0
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/v2/instances", nil)
+	_, err := StreamList(ctx, client, req, func(instance Instance) error {
+		calls++
+		return stop
+	})
+	if !errors.Is(err, stop) || calls != 1 {
+		t.Errorf("StreamList returned %+v after %d calls, expected the error of the callback after 1 call", err, calls)
+	}
+
+	req, _ = client.NewRequest(ctx, http.MethodGet, "/v2/bare-metals", nil)
+	_, err = StreamList(ctx, client, req, func(server BareMetalServer) error { return nil })
+	if !IsUnauthorized(err) {
+		t.Errorf("StreamList returned %+v, expected an unauthorized APIError", err)
+	}
+}
+
+func TestDecodeList(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		ids  []int
+		err  bool
+	}{
+		{"meta first", `{"meta":{"total":2},"plans":[{"id":1},{"id":2}]}`, []int{1, 2}, false},
+		{"other fields", `{"region":{"id":"ewr","nested":[[1],{"a":[]}]},"count":2,"plans":[{"id":1}],"other":[{"id":9}]}`, []int{1}, false},
+		{"empty", `{"plans":[],"meta":null}`, nil, false},
+		{"not an object", `[{"id":1}]`, nil, true},
+		{"invalid item", `{"plans":[{"id":"one"}]}`, nil, true},
+		{"truncated", `{"plans":[{"id":1},`, []int{1}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var ids []int
+			_, err := decodeList(strings.NewReader(test.body), func(item struct{ ID int }) error {
+				ids = append(ids, item.ID)
+				return nil
+			})
+
+			if (err != nil) != test.err {
+				t.Errorf("decodeList returned %+v", err)
+			}
+			if !reflect.DeepEqual(ids, test.ids) {
+				t.Errorf("decodeList decoded %v, expected %v", ids, test.ids)
+			}
+		})
+	}
+}
+
+// pageTransport answers every request with the same page, without a network round trip
+type pageTransport []byte
+
+func (p pageTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(p)),
+		Request:    req,
+	}, nil
+}
+
+func benchmarkPage(b *testing.B) *Client {
+	instances := make([]Instance, 500)
+	for i := range instances {
+		instances[i] = Instance{
+			ID:        fmt.Sprintf("cb676a46-66fd-4dfb-b839-%012d", i),
+			Os:        "Ubuntu 22.04 LTS x64",
+			RAM:       1024,
+			Disk:      25,
+			MainIP:    "192.0.2.10",
+			VCPUCount: 1,
+			Region:    "ewr",
+			Plan:      "vc2-1c-1gb",
+			Label:     fmt.Sprintf("web-%d", i),
+			Tags:      []string{"web", "production"},
+			Features:  []string{"ipv6", "auto_backups"},
+		}
+	}
+
+	page, err := json.Marshal(instancesBase{Instances: instances, Meta: &Meta{Total: 500, Links: &Links{}}})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	c, err := New(WithHTTPClient(&http.Client{Transport: pageTransport(page)}), WithRateLimiter(nil))
+	if err != nil {
+		b.Fatal(err)
+	}
+	return c
+}
+
+func BenchmarkListDecode(b *testing.B) {
+	c := benchmarkPage(b)
+
+	b.Run("DoWithContext", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			req, _ := c.NewRequest(ctx, http.MethodGet, "/v2/instances", nil)
+			if _, err := c.DoWithContext(ctx, req, new(instancesBase)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("StreamList", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			req, _ := c.NewRequest(ctx, http.MethodGet, "/v2/instances", nil)
+			if _, err := StreamList(ctx, c, req, func(Instance) error { return nil }); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package govultr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// timestampLayouts are the formats dates are returned in by the API
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02",
+	"01-02-2006",
+}
+
+// Timestamp is a date returned by the API. It embeds the parsed time, in UTC, and keeps the
+// original string, which it is marshalled back to. Dates in an unknown format are kept as they
+// are with a zero time rather than failing the whole response.
+type Timestamp struct {
+	time.Time
+	raw string
+}
+
+// NewTimestamp returns a Timestamp for t, formatted as RFC 3339
+func NewTimestamp(t time.Time) Timestamp {
+	t = t.UTC()
+	return Timestamp{Time: t, raw: t.Format(time.RFC3339)}
+}
+
+// ParseTimestamp parses a date in one of the formats used by the API. The returned Timestamp
+// keeps s when it cannot be parsed.
+func ParseTimestamp(s string) (Timestamp, error) {
+	if s == "" {
+		return Timestamp{}, nil
+	}
+
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return Timestamp{Time: t.UTC(), raw: s}, nil
+		}
+	}
+	return Timestamp{raw: s}, fmt.Errorf("unable to parse timestamp %q", s)
+}
+
+// Raw returns the date as returned by the API
+func (t Timestamp) Raw() string {
+	return t.raw
+}
+
+// IsZero reports whether the Timestamp holds no date at all
+func (t Timestamp) IsZero() bool {
+	return t.Time.IsZero() && t.raw == ""
+}
+
+// String returns the date as returned by the API, or formatted as RFC 3339 when it was built from
+// a time.Time
+func (t Timestamp) String() string {
+	if t.raw != "" || t.Time.IsZero() {
+		return t.raw
+	}
+	return t.Time.Format(time.RFC3339)
+}
+
+// MarshalText returns the date as returned by the API
+func (t Timestamp) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText parses a date, see ParseTimestamp. It never fails.
+func (t *Timestamp) UnmarshalText(data []byte) error {
+	*t, _ = ParseTimestamp(string(data))
+	return nil
+}
+
+// MarshalJSON returns the date as returned by the API, as a JSON string
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON parses a date string, see ParseTimestamp. null is accepted too.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*t = Timestamp{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("unable to parse timestamp %s: %w", data, err)
+	}
+	return t.UnmarshalText([]byte(s))
+}
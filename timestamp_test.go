@@ -0,0 +1,99 @@
+package govultr
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+)
+
+// timestamp parses a date of a fixture, keeping it as it is when it is not in a known format
+func timestamp(raw string) Timestamp {
+	t, _ := ParseTimestamp(raw)
+	return t
+}
+
+func TestParseTimestamp(t *testing.T) {
+	expected := time.Date(2020, 10, 10, 1, 56, 20, 0, time.UTC)
+
+	for _, raw := range []string{
+		"2020-10-10T01:56:20+00:00",
+		"2020-10-10T03:56:20+02:00",
+		"2020-10-10T01:56:20Z",
+		"2020-10-10 01:56:20",
+		"2020-10-10T01:56:20",
+	} {
+		ts, err := ParseTimestamp(raw)
+		if err != nil {
+			t.Errorf("ParseTimestamp(%q) returned %+v", raw, err)
+			continue
+		}
+		if !ts.Equal(expected) || ts.Location() != time.UTC || ts.String() != raw {
+			t.Errorf("ParseTimestamp(%q) = %v (%s), expected %v", raw, ts.Time, ts, expected)
+		}
+	}
+
+	if ts, err := ParseTimestamp("2020-10-10"); err != nil || !ts.Equal(time.Date(2020, 10, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ParseTimestamp of a date returned %v, %+v", ts.Time, err)
+	}
+
+	ts, err := ParseTimestamp("2020-07-0913:53:34")
+	if err == nil || !ts.Time.IsZero() || ts.IsZero() || ts.Raw() != "2020-07-0913:53:34" {
+		t.Errorf("ParseTimestamp of an unknown format returned %+v, %+v", ts, err)
+	}
+}
+
+func TestTimestamp_JSON(t *testing.T) {
+	for _, raw := range []string{
+		`{"date_created":"2020-10-10 01:56:20"}`,
+		`{"date_created":"2020-07-0913:53:34"}`,
+		`{"date_created":""}`,
+	} {
+		snapshot := new(Snapshot)
+		if err := json.Unmarshal([]byte(raw), snapshot); err != nil {
+			t.Fatalf("json.Unmarshal(%s) returned %+v", raw, err)
+		}
+
+		data, err := json.Marshal(struct {
+			DateCreated Timestamp `json:"date_created"`
+		}{snapshot.DateCreated})
+		if err != nil {
+			t.Fatalf("json.Marshal returned %+v", err)
+		}
+		if string(data) != raw {
+			t.Errorf("round trip of %s returned %s", raw, data)
+		}
+	}
+
+	snapshot := new(Snapshot)
+	if err := json.Unmarshal([]byte(`{"date_created":null}`), snapshot); err != nil || !snapshot.DateCreated.IsZero() {
+		t.Errorf("json.Unmarshal of null returned %+v, %+v", snapshot.DateCreated, err)
+	}
+	if err := json.Unmarshal([]byte(`{"date_created":12}`), snapshot); err == nil {
+		t.Error("json.Unmarshal of a number returned no error")
+	}
+
+	domain, err := json.Marshal(Domain{Domain: "example.com"})
+	if err != nil || string(domain) != `{"domain":"example.com"}` {
+		t.Errorf("json.Marshal of a domain without a creation date returned %s, %+v", domain, err)
+	}
+}
+
+func TestTimestamp_Sort(t *testing.T) {
+	snapshots := []Snapshot{
+		{ID: "b", DateCreated: timestamp("2021-07-13T15:42:21+00:00")},
+		{ID: "c", DateCreated: NewTimestamp(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))},
+		{ID: "a", DateCreated: timestamp("2017-04-12 18:45:41")},
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].DateCreated.Before(snapshots[j].DateCreated.Time)
+	})
+
+	if snapshots[0].ID != "a" || snapshots[1].ID != "b" || snapshots[2].ID != "c" {
+		t.Errorf("sorted snapshots = %+v", snapshots)
+	}
+	if s := snapshots[2].DateCreated.String(); s != "2022-01-01T00:00:00Z" {
+		t.Errorf("NewTimestamp String() = %s, expected %s", s, "2022-01-01T00:00:00Z")
+	}
+}
@@ -13,11 +13,11 @@ const path = "/v2/users"
 // UserService is the interface to interact with the user management endpoints on the Vultr API
 // Link : https://www.vultr.com/api/#tag/users
 type UserService interface { //nolint:dupl
-	Create(ctx context.Context, userCreate *UserReq) (*User, *http.Response, error)
-	Get(ctx context.Context, userID string) (*User, *http.Response, error)
-	Update(ctx context.Context, userID string, userReq *UserReq) error
-	Delete(ctx context.Context, userID string) error
-	List(ctx context.Context, options *ListOptions) ([]User, *Meta, *http.Response, error)
+	Create(ctx context.Context, userCreate *UserReq) (*User, *Response, error)
+	Get(ctx context.Context, userID string) (*User, *Response, error)
+	Update(ctx context.Context, userID string, userReq *UserReq) (*Response, error)
+	Delete(ctx context.Context, userID string) (*Response, error)
+	List(ctx context.Context, options *ListOptions) ([]User, *Meta, *Response, error)
 }
 
 var _ UserService = &UserServiceHandler{}
@@ -46,6 +46,18 @@ type UserReq struct {
 	Password   string   `json:"password,omitempty"`
 }
 
+// Validate checks that the email, name and password of a new user are given
+func (r UserReq) Validate() error {
+	v := new(validation)
+	v.required("email", r.Email, "name", r.Name, "password", r.Password)
+	return v.err()
+}
+
+// validateUpdate accepts any update, every field can be left unchanged
+func (r UserReq) validateUpdate() error {
+	return nil
+}
+
 type usersBase struct {
 	Users []User `json:"users"`
 	Meta  *Meta  `json:"meta"`
@@ -56,7 +68,7 @@ type userBase struct {
 }
 
 // Create will add the specified user to your Vultr account
-func (u *UserServiceHandler) Create(ctx context.Context, userCreate *UserReq) (*User, *http.Response, error) {
+func (u *UserServiceHandler) Create(ctx context.Context, userCreate *UserReq) (*User, *Response, error) {
 	req, err := u.client.NewRequest(ctx, http.MethodPost, path, userCreate)
 	if err != nil {
 		return nil, nil, err
@@ -72,7 +84,7 @@ func (u *UserServiceHandler) Create(ctx context.Context, userCreate *UserReq) (*
 }
 
 // Get will retrieve a specific user account
-func (u *UserServiceHandler) Get(ctx context.Context, userID string) (*User, *http.Response, error) {
+func (u *UserServiceHandler) Get(ctx context.Context, userID string) (*User, *Response, error) {
 	uri := fmt.Sprintf("%s/%s", path, userID)
 
 	req, err := u.client.NewRequest(ctx, http.MethodGet, uri, nil)
@@ -90,32 +102,30 @@ func (u *UserServiceHandler) Get(ctx context.Context, userID string) (*User, *ht
 }
 
 // Update will update the given user. Empty strings will be ignored.
-func (u *UserServiceHandler) Update(ctx context.Context, userID string, userReq *UserReq) error {
+func (u *UserServiceHandler) Update(ctx context.Context, userID string, userReq *UserReq) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s", path, userID)
 	req, err := u.client.NewRequest(ctx, http.MethodPatch, uri, userReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = u.client.DoWithContext(ctx, req, nil)
-	return err
+	return u.client.DoWithContext(ctx, req, nil)
 }
 
 // Delete will remove the specified user from your Vultr account
-func (u *UserServiceHandler) Delete(ctx context.Context, userID string) error {
+func (u *UserServiceHandler) Delete(ctx context.Context, userID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s", path, userID)
 
 	req, err := u.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = u.client.DoWithContext(ctx, req, nil)
-	return err
+	return u.client.DoWithContext(ctx, req, nil)
 }
 
 // List will list all the users associated with your Vultr account
-func (u *UserServiceHandler) List(ctx context.Context, options *ListOptions) ([]User, *Meta, *http.Response, error) { //nolint:dupl
+func (u *UserServiceHandler) List(ctx context.Context, options *ListOptions) ([]User, *Meta, *Response, error) { //nolint:dupl
 	req, err := u.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, nil, nil, err
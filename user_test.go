@@ -52,7 +52,7 @@ func TestUserServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.User.Delete(ctx, "123abc")
+	_, err := client.User.Delete(ctx, "123abc")
 
 	if err != nil {
 		t.Errorf("User.Delete returned %+v, expected %+v", err, nil)
@@ -140,7 +140,7 @@ func TestUserServiceHandler_Update(t *testing.T) {
 		ACL:        []string{"support"},
 	}
 
-	err := client.User.Update(ctx, "abc123", user)
+	_, err := client.User.Update(ctx, "abc123", user)
 
 	if err != nil {
 		t.Errorf("User.Update returned error: %+v", err)
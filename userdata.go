@@ -0,0 +1,225 @@
+package govultr
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MaxUserDataSize is the largest user data, before base64 encoding, accepted by the API
+const MaxUserDataSize = 64 << 10
+
+// ErrUserDataTooLarge is returned when user data is larger than MaxUserDataSize
+var ErrUserDataTooLarge = errors.New("user data is too large")
+
+// User data content types understood by cloud-init
+const (
+	UserDataCloudConfig = "text/cloud-config"
+	UserDataShellScript = "text/x-shellscript"
+)
+
+// EncodeUserData returns data base64 encoded, as expected by the UserData fields of requests
+func EncodeUserData(data []byte) (string, error) {
+	if err := checkUserDataSize(len(data)); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+func checkUserDataSize(size int) error {
+	if size > MaxUserDataSize {
+		return fmt.Errorf("%w: %d bytes, the limit is %d", ErrUserDataTooLarge, size, MaxUserDataSize)
+	}
+	return nil
+}
+
+// Decode returns the user data decoded from base64
+func (u *UserData) Decode() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(u.Data)
+}
+
+// SetUserData sets UserData to data, base64 encoded
+func (r *InstanceCreateReq) SetUserData(data []byte) error {
+	encoded, err := EncodeUserData(data)
+	if err != nil {
+		return err
+	}
+	r.UserData = encoded
+	return nil
+}
+
+// SetUserData sets UserData to data, base64 encoded
+func (r *InstanceUpdateReq) SetUserData(data []byte) error {
+	encoded, err := EncodeUserData(data)
+	if err != nil {
+		return err
+	}
+	r.UserData = NewOptional(encoded)
+	return nil
+}
+
+// SetUserData sets UserData to data, base64 encoded
+func (r *BareMetalCreate) SetUserData(data []byte) error {
+	encoded, err := EncodeUserData(data)
+	if err != nil {
+		return err
+	}
+	r.UserData = encoded
+	return nil
+}
+
+// SetUserData sets UserData to data, base64 encoded
+func (r *BareMetalUpdate) SetUserData(data []byte) error {
+	encoded, err := EncodeUserData(data)
+	if err != nil {
+		return err
+	}
+	r.UserData = NewOptional(encoded)
+	return nil
+}
+
+// userData checks that user data, when given, is base64 encoded and within MaxUserDataSize
+func (v *validation) userData(field, data string) {
+	if data == "" {
+		return
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	switch {
+	case err != nil:
+		v.add(field, "is not base64 encoded, use SetUserData or EncodeUserData")
+	case len(decoded) > MaxUserDataSize:
+		v.add(field, "is %d bytes, the limit is %d", len(decoded), MaxUserDataSize)
+	}
+}
+
+// CloudConfig builds a cloud-config user data document. Only the most common modules are
+// covered, Extra is merged into the document for the others.
+type CloudConfig struct {
+	Hostname       string            `yaml:"hostname,omitempty"`
+	Users          []CloudConfigUser `yaml:"users,omitempty"`
+	PackageUpdate  bool              `yaml:"package_update,omitempty"`
+	PackageUpgrade bool              `yaml:"package_upgrade,omitempty"`
+	Packages       []string          `yaml:"packages,omitempty"`
+	WriteFiles     []CloudConfigFile `yaml:"write_files,omitempty"`
+	// RunCmd are shell commands run on first boot, in order
+	RunCmd []string `yaml:"runcmd,omitempty"`
+	// Extra holds other modules, keyed by name
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+// CloudConfigUser is a user created by cloud-init
+type CloudConfigUser struct {
+	Name              string   `yaml:"name"`
+	Groups            []string `yaml:"groups,omitempty"`
+	Shell             string   `yaml:"shell,omitempty"`
+	Sudo              string   `yaml:"sudo,omitempty"`
+	SSHAuthorizedKeys []string `yaml:"ssh_authorized_keys,omitempty"`
+	LockPasswd        *bool    `yaml:"lock_passwd,omitempty"`
+}
+
+// CloudConfigFile is a file written by cloud-init
+type CloudConfigFile struct {
+	Path        string `yaml:"path"`
+	Content     string `yaml:"content"`
+	Owner       string `yaml:"owner,omitempty"`
+	Permissions string `yaml:"permissions,omitempty"`
+	Append      bool   `yaml:"append,omitempty"`
+}
+
+// AddUser adds a user and returns c, to chain calls
+func (c *CloudConfig) AddUser(user CloudConfigUser) *CloudConfig {
+	c.Users = append(c.Users, user)
+	return c
+}
+
+// AddPackages adds packages to install and returns c, to chain calls
+func (c *CloudConfig) AddPackages(packages ...string) *CloudConfig {
+	c.Packages = append(c.Packages, packages...)
+	return c
+}
+
+// AddFile adds a file to write and returns c, to chain calls
+func (c *CloudConfig) AddFile(path, content, permissions string) *CloudConfig {
+	c.WriteFiles = append(c.WriteFiles, CloudConfigFile{Path: path, Content: content, Permissions: permissions})
+	return c
+}
+
+// AddCommands adds commands to run on first boot and returns c, to chain calls
+func (c *CloudConfig) AddCommands(commands ...string) *CloudConfig {
+	c.RunCmd = append(c.RunCmd, commands...)
+	return c
+}
+
+// Bytes renders the document, starting with the #cloud-config header
+func (c *CloudConfig) Bytes() ([]byte, error) {
+	body, err := yaml.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("#cloud-config\n"), body...), nil
+}
+
+// Part returns the document as a part of multipart user data
+func (c *CloudConfig) Part() (UserDataPart, error) {
+	content, err := c.Bytes()
+	if err != nil {
+		return UserDataPart{}, err
+	}
+	return UserDataPart{ContentType: UserDataCloudConfig, Filename: "cloud-config.yaml", Content: content}, nil
+}
+
+// UserDataPart is a part of multipart user data
+type UserDataPart struct {
+	// ContentType tells cloud-init how to handle the part, such as UserDataCloudConfig or
+	// UserDataShellScript
+	ContentType string
+	Filename    string
+	Content     []byte
+}
+
+// MultipartUserData combines parts into a MIME multipart document, so a cloud-config and shell
+// scripts can be given to the same instance. It fails with ErrUserDataTooLarge when the document
+// is larger than MaxUserDataSize.
+func MultipartUserData(parts ...UserDataPart) ([]byte, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+
+	for i, part := range parts {
+		if part.ContentType == "" {
+			return nil, fmt.Errorf("user data part %d has no content type", i)
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.ContentType+`; charset="utf-8"`)
+		header.Set("MIME-Version", "1.0")
+		if part.Filename != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", part.Filename))
+		}
+
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := pw.Write(part.Content); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	var document bytes.Buffer
+	fmt.Fprintf(&document, "Content-Type: multipart/mixed; boundary=%q\r\nMIME-Version: 1.0\r\n\r\n", w.Boundary())
+	document.Write(body.Bytes())
+
+	if err := checkUserDataSize(document.Len()); err != nil {
+		return nil, err
+	}
+	return document.Bytes(), nil
+}
@@ -0,0 +1,145 @@
+package govultr
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+func TestUserData(t *testing.T) {
+	req := &InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 387}
+	if err := req.SetUserData([]byte("echo Hello World")); err != nil {
+		t.Fatalf("SetUserData returned %+v", err)
+	}
+	if req.UserData != "ZWNobyBIZWxsbyBXb3JsZA==" {
+		t.Errorf("SetUserData set %q", req.UserData)
+	}
+
+	decoded, err := (&UserData{Data: req.UserData}).Decode()
+	if err != nil || string(decoded) != "echo Hello World" {
+		t.Errorf("UserData.Decode returned %q, %+v", decoded, err)
+	}
+
+	update := &InstanceUpdateReq{}
+	if err := update.SetUserData(bytes.Repeat([]byte("a"), MaxUserDataSize+1)); !errors.Is(err, ErrUserDataTooLarge) {
+		t.Errorf("SetUserData returned %+v, expected ErrUserDataTooLarge", err)
+	}
+	if update.UserData.IsSet() {
+		t.Errorf("SetUserData set user data larger than the limit")
+	}
+
+	req.UserData = "echo Hello World"
+	if err := req.Validate(); err == nil || !strings.Contains(err.Error(), "user_data: is not base64 encoded") {
+		t.Errorf("Validate returned %+v, expected an error about user_data", err)
+	}
+}
+
+func TestUserData_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/", func(writer http.ResponseWriter, request *http.Request) {
+		t.Errorf("an invalid request was sent to %s", request.URL.Path)
+	})
+
+	var validationErr *ValidationError
+	_, _, err := client.Instance.Update(ctx, "i-1", &InstanceUpdateReq{UserData: NewOptional("echo Hello World")})
+	if !errors.As(err, &validationErr) || validationErr.Field("user_data") == nil {
+		t.Errorf("Instance.Update returned %+v, expected an error about user_data", err)
+	}
+
+	tooLarge := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte("a"), MaxUserDataSize+1))
+	_, _, err = client.BareMetalServer.Update(ctx, "bm-1", &BareMetalUpdate{UserData: NewOptional(tooLarge)})
+	if !errors.As(err, &validationErr) || validationErr.Field("user_data") == nil {
+		t.Errorf("BareMetalServer.Update returned %+v, expected an error about user_data", err)
+	}
+
+	if err := (InstanceUpdateReq{UserData: Null[string]()}).validateUpdate(); err != nil {
+		t.Errorf("InstanceUpdateReq.validateUpdate returned %+v for cleared user data", err)
+	}
+}
+
+func TestCloudConfig(t *testing.T) {
+	config := &CloudConfig{Hostname: "web-1", PackageUpdate: true, Extra: map[string]interface{}{"timezone": "UTC"}}
+	config.
+		AddUser(CloudConfigUser{Name: "deploy", Groups: []string{"sudo"}, SSHAuthorizedKeys: []string{"ssh-ed25519 AAAA deploy"}}).
+		AddPackages("nginx").
+		AddFile("/etc/motd", "managed by govultr\n", "0644").
+		AddCommands("systemctl enable --now nginx")
+
+	document, err := config.Bytes()
+	if err != nil {
+		t.Fatalf("CloudConfig.Bytes returned %+v", err)
+	}
+
+	expected := `#cloud-config
+hostname: web-1
+users:
+    - name: deploy
+      groups:
+        - sudo
+      ssh_authorized_keys:
+        - ssh-ed25519 AAAA deploy
+package_update: true
+packages:
+    - nginx
+write_files:
+    - path: /etc/motd
+      content: |
+        managed by govultr
+      permissions: "0644"
+runcmd:
+    - systemctl enable --now nginx
+timezone: UTC
+`
+	if string(document) != expected {
+		t.Errorf("CloudConfig.Bytes returned\n%s\nexpected\n%s", document, expected)
+	}
+}
+
+func TestMultipartUserData(t *testing.T) {
+	config, err := (&CloudConfig{Packages: []string{"nginx"}}).Part()
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := UserDataPart{ContentType: UserDataShellScript, Filename: "setup.sh", Content: []byte("#!/bin/sh\necho ready\n")}
+
+	document, err := MultipartUserData(config, script)
+	if err != nil {
+		t.Fatalf("MultipartUserData returned %+v", err)
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(document))
+	if err != nil {
+		t.Fatalf("MultipartUserData returned an invalid MIME document: %+v", err)
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/mixed" {
+		t.Fatalf("MultipartUserData returned a %s document", mediaType)
+	}
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	for _, expected := range []UserDataPart{config, script} {
+		part, err := reader.NextPart()
+		if err != nil {
+			t.Fatalf("MultipartUserData returned too few parts: %+v", err)
+		}
+		content, _ := io.ReadAll(part)
+		if !strings.HasPrefix(part.Header.Get("Content-Type"), expected.ContentType) || part.FileName() != expected.Filename ||
+			!bytes.Equal(content, expected.Content) {
+			t.Errorf("MultipartUserData returned part %v %q, expected %+v", part.Header, content, expected)
+		}
+	}
+
+	large := UserDataPart{ContentType: UserDataShellScript, Content: bytes.Repeat([]byte("#"), MaxUserDataSize)}
+	if _, err := MultipartUserData(large); !errors.Is(err, ErrUserDataTooLarge) {
+		t.Errorf("MultipartUserData returned %+v, expected ErrUserDataTooLarge", err)
+	}
+}
@@ -0,0 +1,193 @@
+package govultr
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Validator is implemented by the request structs checked before they are sent. Client.NewRequest
+// validates request bodies implementing it, so invalid requests fail without a round trip.
+type Validator interface {
+	Validate() error
+}
+
+// updateValidator is implemented by the request structs of update calls, including those shared with
+// a create call. The fields required on create are optional on update, so NewRequest checks PUT and
+// PATCH bodies with validateUpdate instead of Validate.
+type updateValidator interface {
+	validateUpdate() error
+}
+
+// FieldError describes an invalid field of a request. Field is the JSON name of the field.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Error returns the field name and the problem found with it
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationError is returned by Validate, and by the service methods, when a request is invalid.
+// It lists every invalid field. Use errors.As to retrieve it.
+type ValidationError struct {
+	Fields []*FieldError
+}
+
+// Error lists the invalid fields
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		messages[i] = f.Error()
+	}
+	return "invalid request: " + strings.Join(messages, "; ")
+}
+
+// Field returns the error of the named field, or nil when the field is valid
+func (e *ValidationError) Field(name string) *FieldError {
+	for _, f := range e.Fields {
+		if f.Field == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// validation collects the invalid fields of a request
+type validation struct {
+	fields []*FieldError
+}
+
+func (v *validation) add(field, format string, args ...interface{}) {
+	v.fields = append(v.fields, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// required reports the fields, given as alternating names and values, that are empty
+func (v *validation) required(fields ...string) {
+	for i := 0; i+1 < len(fields); i += 2 {
+		if fields[i+1] == "" {
+			v.add(fields[i], "is required")
+		}
+	}
+}
+
+// url checks that a URL, when given, is an absolute http or https URL
+func (v *validation) url(field, rawURL string) {
+	if rawURL == "" {
+		return
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.add(field, "%q is not an http or https URL", rawURL)
+	}
+}
+
+// exactlyOne checks that exactly one of the named fields is set
+func (v *validation) exactlyOne(names []string, set ...bool) {
+	count := 0
+	for _, s := range set {
+		if s {
+			count++
+		}
+	}
+
+	switch {
+	case count == 0:
+		v.add(names[0], "one of %s is required", strings.Join(names, ", "))
+	case count > 1:
+		var given []string
+		for i, s := range set {
+			if s {
+				given = append(given, names[i])
+			}
+		}
+		v.add(given[0], "only one of %s can be given, got %s", strings.Join(names, ", "), strings.Join(given, " and "))
+	}
+}
+
+// hostname checks that a hostname, when given, follows RFC 1123
+func (v *validation) hostname(field, hostname string) {
+	if hostname == "" {
+		return
+	}
+
+	if len(hostname) > 253 {
+		v.add(field, "%q is longer than 253 characters", hostname)
+		return
+	}
+
+	for _, label := range strings.Split(strings.TrimSuffix(hostname, "."), ".") {
+		if !validHostnameLabel(label) {
+			v.add(field, "%q is not a valid hostname: labels must be 1 to 63 letters, digits or hyphens, "+
+				"and can not start or end with a hyphen", hostname)
+			return
+		}
+	}
+}
+
+func validHostnameLabel(label string) bool {
+	if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+
+	for _, c := range label {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// portRange checks that a port, when given, is a port or a range of ports such as 8000:8080
+func (v *validation) portRange(field, port string) {
+	if port == "" {
+		return
+	}
+
+	from, to, isRange := strings.Cut(port, ":")
+	if !isRange {
+		to = from
+	}
+
+	first, err1 := strconv.Atoi(from)
+	last, err2 := strconv.Atoi(to)
+	switch {
+	case err1 != nil || err2 != nil:
+		v.add(field, "%q is not a port or a range of ports such as 8000:8080", port)
+	case first < 1 || last > 65535:
+		v.add(field, "%q is outside of 1-65535", port)
+	case first > last:
+		v.add(field, "%q starts after it ends", port)
+	}
+}
+
+// network checks that a subnet is the network address of a CIDR with the given mask
+func (v *validation) network(field, subnet string, maskField string, mask, bits int) {
+	ip := net.ParseIP(subnet)
+	if ip == nil || (bits == 32) != (ip.To4() != nil) {
+		v.add(field, "%q is not an IPv%d address", subnet, map[int]int{32: 4, 128: 6}[bits])
+		return
+	}
+
+	if mask < 0 || mask > bits {
+		v.add(maskField, "%d is outside of 0-%d", mask, bits)
+		return
+	}
+
+	_, network, _ := net.ParseCIDR(fmt.Sprintf("%s/%d", subnet, mask))
+	if !network.IP.Equal(ip) {
+		v.add(field, "%s/%d is not a network address, did you mean %s?", subnet, mask, network)
+	}
+}
+
+func (v *validation) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: v.fields}
+}
@@ -0,0 +1,126 @@
+package govultr
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    Validator
+		fields []string
+	}{
+		{"instance", InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 387, Hostname: "web-1.example.com"}, nil},
+		{"instance without source", InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb"}, []string{"os_id"}},
+		{"instance with two sources", InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 387, SnapshotID: "5359435d"}, []string{"os_id"}},
+		{"instance with invalid hostname", InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", AppID: 1, Hostname: "-web_1"}, []string{"hostname"}},
+		{"instance without region and plan", InstanceCreateReq{ImageID: "app"}, []string{"region", "plan"}},
+		{"bare metal", BareMetalCreate{Region: "ewr", Plan: "vbm-4c-32gb", AppID: 1, ImageID: "app"}, []string{"app_id"}},
+		{"monthly backups", BackupScheduleReq{Type: BackupScheduleMonthly, Dom: 28}, nil},
+		{"monthly backups on the 31st", BackupScheduleReq{Type: BackupScheduleMonthly, Dom: 31}, []string{"dom"}},
+		{"backups", BackupScheduleReq{Type: "hourly", Hour: IntToIntPtr(24)}, []string{"type", "hour"}},
+		{"vpc", VPCReq{Region: "ewr", V4Subnet: "10.99.0.0", V4SubnetMask: 24}, nil},
+		{"vpc with host address", VPCReq{Region: "ewr", V4Subnet: "10.99.0.1", V4SubnetMask: 24}, []string{"v4_subnet"}},
+		{"vpc with IPv6 subnet", VPCReq{Region: "ewr", V4Subnet: "2001:db8::", V4SubnetMask: 24}, []string{"v4_subnet"}},
+		{"firewall rule", FirewallRuleReq{IPType: IPTypeV6, Protocol: FirewallProtocolTCP, Subnet: "2001:db8::", SubnetSize: 32, Port: "8000:8080"}, nil},
+		{"firewall rule from a source", FirewallRuleReq{IPType: IPTypeV4, Protocol: FirewallProtocolUDP, Source: "cloudflare", Port: "53"}, nil},
+		{"firewall rule with reversed ports", FirewallRuleReq{IPType: IPTypeV4, Protocol: FirewallProtocolTCP, Subnet: "0.0.0.0", Port: "8080:8000"}, []string{"port"}},
+		{"firewall rule with invalid port", FirewallRuleReq{IPType: IPTypeV4, Protocol: FirewallProtocolTCP, Subnet: "0.0.0.0", Port: "70000"}, []string{"port"}},
+		{"icmp rule with port", FirewallRuleReq{IPType: IPTypeV4, Protocol: FirewallProtocolICMP, Subnet: "0.0.0.0", Port: "80"}, []string{"port"}},
+		{"firewall rule without subnet", FirewallRuleReq{IPType: "ipv4", Protocol: "TCP"}, []string{"ip_type", "protocol", "subnet"}},
+		{"database", DatabaseCreateReq{DatabaseEngine: DatabaseEnginePG, Region: "ewr", Plan: "vultr-dbaas-startup-cc-1-55-2", Label: "db"}, nil},
+		{"database with unsupported engine", DatabaseCreateReq{DatabaseEngine: "mongodb", Region: "ewr", Plan: "vultr-dbaas-startup-cc-1-55-2", Label: "db"}, []string{"database_engine"}},
+		{"cluster", ClusterReq{Region: "ewr", Version: "v1.29.1+1", NodePools: []NodePoolReq{{Label: "pool", Plan: "vc2-1c-2gb"}}}, []string{"node_pools[0].node_quantity"}},
+		{"load balancer", LoadBalancerReq{BalancingAlgorithm: "random", ForwardingRules: []ForwardingRule{{FrontendProtocol: LBProtocolHTTP, FrontendPort: 80, BackendProtocol: LBProtocolHTTP}}}, []string{"balancing_algorithm", "forwarding_rules[0].backend_port"}},
+		{"domain", DomainReq{Domain: "example.com", IP: "192.0.2.1", DNSSec: "enabled"}, nil},
+		{"domain with invalid ip and dnssec", DomainReq{Domain: "example.com", IP: "192.0.2", DNSSec: "on"}, []string{"ip", "dns_sec"}},
+		{"domain without name", DomainReq{}, []string{"domain"}},
+		{"domain record", DomainRecordReq{Type: "A", Data: "192.0.2.1"}, nil},
+		{"domain record without type and data", DomainRecordReq{Name: "www", TTL: -1}, []string{"type", "data", "ttl"}},
+		{"ssh key", SSHKeyReq{Name: "key"}, []string{"ssh_key"}},
+		{"startup script", StartupScriptReq{Name: "script", Type: "cloud-init"}, []string{"type", "script"}},
+		{"user", UserReq{Email: "jo@example.com"}, []string{"name", "password"}},
+		{"firewall group", FirewallGroupReq{}, nil},
+		{"snapshot", SnapshotReq{Description: "nightly"}, []string{"instance_id"}},
+		{"snapshot from url", SnapshotURLReq{URL: "ftp://example.com/disk.raw"}, []string{"url"}},
+		{"iso", ISOReq{URL: "https://example.com/alpine.iso"}, nil},
+		{"iso without url", ISOReq{}, []string{"url"}},
+		{"database user", DatabaseUserCreateReq{Password: "secret"}, []string{"username"}},
+		{"logical database", DatabaseDBCreateReq{}, []string{"name"}},
+		{"connection pool", DatabaseConnectionPoolCreateReq{Name: "pool", Database: "db", Username: "user", Mode: "transaction", Size: 5}, nil},
+		{"connection pool with unknown mode", DatabaseConnectionPoolCreateReq{Name: "pool", Database: "db", Username: "user", Mode: "pooled"}, []string{"mode", "size"}},
+		{"reinstall", ReinstallReq{Hostname: "web_1"}, []string{"hostname"}},
+		{"cluster upgrade", ClusterUpgradeReq{}, []string{"upgrade_version"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.req.Validate()
+			if test.fields == nil {
+				if err != nil {
+					t.Errorf("Validate returned %+v", err)
+				}
+				return
+			}
+
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Validate returned %+v, expected a *ValidationError", err)
+			}
+			if len(validationErr.Fields) != len(test.fields) {
+				t.Errorf("Validate returned %v, expected errors for %v", err, test.fields)
+			}
+			for _, field := range test.fields {
+				if validationErr.Field(field) == nil {
+					t.Errorf("Validate returned %v, expected an error for %s", err, field)
+				}
+			}
+		})
+	}
+}
+
+func TestValidate_BeforeRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances", func(writer http.ResponseWriter, request *http.Request) {
+		t.Error("an invalid request was sent")
+	})
+
+	_, _, err := client.Instance.Create(ctx, &InstanceCreateReq{Region: "ewr", OsID: 387, ISOID: "cb676b1a"})
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Instance.Create returned %+v, expected a *ValidationError", err)
+	}
+
+	expected := "invalid request: plan: is required; os_id: only one of os_id, iso_id, app_id, image_id, snapshot_id can be given, got os_id and iso_id"
+	if err.Error() != expected {
+		t.Errorf("Instance.Create returned %q, expected %q", err, expected)
+	}
+
+	if _, err := client.NewRequest(ctx, http.MethodPost, "/v2/instances", (*InstanceCreateReq)(nil)); err != nil && strings.Contains(err.Error(), "invalid request") {
+		t.Errorf("NewRequest validated a nil request: %+v", err)
+	}
+}
+
+func TestValidate_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/ssh-keys/key", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.SSHKey.Update(ctx, "key", &SSHKeyReq{Name: "renamed"}); err != nil {
+		t.Errorf("SSHKey.Update returned %+v, expected the fields required on create to be optional", err)
+	}
+
+	var validationErr *ValidationError
+	if _, err := client.FirewallGroup.Update(ctx, "group", &FirewallGroupReq{}); !errors.As(err, &validationErr) {
+		t.Errorf("FirewallGroup.Update returned %+v, expected a *ValidationError", err)
+	}
+}
@@ -13,11 +13,11 @@ const vpcPath = "/v2/vpcs"
 // VPCService is the interface to interact with the VPC endpoints on the Vultr API
 // Link : https://www.vultr.com/api/#tag/vpcs
 type VPCService interface {
-	Create(ctx context.Context, createReq *VPCReq) (*VPC, *http.Response, error)
-	Get(ctx context.Context, vpcID string) (*VPC, *http.Response, error)
-	Update(ctx context.Context, vpcID string, description string) error
-	Delete(ctx context.Context, vpcID string) error
-	List(ctx context.Context, options *ListOptions) ([]VPC, *Meta, *http.Response, error)
+	Create(ctx context.Context, createReq *VPCReq) (*VPC, *Response, error)
+	Get(ctx context.Context, vpcID string) (*VPC, *Response, error)
+	Update(ctx context.Context, vpcID string, description string) (*Response, error)
+	Delete(ctx context.Context, vpcID string) (*Response, error)
+	List(ctx context.Context, options *ListOptions) ([]VPC, *Meta, *Response, error)
 }
 
 // VPCServiceHandler handles interaction with the VPC methods for the Vultr API
@@ -27,12 +27,12 @@ type VPCServiceHandler struct {
 
 // VPC represents a Vultr VPC
 type VPC struct {
-	ID           string `json:"id"`
-	Region       string `json:"region"`
-	Description  string `json:"description"`
-	V4Subnet     string `json:"v4_subnet"`
-	V4SubnetMask int    `json:"v4_subnet_mask"`
-	DateCreated  string `json:"date_created"`
+	ID           string    `json:"id"`
+	Region       string    `json:"region"`
+	Description  string    `json:"description"`
+	V4Subnet     string    `json:"v4_subnet"`
+	V4SubnetMask int       `json:"v4_subnet_mask"`
+	DateCreated  Timestamp `json:"date_created"`
 }
 
 // VPCReq represents parameters to create or update a VPC resource
@@ -43,6 +43,17 @@ type VPCReq struct {
 	V4SubnetMask int    `json:"v4_subnet_mask"`
 }
 
+// Validate checks that the region is given and that the subnet, when given, is the network
+// address of an IPv4 CIDR
+func (r VPCReq) Validate() error {
+	v := new(validation)
+	v.required("region", r.Region)
+	if r.V4Subnet != "" {
+		v.network("v4_subnet", r.V4Subnet, "v4_subnet_mask", r.V4SubnetMask, 32)
+	}
+	return v.err()
+}
+
 type vpcsBase struct {
 	VPCs []VPC `json:"vpcs"`
 	Meta *Meta `json:"meta"`
@@ -53,7 +64,7 @@ type vpcBase struct {
 }
 
 // Create creates a new VPC. A VPC can only be used at the location for which it was created.
-func (n *VPCServiceHandler) Create(ctx context.Context, createReq *VPCReq) (*VPC, *http.Response, error) {
+func (n *VPCServiceHandler) Create(ctx context.Context, createReq *VPCReq) (*VPC, *Response, error) {
 	req, err := n.client.NewRequest(ctx, http.MethodPost, vpcPath, createReq)
 	if err != nil {
 		return nil, nil, err
@@ -69,7 +80,7 @@ func (n *VPCServiceHandler) Create(ctx context.Context, createReq *VPCReq) (*VPC
 }
 
 // Get gets the VPC of the requested ID
-func (n *VPCServiceHandler) Get(ctx context.Context, vpcID string) (*VPC, *http.Response, error) {
+func (n *VPCServiceHandler) Get(ctx context.Context, vpcID string) (*VPC, *Response, error) {
 	uri := fmt.Sprintf("%s/%s", vpcPath, vpcID)
 	req, err := n.client.NewRequest(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -86,32 +97,30 @@ func (n *VPCServiceHandler) Get(ctx context.Context, vpcID string) (*VPC, *http.
 }
 
 // Update updates a VPC
-func (n *VPCServiceHandler) Update(ctx context.Context, vpcID, description string) error {
+func (n *VPCServiceHandler) Update(ctx context.Context, vpcID, description string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s", vpcPath, vpcID)
 
 	vpcReq := RequestBody{"description": description}
 	req, err := n.client.NewRequest(ctx, http.MethodPut, uri, vpcReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = n.client.DoWithContext(ctx, req, nil)
-	return err
+	return n.client.DoWithContext(ctx, req, nil)
 }
 
 // Delete deletes a VPC. Before deleting, a VPC must be disabled from all instances
-func (n *VPCServiceHandler) Delete(ctx context.Context, vpcID string) error {
+func (n *VPCServiceHandler) Delete(ctx context.Context, vpcID string) (*Response, error) {
 	uri := fmt.Sprintf("%s/%s", vpcPath, vpcID)
 	req, err := n.client.NewRequest(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = n.client.DoWithContext(ctx, req, nil)
-	return err
+	return n.client.DoWithContext(ctx, req, nil)
 }
 
 // List lists all VPCs on the current account
-func (n *VPCServiceHandler) List(ctx context.Context, options *ListOptions) ([]VPC, *Meta, *http.Response, error) { //nolint:dupl
+func (n *VPCServiceHandler) List(ctx context.Context, options *ListOptions) ([]VPC, *Meta, *Response, error) { //nolint:dupl
 	req, err := n.client.NewRequest(ctx, http.MethodGet, vpcPath, nil)
 	if err != nil {
 		return nil, nil, nil, err
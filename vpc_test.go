@@ -45,7 +45,7 @@ func TestVPCServiceHandler_Create(t *testing.T) {
 		Description:  "test1",
 		V4Subnet:     "10.99.0.0",
 		V4SubnetMask: 24,
-		DateCreated:  "2017-08-25 12:23:45",
+		DateCreated:  timestamp("2017-08-25 12:23:45"),
 	}
 
 	if !reflect.DeepEqual(net, expected) {
@@ -61,7 +61,7 @@ func TestVPCServiceHandler_Delete(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.VPC.Delete(ctx, "net539626f0798d7")
+	_, err := client.VPC.Delete(ctx, "net539626f0798d7")
 
 	if err != nil {
 		t.Errorf("VPC.Delete returned %+v, expected %+v", err, nil)
@@ -101,7 +101,7 @@ func TestVPCServiceHandler_List(t *testing.T) {
 			Description:  "test1",
 			V4Subnet:     "10.99.0.0",
 			V4SubnetMask: 24,
-			DateCreated:  "2017-08-25 12:23:45",
+			DateCreated:  timestamp("2017-08-25 12:23:45"),
 		},
 	}
 
@@ -118,7 +118,7 @@ func TestVPCServiceHandler_Update(t *testing.T) {
 		fmt.Fprint(writer)
 	})
 
-	err := client.VPC.Update(ctx, "net539626f0798d7", "update")
+	_, err := client.VPC.Update(ctx, "net539626f0798d7", "update")
 
 	if err != nil {
 		t.Errorf("VPC.Update returned %+v, expected %+v", err, nil)
@@ -145,7 +145,7 @@ func TestVPCServiceHandler_Get(t *testing.T) {
 		Description:  "sample desc",
 		V4Subnet:     "10.99.0.0",
 		V4SubnetMask: 24,
-		DateCreated:  "2020-10-10T01:56:20+00:00",
+		DateCreated:  timestamp("2020-10-10T01:56:20+00:00"),
 	}
 
 	if !reflect.DeepEqual(vpc, expected) {
@@ -0,0 +1,330 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrWaitTimeout is returned when a resource did not reach a target state before the wait timeout
+	ErrWaitTimeout = errors.New("timed out waiting for the resource")
+	// ErrWaitFailed is returned when a resource reached one of the failure states of a wait
+	ErrWaitFailed = errors.New("resource reached a failure state")
+)
+
+// WaitOption configures WaitFor and the ready-made waiters
+type WaitOption func(*waitOptions)
+
+type waitOptions struct {
+	target      []string
+	failure     []string
+	timeout     time.Duration
+	interval    time.Duration
+	maxInterval time.Duration
+	multiplier  float64
+	maxErrors   int
+	tolerate    func(error) bool
+	progress    func(WaitProgress)
+}
+
+func defaultWaitOptions() *waitOptions {
+	return &waitOptions{
+		timeout:     30 * time.Minute,
+		interval:    5 * time.Second,
+		maxInterval: 30 * time.Second,
+		multiplier:  1.5,
+		maxErrors:   3,
+		tolerate:    transientWaitError,
+	}
+}
+
+// WaitProgress describes a poll of the resource being waited for
+type WaitProgress struct {
+	// Attempt is the number of polls so far, starting at 1
+	Attempt int
+	// State is the state the resource was found in
+	State string
+	// Elapsed is the time since the wait started
+	Elapsed time.Duration
+	// Next is the time until the next poll, zero once the wait is over
+	Next time.Duration
+	// Err is the error of a failed poll that the wait tolerated, State is then the last one known
+	Err error
+}
+
+// WithTargetStates sets the states ending the wait successfully, replacing the defaults of the waiter
+func WithTargetStates(states ...string) WaitOption {
+	return func(o *waitOptions) {
+		o.target = states
+	}
+}
+
+// WithFailureStates sets the states ending the wait with ErrWaitFailed, replacing the defaults of
+// the waiter
+func WithFailureStates(states ...string) WaitOption {
+	return func(o *waitOptions) {
+		o.failure = states
+	}
+}
+
+// WithWaitTimeout bounds the duration of the wait, 30 minutes by default. A zero duration waits
+// until the context is done.
+func WithWaitTimeout(d time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.timeout = d
+	}
+}
+
+// WithWaitBackoff sets the delay between polls: it starts at interval and is multiplied by
+// multiplier after each poll, up to maxInterval. The default waits 5 seconds, then grows by 1.5
+// up to 30 seconds. A zero maxInterval leaves the delay unbounded. Invalid values, a non-positive
+// interval, a negative maxInterval or a multiplier below 1, keep their default.
+func WithWaitBackoff(interval, maxInterval time.Duration, multiplier float64) WaitOption {
+	return func(o *waitOptions) {
+		if interval > 0 {
+			o.interval = interval
+		}
+		if maxInterval >= 0 {
+			o.maxInterval = maxInterval
+		}
+		if multiplier >= 1 {
+			o.multiplier = multiplier
+		}
+	}
+}
+
+// WithWaitErrorTolerance keeps polling after up to n consecutive refresh errors for which
+// tolerate returns true, any error when it is nil. By default up to 3 consecutive 404 and 5xx
+// responses are tolerated, as a resource may not be visible yet just after its creation. A zero n
+// ends the wait on the first error.
+func WithWaitErrorTolerance(n int, tolerate func(error) bool) WaitOption {
+	return func(o *waitOptions) {
+		if n < 0 {
+			n = 0
+		}
+		if tolerate == nil {
+			tolerate = func(error) bool { return true }
+		}
+		o.maxErrors, o.tolerate = n, tolerate
+	}
+}
+
+// transientWaitError reports whether err is a 404 or a 5xx response of the API
+func transientWaitError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode >= http.StatusInternalServerError)
+}
+
+// WithWaitProgress calls fn after every poll
+func WithWaitProgress(fn func(WaitProgress)) WaitOption {
+	return func(o *waitOptions) {
+		o.progress = fn
+	}
+}
+
+// WaitFor polls a resource with refresh until state reports one of the target states. It returns
+// ErrWaitFailed when a failure state is reached and ErrWaitTimeout when the timeout expires, along
+// with the last version of the resource. States are compared case insensitively. Errors returned
+// by refresh end the wait, unless tolerated as set by WithWaitErrorTolerance.
+func WaitFor[T any](ctx context.Context, refresh func(ctx context.Context) (T, error), state func(T) string, opts ...WaitOption) (T, error) {
+	o := defaultWaitOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	var last T
+	if len(o.target) == 0 {
+		return last, errors.New("no target state to wait for")
+	}
+
+	if o.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, o.timeout, ErrWaitTimeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	interval := o.interval
+	current := ""
+	failures := 0
+
+	for attempt := 1; ; attempt++ {
+		resource, err := refresh(ctx)
+		switch {
+		case err == nil:
+			failures = 0
+			last = resource
+			current = state(resource)
+		case ctx.Err() != nil:
+			return last, waitDone(ctx, current)
+		default:
+			failures++
+			if failures > o.maxErrors || !o.tolerate(err) {
+				return last, err
+			}
+		}
+
+		done := err == nil && containsState(o.target, current)
+		failed := err == nil && containsState(o.failure, current)
+
+		if o.progress != nil {
+			p := WaitProgress{Attempt: attempt, State: current, Elapsed: time.Since(start), Err: err}
+			if !done && !failed {
+				p.Next = interval
+			}
+			o.progress(p)
+		}
+
+		switch {
+		case done:
+			return last, nil
+		case failed:
+			return last, fmt.Errorf("%w: %s", ErrWaitFailed, current)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, waitDone(ctx, current)
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * o.multiplier)
+		if o.maxInterval > 0 && interval > o.maxInterval {
+			interval = o.maxInterval
+		}
+	}
+}
+
+// waitDone returns the error ending a wait whose context is done
+func waitDone(ctx context.Context, state string) error {
+	err := context.Cause(ctx)
+	if state == "" {
+		return err
+	}
+	return fmt.Errorf("%w, last state: %s", err, state)
+}
+
+func containsState(states []string, state string) bool {
+	for _, s := range states {
+		if strings.EqualFold(s, state) {
+			return true
+		}
+	}
+	return false
+}
+
+// WaitInstanceReady waits until an instance is active, running and its server status is ok.
+// Its state is "ready" once it is, otherwise the first of its status, power status and server
+// status that is not, the wait fails when it is "suspended".
+func WaitInstanceReady(ctx context.Context, instances InstanceService, instanceID string, opts ...WaitOption) (*Instance, error) {
+	refresh := func(ctx context.Context) (*Instance, error) {
+		instance, _, err := instances.Get(ctx, instanceID)
+		return instance, err
+	}
+	state := func(instance *Instance) string {
+		switch {
+		case instance.Status != InstanceStatusActive:
+			return string(instance.Status)
+		case instance.PowerStatus != PowerStatusRunning:
+			return string(instance.PowerStatus)
+		case instance.ServerStatus != ServerStatusOK:
+			return string(instance.ServerStatus)
+		}
+		return "ready"
+	}
+
+	return WaitFor(ctx, refresh, state, withWaitDefaults([]string{"ready"}, []string{string(InstanceStatusSuspended)}, opts)...)
+}
+
+// WaitSnapshotComplete waits until a snapshot is complete
+func WaitSnapshotComplete(ctx context.Context, snapshots SnapshotService, snapshotID string, opts ...WaitOption) (*Snapshot, error) {
+	refresh := func(ctx context.Context) (*Snapshot, error) {
+		snapshot, _, err := snapshots.Get(ctx, snapshotID)
+		return snapshot, err
+	}
+	state := func(snapshot *Snapshot) string {
+		return snapshot.Status
+	}
+
+	return WaitFor(ctx, refresh, state, withWaitDefaults([]string{"complete"}, nil, opts)...)
+}
+
+// WaitISOComplete waits until an ISO is downloaded
+func WaitISOComplete(ctx context.Context, isos ISOService, isoID string, opts ...WaitOption) (*ISO, error) {
+	refresh := func(ctx context.Context) (*ISO, error) {
+		iso, _, err := isos.Get(ctx, isoID)
+		return iso, err
+	}
+	state := func(iso *ISO) string {
+		return iso.Status
+	}
+
+	return WaitFor(ctx, refresh, state, withWaitDefaults([]string{"complete"}, nil, opts)...)
+}
+
+// WaitBlockAttached waits until a block storage is active and attached to an instance. Its state
+// is "attached" once it is, otherwise its status, or "detached" when it is active but not yet
+// attached to the instance.
+func WaitBlockAttached(ctx context.Context, blocks BlockStorageService, blockID, instanceID string, opts ...WaitOption) (*BlockStorage, error) {
+	refresh := func(ctx context.Context) (*BlockStorage, error) {
+		block, _, err := blocks.Get(ctx, blockID)
+		return block, err
+	}
+	state := func(block *BlockStorage) string {
+		switch {
+		case block.Status != "active":
+			return block.Status
+		case block.AttachedToInstance != instanceID:
+			return "detached"
+		}
+		return "attached"
+	}
+
+	return WaitFor(ctx, refresh, state, withWaitDefaults([]string{"attached"}, nil, opts)...)
+}
+
+// WaitClusterActive waits until a Kubernetes cluster and all its node pools are active. Its state
+// is the status of the cluster, or of the first node pool that is not active.
+func WaitClusterActive(ctx context.Context, kubernetes KubernetesService, vkeID string, opts ...WaitOption) (*Cluster, error) {
+	refresh := func(ctx context.Context) (*Cluster, error) {
+		cluster, _, err := kubernetes.GetCluster(ctx, vkeID)
+		return cluster, err
+	}
+	state := func(cluster *Cluster) string {
+		if cluster.Status != "active" {
+			return cluster.Status
+		}
+		for _, pool := range cluster.NodePools {
+			if pool.Status != "active" {
+				return pool.Status
+			}
+		}
+		return "active"
+	}
+
+	return WaitFor(ctx, refresh, state, withWaitDefaults([]string{"active"}, nil, opts)...)
+}
+
+// WaitDatabaseRunning waits until a managed database is running
+func WaitDatabaseRunning(ctx context.Context, databases DatabaseService, databaseID string, opts ...WaitOption) (*Database, error) {
+	refresh := func(ctx context.Context) (*Database, error) {
+		database, _, err := databases.Get(ctx, databaseID)
+		return database, err
+	}
+	state := func(database *Database) string {
+		return database.Status
+	}
+
+	return WaitFor(ctx, refresh, state, withWaitDefaults([]string{"Running"}, nil, opts)...)
+}
+
+// withWaitDefaults prepends the states of a ready-made waiter to opts, so they can be overridden
+func withWaitDefaults(target, failure []string, opts []WaitOption) []WaitOption {
+	return append([]WaitOption{WithTargetStates(target...), WithFailureStates(failure...)}, opts...)
+}
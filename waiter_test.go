@@ -0,0 +1,213 @@
+package govultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var fastWait = WithWaitBackoff(time.Millisecond, 4*time.Millisecond, 2)
+
+// respondInOrder answers each request with the next response, repeating the last one
+func respondInOrder(responses ...string) http.HandlerFunc {
+	calls := 0
+	return func(writer http.ResponseWriter, request *http.Request) {
+		response := responses[min(calls, len(responses)-1)]
+		calls++
+		fmt.Fprint(writer, response)
+	}
+}
+
+func TestWaitFor(t *testing.T) {
+	states := []string{"pending", "pending", "Complete"}
+	polls := 0
+	refresh := func(ctx context.Context) (string, error) {
+		polls++
+		return states[polls-1], nil
+	}
+
+	var progress []WaitProgress
+	state, err := WaitFor(ctx, refresh, func(s string) string { return s },
+		WithTargetStates("complete"),
+		WithWaitBackoff(time.Millisecond, 3*time.Millisecond, 2),
+		WithWaitProgress(func(p WaitProgress) { progress = append(progress, p) }),
+	)
+	if err != nil {
+		t.Fatalf("WaitFor returned %+v", err)
+	}
+	if state != "Complete" || polls != 3 {
+		t.Errorf("WaitFor returned %v after %d polls, expected Complete after 3", state, polls)
+	}
+
+	if len(progress) != 3 {
+		t.Fatalf("WaitFor reported %d progress updates, expected 3", len(progress))
+	}
+	for i, expected := range []time.Duration{time.Millisecond, 2 * time.Millisecond, 0} {
+		if progress[i].Attempt != i+1 || progress[i].State != states[i] || progress[i].Next != expected {
+			t.Errorf("WaitFor progress %d = %+v, expected next poll in %v", i, progress[i], expected)
+		}
+	}
+}
+
+func TestWaitFor_Errors(t *testing.T) {
+	identity := func(s string) string { return s }
+	pending := func(ctx context.Context) (string, error) { return "pending", nil }
+
+	if _, err := WaitFor(ctx, pending, identity, fastWait); err == nil {
+		t.Error("WaitFor without target states returned no error")
+	}
+
+	_, err := WaitFor(ctx, pending, identity, fastWait, WithTargetStates("complete"), WithFailureStates("pending"))
+	if !errors.Is(err, ErrWaitFailed) {
+		t.Errorf("WaitFor returned %+v, expected %v", err, ErrWaitFailed)
+	}
+
+	state, err := WaitFor(ctx, pending, identity, fastWait, WithTargetStates("complete"), WithWaitTimeout(10*time.Millisecond))
+	if !errors.Is(err, ErrWaitTimeout) || state != "pending" {
+		t.Errorf("WaitFor returned %v, %+v, expected the last state and %v", state, err, ErrWaitTimeout)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := WaitFor(canceled, pending, identity, fastWait, WithTargetStates("complete")); !errors.Is(err, context.Canceled) {
+		t.Errorf("WaitFor with a canceled context returned %+v, expected %v", err, context.Canceled)
+	}
+
+	refreshErr := errors.New("refresh failed")
+	failing := func(ctx context.Context) (string, error) { return "", refreshErr }
+	if _, err := WaitFor(ctx, failing, identity, fastWait, WithTargetStates("complete")); !errors.Is(err, refreshErr) {
+		t.Errorf("WaitFor returned %+v, expected %v", err, refreshErr)
+	}
+}
+
+func TestWaitInstanceReady(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances/14b3e7d6", respondInOrder(
+		`{"instance":{"id":"14b3e7d6","status":"pending","power_status":"stopped","server_status":"none"}}`,
+		`{"instance":{"id":"14b3e7d6","status":"active","power_status":"running","server_status":"installingbooting"}}`,
+		`{"instance":{"id":"14b3e7d6","status":"active","power_status":"running","server_status":"ok"}}`,
+	))
+
+	var states []string
+	instance, err := WaitInstanceReady(ctx, client.Instance, "14b3e7d6", fastWait, WithWaitProgress(func(p WaitProgress) {
+		states = append(states, p.State)
+	}))
+	if err != nil {
+		t.Fatalf("WaitInstanceReady returned %+v", err)
+	}
+	if instance.ServerStatus != "ok" {
+		t.Errorf("WaitInstanceReady returned %+v", instance)
+	}
+
+	if expected := []string{"pending", "installingbooting", "ready"}; !reflect.DeepEqual(states, expected) {
+		t.Errorf("WaitInstanceReady went through %v, expected %v", states, expected)
+	}
+}
+
+func TestWaitInstanceReady_NotFoundYet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/v2/instances/14b3e7d6", func(writer http.ResponseWriter, request *http.Request) {
+		calls++
+		if calls == 1 {
+			http.Error(writer, `{"error":"invalid instance ID","status":404}`, http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(writer, `{"instance":{"id":"14b3e7d6","status":"active","power_status":"running","server_status":"ok"}}`)
+	})
+
+	var progress []WaitProgress
+	instance, err := WaitInstanceReady(ctx, client.Instance, "14b3e7d6", fastWait, WithWaitProgress(func(p WaitProgress) {
+		progress = append(progress, p)
+	}))
+	if err != nil {
+		t.Fatalf("WaitInstanceReady returned %+v", err)
+	}
+	if instance.ID != "14b3e7d6" || len(progress) != 2 || progress[0].Err == nil || progress[1].State != "ready" {
+		t.Errorf("WaitInstanceReady returned %+v after %+v, expected the 404 to be tolerated", instance, progress)
+	}
+
+	_, err = WaitInstanceReady(ctx, client.Instance, "missing", fastWait, WithWaitErrorTolerance(0, nil))
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("WaitInstanceReady returned %+v, expected the 404 to end the wait", err)
+	}
+}
+
+func TestWithWaitBackoff_Invalid(t *testing.T) {
+	o := defaultWaitOptions()
+	WithWaitBackoff(0, -time.Second, 0.5)(o)
+
+	if defaults := defaultWaitOptions(); o.interval != defaults.interval || o.maxInterval != defaults.maxInterval || o.multiplier != defaults.multiplier {
+		t.Errorf("WithWaitBackoff applied invalid values: %v, %v, %v", o.interval, o.maxInterval, o.multiplier)
+	}
+}
+
+func TestWaitInstanceReady_Suspended(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/instances/14b3e7d6", respondInOrder(
+		`{"instance":{"id":"14b3e7d6","status":"suspended","power_status":"stopped","server_status":"none"}}`,
+	))
+
+	if _, err := WaitInstanceReady(ctx, client.Instance, "14b3e7d6", fastWait); !errors.Is(err, ErrWaitFailed) {
+		t.Errorf("WaitInstanceReady returned %+v, expected %v", err, ErrWaitFailed)
+	}
+}
+
+func TestWaiters(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/snapshots/5359435d", respondInOrder(
+		`{"snapshot":{"id":"5359435d","status":"pending"}}`,
+		`{"snapshot":{"id":"5359435d","status":"complete"}}`,
+	))
+	mux.HandleFunc("/v2/iso/9931be02", respondInOrder(
+		`{"iso":{"id":"9931be02","status":"pending"}}`,
+		`{"iso":{"id":"9931be02","status":"complete"}}`,
+	))
+	mux.HandleFunc("/v2/blocks/c56c7b6e", respondInOrder(
+		`{"block":{"id":"c56c7b6e","status":"pending","attached_to_instance":""}}`,
+		`{"block":{"id":"c56c7b6e","status":"active","attached_to_instance":""}}`,
+		`{"block":{"id":"c56c7b6e","status":"active","attached_to_instance":"14b3e7d6"}}`,
+	))
+	mux.HandleFunc("/v2/kubernetes/clusters/455dcd32", respondInOrder(
+		`{"vke_cluster":{"id":"455dcd32","status":"pending","node_pools":[{"id":"a","status":"pending"}]}}`,
+		`{"vke_cluster":{"id":"455dcd32","status":"active","node_pools":[{"id":"a","status":"pending"}]}}`,
+		`{"vke_cluster":{"id":"455dcd32","status":"active","node_pools":[{"id":"a","status":"active"}]}}`,
+	))
+	mux.HandleFunc("/v2/databases/9e6f1a8c", respondInOrder(
+		`{"database":{"id":"9e6f1a8c","status":"Rebuilding"}}`,
+		`{"database":{"id":"9e6f1a8c","status":"Running"}}`,
+	))
+
+	if snapshot, err := WaitSnapshotComplete(ctx, client.Snapshot, "5359435d", fastWait); err != nil || snapshot.Status != "complete" {
+		t.Errorf("WaitSnapshotComplete returned %+v, %+v", snapshot, err)
+	}
+
+	if iso, err := WaitISOComplete(ctx, client.ISO, "9931be02", fastWait); err != nil || iso.Status != "complete" {
+		t.Errorf("WaitISOComplete returned %+v, %+v", iso, err)
+	}
+
+	if block, err := WaitBlockAttached(ctx, client.BlockStorage, "c56c7b6e", "14b3e7d6", fastWait); err != nil || block.AttachedToInstance != "14b3e7d6" {
+		t.Errorf("WaitBlockAttached returned %+v, %+v", block, err)
+	}
+
+	if cluster, err := WaitClusterActive(ctx, client.Kubernetes, "455dcd32", fastWait); err != nil || cluster.NodePools[0].Status != "active" {
+		t.Errorf("WaitClusterActive returned %+v, %+v", cluster, err)
+	}
+
+	if database, err := WaitDatabaseRunning(ctx, client.Database, "9e6f1a8c", fastWait); err != nil || database.Status != "Running" {
+		t.Errorf("WaitDatabaseRunning returned %+v, %+v", database, err)
+	}
+}